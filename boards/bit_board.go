@@ -18,7 +18,7 @@ func NewBitBoard(
 	pieces []common.Piece,
 	pieceFactory common.PieceFactory,
 ) common.PieceStorage {
-	pieceGroup := new(bitBoardPieceGroup)
+	pieceGroup := newBitBoardPieceGroup()
 	for _, piece := range pieces {
 		pieceGroup.AddPiece(size, piece)
 	}
@@ -42,7 +42,7 @@ func (board BitBoard) Piece(position common.Position) (
 //
 // It doesn't check that the move is correct.
 func (board BitBoard) ApplyMove(move common.Move) common.PieceStorage {
-	pieceGroupCopy := new(bitBoardPieceGroup)
+	pieceGroupCopy := newBitBoardPieceGroup()
 	pieceGroupCopy.SetValue(board.pieces)
 
 	piece, _ :=
@@ -55,3 +55,31 @@ func (board BitBoard) ApplyMove(move common.Move) common.PieceStorage {
 	bitBoard := BitBoard{board.BaseBoard, pieceGroupCopy, board.pieceFactory}
 	return WrapBasePieceStorage(bitBoard)
 }
+
+// SetPiece ...
+//
+// It replaces a piece on the same position, if any.
+func (board BitBoard) SetPiece(piece common.Piece) common.PieceStorage {
+	pieceGroupCopy := newBitBoardPieceGroup()
+	pieceGroupCopy.SetValue(board.pieces)
+
+	size, position := board.Size(), piece.Position()
+	pieceGroupCopy.ClearPosition(size, position, board.pieceFactory)
+	pieceGroupCopy.AddPiece(size, piece)
+
+	bitBoard := BitBoard{board.BaseBoard, pieceGroupCopy, board.pieceFactory}
+	return WrapBasePieceStorage(bitBoard)
+}
+
+// RemovePiece ...
+func (board BitBoard) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	pieceGroupCopy := newBitBoardPieceGroup()
+	pieceGroupCopy.SetValue(board.pieces)
+
+	pieceGroupCopy.ClearPosition(board.Size(), position, board.pieceFactory)
+
+	bitBoard := BitBoard{board.BaseBoard, pieceGroupCopy, board.pieceFactory}
+	return WrapBasePieceStorage(bitBoard)
+}
@@ -16,13 +16,20 @@ type bitBoardPieceGroupByColorAndKindHandler func(
 	piecesByColorAndKind *bitBoardPieceGroupByColorAndKind,
 ) error
 
-type bitBoardPieceGroup [common.ColorCount][common.KindCount]bitBoardPieceGroupByColorAndKind // nolint: lll
+// kinds are indexed in slices in order to support registered kinds
+// (see common.RegisterKind()); the slices are sized lazily up to the highest
+// kind of added pieces, so unused kinds and colors don't slow down lookups
+type bitBoardPieceGroup [common.AllColorCount][]bitBoardPieceGroupByColorAndKind
+
+func newBitBoardPieceGroup() *bitBoardPieceGroup {
+	return new(bitBoardPieceGroup)
+}
 
 func (pieceGroup *bitBoardPieceGroup) IteratePiecesByColorAndKind(
 	handler bitBoardPieceGroupByColorAndKindHandler,
 ) error {
-	for colorAsInt := 0; colorAsInt < int(common.ColorCount); colorAsInt++ {
-		for kindAsInt := 0; kindAsInt < int(common.KindCount); kindAsInt++ {
+	for colorAsInt := 0; colorAsInt < int(common.AllColorCount); colorAsInt++ {
+		for kindAsInt := 0; kindAsInt < len(pieceGroup[colorAsInt]); kindAsInt++ {
 			color, kind := common.Color(colorAsInt), common.Kind(kindAsInt)
 			piecesByColorAndKind := &pieceGroup[color][kind]
 			if err := handler(color, kind, piecesByColorAndKind); err != nil {
@@ -65,20 +72,22 @@ func (pieceGroup *bitBoardPieceGroup) PieceByPosition(
 func (pieceGroup *bitBoardPieceGroup) SetValue(
 	anotherPieceGroup *bitBoardPieceGroup,
 ) {
-	anotherPieceGroup.IteratePiecesByColorAndKind(func( // nolint: errcheck
-		color common.Color,
-		kind common.Kind,
-		piecesByColorAndKind *bitBoardPieceGroupByColorAndKind,
-	) error {
-		pieceGroup[color][kind].SetValue(piecesByColorAndKind)
-		return nil
-	})
+	for colorAsInt, piecesByColor := range anotherPieceGroup {
+		color := common.Color(colorAsInt)
+		pieceGroup.grow(color, len(piecesByColor))
+
+		for kind := range piecesByColor {
+			pieceGroup[color][kind].SetValue(&piecesByColor[kind])
+		}
+	}
 }
 
 func (pieceGroup *bitBoardPieceGroup) AddPiece(
 	size common.Size,
 	piece common.Piece,
 ) {
+	pieceGroup.grow(piece.Color(), int(piece.Kind())+1)
+
 	pieceGroup[piece.Color()][piece.Kind()].
 		SetPositionStatus(size, piece.Position(), occupiedPositionStatus)
 }
@@ -98,3 +107,16 @@ func (pieceGroup *bitBoardPieceGroup) ClearPosition(
 
 	return piece, true
 }
+
+func (pieceGroup *bitBoardPieceGroup) grow(color common.Color, kindCount int) {
+	piecesByColor := pieceGroup[color]
+	if len(piecesByColor) >= kindCount {
+		return
+	}
+
+	// grow the slice by a single allocation
+	grownPiecesByColor := make([]bitBoardPieceGroupByColorAndKind, kindCount)
+	copy(grownPiecesByColor, piecesByColor)
+
+	pieceGroup[color] = grownPiecesByColor
+}
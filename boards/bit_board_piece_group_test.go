@@ -29,9 +29,10 @@ func TestBitBoardPieceGroupIteratePiecesByColorAndKind(test *testing.T) {
 	for _, data := range []data{
 		{
 			pieceGroup: func() *bitBoardPieceGroup {
-				pieceGroup := new(bitBoardPieceGroup)
+				pieceGroup := newBitBoardPieceGroup()
 				positionIndex := 0
 				for colorAsInt := 0; colorAsInt < int(common.ColorCount); colorAsInt++ {
+					pieceGroup.grow(common.Color(colorAsInt), int(common.KindCount))
 					for kindAsInt := 0; kindAsInt < int(common.KindCount); kindAsInt++ {
 						piecesByColorAndKind := &pieceGroup[colorAsInt][kindAsInt]
 						piecesByColorAndKind.ToBigInt().
@@ -83,9 +84,10 @@ func TestBitBoardPieceGroupIteratePiecesByColorAndKind(test *testing.T) {
 		},
 		{
 			pieceGroup: func() *bitBoardPieceGroup {
-				pieceGroup := new(bitBoardPieceGroup)
+				pieceGroup := newBitBoardPieceGroup()
 				positionIndex := 0
 				for colorAsInt := 0; colorAsInt < int(common.ColorCount); colorAsInt++ {
+					pieceGroup.grow(common.Color(colorAsInt), int(common.KindCount))
 					for kindAsInt := 0; kindAsInt < int(common.KindCount); kindAsInt++ {
 						piecesByColorAndKind := &pieceGroup[colorAsInt][kindAsInt]
 						piecesByColorAndKind.ToBigInt().
@@ -167,7 +169,7 @@ func TestBitBoardPieceGroupPieceByPosition(test *testing.T) {
 
 	for _, data := range []data{
 		{
-			pieceGroup: new(bitBoardPieceGroup),
+			pieceGroup: newBitBoardPieceGroup(),
 			args: args{
 				size:     common.Size{5, 5},
 				position: common.Position{2, 3},
@@ -185,7 +187,7 @@ func TestBitBoardPieceGroupPieceByPosition(test *testing.T) {
 		},
 		{
 			pieceGroup: func() *bitBoardPieceGroup {
-				pieceGroup := new(bitBoardPieceGroup)
+				pieceGroup := newBitBoardPieceGroup()
 				pieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
 					kind:     common.Queen,
 					color:    common.White,
@@ -245,14 +247,14 @@ func TestBitBoardPieceGroupPieceByPosition(test *testing.T) {
 }
 
 func TestBitBoardPieceGroupSetValue(test *testing.T) {
-	expectedPieceGroup := new(bitBoardPieceGroup)
+	expectedPieceGroup := newBitBoardPieceGroup()
 	expectedPieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
 		kind:     common.Queen,
 		color:    common.White,
 		position: common.Position{2, 3},
 	})
 
-	pieceGroup := new(bitBoardPieceGroup)
+	pieceGroup := newBitBoardPieceGroup()
 	pieceGroup.SetValue(expectedPieceGroup)
 
 	if !reflect.DeepEqual(pieceGroup, expectedPieceGroup) {
@@ -261,14 +263,15 @@ func TestBitBoardPieceGroupSetValue(test *testing.T) {
 }
 
 func TestBitBoardPieceGroupAddPiece(test *testing.T) {
-	pieceGroup := new(bitBoardPieceGroup)
+	pieceGroup := newBitBoardPieceGroup()
 	pieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
 		kind:     common.Queen,
 		color:    common.White,
 		position: common.Position{2, 3},
 	})
 
-	expectedPieceGroup := new(bitBoardPieceGroup)
+	expectedPieceGroup := newBitBoardPieceGroup()
+	expectedPieceGroup.grow(common.White, int(common.Queen)+1)
 	expectedPieceGroup.IteratePiecesByColorAndKind(func( // nolint: errcheck
 		color common.Color,
 		kind common.Kind,
@@ -289,6 +292,49 @@ func TestBitBoardPieceGroupAddPiece(test *testing.T) {
 	}
 }
 
+func TestBitBoardPieceGroupAddPiece_withExtraKind(test *testing.T) {
+	extraKind := common.Kind(len(common.Kinds()) + 2)
+
+	pieceGroup := newBitBoardPieceGroup()
+	pieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
+		kind:     extraKind,
+		color:    common.White,
+		position: common.Position{2, 3},
+	})
+
+	anotherPieceGroup := newBitBoardPieceGroup()
+	anotherPieceGroup.SetValue(pieceGroup)
+
+	for _, pieceGroup := range []*bitBoardPieceGroup{
+		pieceGroup,
+		anotherPieceGroup,
+	} {
+		gotPiece, _, gotOk := pieceGroup.PieceByPosition(
+			common.Size{5, 5},
+			common.Position{2, 3},
+			func(
+				kind common.Kind,
+				color common.Color,
+				position common.Position,
+			) common.Piece {
+				return MockPiece{kind: kind, color: color, position: position}
+			},
+		)
+
+		wantPiece := MockPiece{
+			kind:     extraKind,
+			color:    common.White,
+			position: common.Position{2, 3},
+		}
+		if !reflect.DeepEqual(gotPiece, wantPiece) {
+			test.Fail()
+		}
+		if !gotOk {
+			test.Fail()
+		}
+	}
+}
+
 func TestBitBoardPieceGroupClearPosition(test *testing.T) {
 	type args struct {
 		size         common.Size
@@ -305,7 +351,7 @@ func TestBitBoardPieceGroupClearPosition(test *testing.T) {
 
 	for _, data := range []data{
 		{
-			pieceGroup: new(bitBoardPieceGroup),
+			pieceGroup: newBitBoardPieceGroup(),
 			args: args{
 				size:     common.Size{5, 5},
 				position: common.Position{2, 3},
@@ -317,13 +363,13 @@ func TestBitBoardPieceGroupClearPosition(test *testing.T) {
 					return MockPiece{kind: kind, color: color, position: position}
 				},
 			},
-			wantPieceGroup: new(bitBoardPieceGroup),
+			wantPieceGroup: newBitBoardPieceGroup(),
 			wantPiece:      nil,
 			wantOk:         false,
 		},
 		{
 			pieceGroup: func() *bitBoardPieceGroup {
-				pieceGroup := new(bitBoardPieceGroup)
+				pieceGroup := newBitBoardPieceGroup()
 				pieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
 					kind:     common.Queen,
 					color:    common.White,
@@ -343,7 +389,7 @@ func TestBitBoardPieceGroupClearPosition(test *testing.T) {
 					return MockPiece{kind: kind, color: color, position: position}
 				},
 			},
-			wantPieceGroup: new(bitBoardPieceGroup),
+			wantPieceGroup: newBitBoardPieceGroup(),
 			wantPiece: MockPiece{
 				kind:     common.Queen,
 				color:    common.White,
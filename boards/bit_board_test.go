@@ -35,7 +35,7 @@ func TestNewBitBoard(test *testing.T) {
 	expectedBitBoard := BitBoard{
 		BaseBoard: NewBaseBoard(common.Size{5, 5}),
 
-		pieces: new(bitBoardPieceGroup),
+		pieces: newBitBoardPieceGroup(),
 	}
 	expectedBitBoard.pieces.AddPiece(common.Size{5, 5}, MockPiece{
 		kind:     common.King,
@@ -74,7 +74,7 @@ func TestBitBoardPiece(test *testing.T) {
 			fields: fields{
 				size: common.Size{5, 5},
 				pieces: func() *bitBoardPieceGroup {
-					pieceGroup := new(bitBoardPieceGroup)
+					pieceGroup := newBitBoardPieceGroup()
 					pieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
 						kind:     common.King,
 						color:    common.Black,
@@ -110,7 +110,7 @@ func TestBitBoardPiece(test *testing.T) {
 			fields: fields{
 				size: common.Size{5, 5},
 				pieces: func() *bitBoardPieceGroup {
-					pieceGroup := new(bitBoardPieceGroup)
+					pieceGroup := newBitBoardPieceGroup()
 					pieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
 						kind:     common.King,
 						color:    common.Black,
@@ -176,7 +176,7 @@ func TestBitBoardApplyMove(test *testing.T) {
 			fields: fields{
 				size: common.Size{5, 5},
 				pieces: func() *bitBoardPieceGroup {
-					pieceGroup := new(bitBoardPieceGroup)
+					pieceGroup := newBitBoardPieceGroup()
 					pieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
 						kind:     common.King,
 						color:    common.Black,
@@ -208,7 +208,7 @@ func TestBitBoardApplyMove(test *testing.T) {
 				wantNextBoard := BitBoard{
 					BaseBoard: NewBaseBoard(common.Size{5, 5}),
 
-					pieces: new(bitBoardPieceGroup),
+					pieces: newBitBoardPieceGroup(),
 				}
 				wantNextBoard.pieces.AddPiece(common.Size{5, 5}, MockPiece{
 					kind:     common.King,
@@ -228,7 +228,7 @@ func TestBitBoardApplyMove(test *testing.T) {
 			fields: fields{
 				size: common.Size{5, 5},
 				pieces: func() *bitBoardPieceGroup {
-					pieceGroup := new(bitBoardPieceGroup)
+					pieceGroup := newBitBoardPieceGroup()
 					pieceGroup.AddPiece(common.Size{5, 5}, MockPiece{
 						kind:     common.King,
 						color:    common.Black,
@@ -260,8 +260,9 @@ func TestBitBoardApplyMove(test *testing.T) {
 				wantNextBoard := BitBoard{
 					BaseBoard: NewBaseBoard(common.Size{5, 5}),
 
-					pieces: new(bitBoardPieceGroup),
+					pieces: newBitBoardPieceGroup(),
 				}
+				wantNextBoard.pieces.grow(common.Black, int(common.King)+1)
 				wantNextBoard.pieces[common.Black][common.King].
 					ToBigInt().SetBits([]big.Word{})
 				wantNextBoard.pieces.AddPiece(common.Size{5, 5}, MockPiece{
@@ -310,3 +311,160 @@ func isCorrectBitBoard(
 
 	return reflect.DeepEqual(actualBitBoard, expectedBitBoard)
 }
+
+func TestBitBoardSetPiece(test *testing.T) {
+	type args struct {
+		piece common.Piece
+	}
+	type data struct {
+		args       args
+		wantPieces []common.Piece
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				piece: MockPiece{
+					kind:     common.Rook,
+					color:    common.White,
+					position: common.Position{1, 2},
+				},
+			},
+			wantPieces: []common.Piece{
+				MockPiece{
+					kind:     common.Rook,
+					color:    common.White,
+					position: common.Position{1, 2},
+				},
+				MockPiece{
+					kind:     common.Queen,
+					color:    common.White,
+					position: common.Position{4, 2},
+				},
+				MockPiece{
+					kind:     common.King,
+					color:    common.Black,
+					position: common.Position{2, 3},
+				},
+			},
+		},
+		{
+			args: args{
+				piece: MockPiece{
+					kind:     common.Rook,
+					color:    common.White,
+					position: common.Position{2, 3},
+				},
+			},
+			wantPieces: []common.Piece{
+				MockPiece{
+					kind:     common.Queen,
+					color:    common.White,
+					position: common.Position{4, 2},
+				},
+				MockPiece{
+					kind:     common.Rook,
+					color:    common.White,
+					position: common.Position{2, 3},
+				},
+			},
+		},
+		{
+			args: args{
+				piece: MockPiece{
+					kind:     common.Rook,
+					color:    common.Green,
+					position: common.Position{0, 0},
+				},
+			},
+			wantPieces: []common.Piece{
+				MockPiece{
+					kind:     common.Rook,
+					color:    common.Green,
+					position: common.Position{0, 0},
+				},
+				MockPiece{
+					kind:     common.Queen,
+					color:    common.White,
+					position: common.Position{4, 2},
+				},
+				MockPiece{
+					kind:     common.King,
+					color:    common.Black,
+					position: common.Position{2, 3},
+				},
+			},
+		},
+	} {
+		board := NewBitBoard(
+			common.Size{5, 5},
+			makeTestPieces(),
+			func(
+				kind common.Kind,
+				color common.Color,
+				position common.Position,
+			) common.Piece {
+				return MockPiece{kind: kind, color: color, position: position}
+			},
+		)
+		gotNextBoard := board.SetPiece(data.args.piece)
+
+		if !reflect.DeepEqual(gotNextBoard.Pieces(), data.wantPieces) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(board.Pieces(), makeTestPieces()) {
+			test.Fail()
+		}
+	}
+}
+
+func TestBitBoardRemovePiece(test *testing.T) {
+	type args struct {
+		position common.Position
+	}
+	type data struct {
+		args       args
+		wantPieces []common.Piece
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				position: common.Position{2, 3},
+			},
+			wantPieces: []common.Piece{
+				MockPiece{
+					kind:     common.Queen,
+					color:    common.White,
+					position: common.Position{4, 2},
+				},
+			},
+		},
+		{
+			args: args{
+				position: common.Position{1, 2},
+			},
+			wantPieces: makeTestPieces(),
+		},
+	} {
+		board := NewBitBoard(
+			common.Size{5, 5},
+			makeTestPieces(),
+			func(
+				kind common.Kind,
+				color common.Color,
+				position common.Position,
+			) common.Piece {
+				return MockPiece{kind: kind, color: color, position: position}
+			},
+		)
+		gotNextBoard := board.RemovePiece(data.args.position)
+
+		if !reflect.DeepEqual(gotNextBoard.Pieces(), data.wantPieces) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(board.Pieces(), makeTestPieces()) {
+			test.Fail()
+		}
+	}
+}
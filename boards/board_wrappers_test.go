@@ -74,6 +74,34 @@ func (storage MockBasePieceStorage) ApplyMove(
 	panic("not implemented")
 }
 
+func (storage MockBasePieceStorage) SetPiece(
+	piece common.Piece,
+) common.PieceStorage {
+	panic("not implemented")
+}
+
+func (storage MockBasePieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	panic("not implemented")
+}
+
+// pieces are ordered as they are returned by the common.Pieces() function
+func makeTestPieces() []common.Piece {
+	return []common.Piece{
+		MockPiece{
+			kind:     common.Queen,
+			color:    common.White,
+			position: common.Position{4, 2},
+		},
+		MockPiece{
+			kind:     common.King,
+			color:    common.Black,
+			position: common.Position{2, 3},
+		},
+	}
+}
+
 type MockPieceGroupGetter struct {
 	pieces []common.Piece
 }
@@ -47,8 +47,8 @@ func ExampleMapBoard_CheckMove() { // nolint: govet
 	fmt.Printf("%+v: %v\n", moveTwo, board.CheckMove(moveTwo))
 
 	// Output:
-	// {Start:{File:2 Rank:2} Finish:{File:3 Rank:3}}: illegal move
-	// {Start:{File:3 Rank:3} Finish:{File:2 Rank:2}}: <nil>
+	// {Start:{File:2 Rank:2} Finish:{File:3 Rank:3} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}: illegal move
+	// {Start:{File:3 Rank:3} Finish:{File:2 Rank:2} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}: <nil>
 }
 
 func ExampleMapBoard_ApplyMove() {
@@ -0,0 +1,100 @@
+package boards
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// GeometryBoard ...
+//
+// It wraps a piece storage and provides a geometry for it
+// (see the common.GeometryGetter interface). Positions outside
+// the geometry are never used by the wrapper itself, but the wrapped storage
+// still allocates them.
+type GeometryBoard struct {
+	common.PieceStorage
+
+	geometry common.Geometry
+}
+
+// NewGeometryBoard ...
+func NewGeometryBoard(
+	storage common.PieceStorage,
+	geometry common.Geometry,
+) common.PieceStorage {
+	return GeometryBoard{storage, geometry}
+}
+
+// Geometry ...
+func (board GeometryBoard) Geometry() common.Geometry {
+	return board.geometry
+}
+
+// IsSparse ...
+//
+// It returns sparseness of the wrapped storage
+// (see the common.SparsenessChecker interface).
+func (board GeometryBoard) IsSparse() bool {
+	return common.IsSparse(board.PieceStorage)
+}
+
+// Pieces ...
+//
+// It returns pieces only from cells of the geometry. For a sparse storage,
+// it filters pieces of the storage instead of iterating over all cells.
+func (board GeometryBoard) Pieces() []common.Piece {
+	if !board.IsSparse() {
+		return common.Pieces(board)
+	}
+
+	var pieces []common.Piece
+	for _, piece := range board.PieceStorage.Pieces() {
+		if board.geometry.HasPosition(board.Size(), piece.Position()) {
+			pieces = append(pieces, piece)
+		}
+	}
+
+	return pieces
+}
+
+// CheckMove ...
+//
+// Unlike the wrapped storage, it passes the wrapper to pieces, so they can
+// take the geometry into account.
+//
+// It doesn't check for a check before or after the move.
+func (board GeometryBoard) CheckMove(move common.Move) error {
+	return common.CheckMove(board, move)
+}
+
+// ApplyMove ...
+//
+// It doesn't check that the move is correct.
+func (board GeometryBoard) ApplyMove(move common.Move) common.PieceStorage {
+	return board.update(board.PieceStorage.ApplyMove(move))
+}
+
+// SetPiece ...
+//
+// It replaces a piece on the same position, if any. It does nothing
+// if the position isn't a cell of the geometry (e.g. it's a hole).
+func (board GeometryBoard) SetPiece(piece common.Piece) common.PieceStorage {
+	if !board.geometry.HasPosition(board.Size(), piece.Position()) {
+		return board
+	}
+
+	return board.update(board.PieceStorage.SetPiece(piece))
+}
+
+// RemovePiece ...
+func (board GeometryBoard) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return board.update(board.PieceStorage.RemovePiece(position))
+}
+
+func (board GeometryBoard) update(
+	storage common.PieceStorage,
+) common.PieceStorage {
+	board.PieceStorage = storage
+	return board
+}
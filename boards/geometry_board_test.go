@@ -0,0 +1,181 @@
+package boards
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestNewGeometryBoard(test *testing.T) {
+	storage := NewMapBoard(common.Size{3, 3}, nil)
+	board := NewGeometryBoard(storage, common.Hexagonal{})
+
+	expectedBoard := GeometryBoard{storage, common.Hexagonal{}}
+	if !reflect.DeepEqual(board, expectedBoard) {
+		test.Fail()
+	}
+}
+
+func TestGeometryBoardPieces(test *testing.T) {
+	board := NewGeometryBoard(
+		NewMapBoard(common.Size{3, 3}, []common.Piece{
+			MockPiece{position: common.Position{0, 0}},
+			MockPiece{position: common.Position{1, 1}},
+			MockPiece{position: common.Position{2, 2}},
+		}),
+		common.Hexagonal{},
+	)
+	pieces := board.Pieces()
+
+	expectedPieces := []common.Piece{MockPiece{position: common.Position{1, 1}}}
+	if !reflect.DeepEqual(pieces, expectedPieces) {
+		test.Fail()
+	}
+}
+
+func TestGeometryBoardPieces_withSparseBoard(test *testing.T) {
+	board := NewGeometryBoard(
+		NewSparseBoard(common.Size{1000, 1000}, []common.Piece{
+			MockPiece{position: common.Position{0, 0}},
+			MockPiece{position: common.Position{1, 1}},
+			MockPiece{position: common.Position{999, 999}},
+		}),
+		common.NewMask([]common.Position{{1, 1}}),
+	)
+	pieces := board.Pieces()
+
+	expectedPieces := []common.Piece{
+		MockPiece{position: common.Position{0, 0}},
+		MockPiece{position: common.Position{999, 999}},
+	}
+	if !reflect.DeepEqual(pieces, expectedPieces) {
+		test.Fail()
+	}
+	if !common.IsSparse(board) {
+		test.Fail()
+	}
+}
+
+func TestGeometryBoardCheckMove(test *testing.T) {
+	type args struct {
+		move common.Move
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	var checkedStorage common.PieceStorage
+	board := NewGeometryBoard(
+		NewSliceBoard(common.Size{3, 3}, []common.Piece{
+			MockPiece{
+				position: common.Position{1, 1},
+				checkMove: func(move common.Move, storage common.PieceStorage) bool {
+					checkedStorage = storage
+					return true
+				},
+			},
+		}),
+		common.Hexagonal{},
+	)
+	for _, data := range []data{
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{1, 1},
+					Finish: common.Position{0, 2},
+				},
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{1, 1},
+					Finish: common.Position{2, 2},
+				},
+			},
+			want: common.ErrOutOfSize,
+		},
+	} {
+		checkedStorage = nil
+		got := board.CheckMove(data.args.move)
+
+		if got != data.want {
+			test.Fail()
+		}
+		if data.want == nil && !reflect.DeepEqual(checkedStorage, board) {
+			test.Fail()
+		}
+	}
+}
+
+func TestGeometryBoardApplyMove(test *testing.T) {
+	board := NewGeometryBoard(
+		NewMapBoard(common.Size{3, 3}, []common.Piece{
+			MockPiece{position: common.Position{1, 1}},
+		}),
+		common.Hexagonal{},
+	)
+	nextBoard := board.ApplyMove(common.Move{
+		Start:  common.Position{1, 1},
+		Finish: common.Position{0, 2},
+	})
+
+	expectedNextBoard := NewGeometryBoard(
+		NewMapBoard(common.Size{3, 3}, []common.Piece{
+			MockPiece{position: common.Position{0, 2}},
+		}),
+		common.Hexagonal{},
+	)
+	if !reflect.DeepEqual(nextBoard, expectedNextBoard) {
+		test.Fail()
+	}
+}
+
+func TestGeometryBoardSetPiece(test *testing.T) {
+	board := NewGeometryBoard(
+		NewMapBoard(common.Size{3, 3}, nil),
+		common.Hexagonal{},
+	)
+	nextBoard := board.SetPiece(MockPiece{position: common.Position{1, 1}})
+
+	expectedNextBoard := NewGeometryBoard(
+		NewMapBoard(common.Size{3, 3}, []common.Piece{
+			MockPiece{position: common.Position{1, 1}},
+		}),
+		common.Hexagonal{},
+	)
+	if !reflect.DeepEqual(nextBoard, expectedNextBoard) {
+		test.Fail()
+	}
+}
+
+func TestGeometryBoardSetPiece_withHole(test *testing.T) {
+	mask := common.NewMask([]common.Position{{1, 1}})
+	board := NewGeometryBoard(NewMapBoard(common.Size{3, 3}, nil), mask)
+	nextBoard := board.SetPiece(MockPiece{position: common.Position{1, 1}})
+
+	if !reflect.DeepEqual(nextBoard, board) {
+		test.Fail()
+	}
+}
+
+func TestGeometryBoardRemovePiece(test *testing.T) {
+	board := NewGeometryBoard(
+		NewMapBoard(common.Size{3, 3}, []common.Piece{
+			MockPiece{position: common.Position{1, 1}},
+		}),
+		common.Hexagonal{},
+	)
+	nextBoard := board.RemovePiece(common.Position{1, 1})
+
+	expectedNextBoard := NewGeometryBoard(
+		NewMapBoard(common.Size{3, 3}, nil),
+		common.Hexagonal{},
+	)
+	if !reflect.DeepEqual(nextBoard, expectedNextBoard) {
+		test.Fail()
+	}
+}
@@ -15,14 +15,7 @@ type MapBoard struct {
 
 // NewMapBoard ...
 func NewMapBoard(size common.Size, pieces []common.Piece) common.PieceStorage {
-	pieceGroup := make(pieceGroup, len(pieces))
-	for _, piece := range pieces {
-		pieceGroup[piece.Position()] = piece
-	}
-
-	baseBoard := NewBaseBoard(size)
-	mapBoard := MapBoard{baseBoard, pieceGroup}
-	return WrapBasePieceStorage(mapBoard)
+	return WrapBasePieceStorage(newMapBoard(size, pieces))
 }
 
 // common.Piece ...
@@ -38,6 +31,34 @@ func (board MapBoard) Piece(position common.Position) (
 //
 // It doesn't check that the move is correct.
 func (board MapBoard) ApplyMove(move common.Move) common.PieceStorage {
+	return WrapBasePieceStorage(board.applyMove(move))
+}
+
+// SetPiece ...
+//
+// It replaces a piece on the same position, if any.
+func (board MapBoard) SetPiece(piece common.Piece) common.PieceStorage {
+	return WrapBasePieceStorage(board.setPiece(piece))
+}
+
+// RemovePiece ...
+func (board MapBoard) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return WrapBasePieceStorage(board.removePiece(position))
+}
+
+func newMapBoard(size common.Size, pieces []common.Piece) MapBoard {
+	pieceGroup := make(pieceGroup, len(pieces))
+	for _, piece := range pieces {
+		pieceGroup[piece.Position()] = piece
+	}
+
+	baseBoard := NewBaseBoard(size)
+	return MapBoard{baseBoard, pieceGroup}
+}
+
+func (board MapBoard) applyMove(move common.Move) MapBoard {
 	piece := board.pieces[move.Start]
 	movedPiece := piece.ApplyPosition(move.Finish)
 
@@ -48,6 +69,28 @@ func (board MapBoard) ApplyMove(move common.Move) common.PieceStorage {
 		}
 	}
 
-	mapBoard := MapBoard{board.BaseBoard, pieceGroupCopy}
-	return WrapBasePieceStorage(mapBoard)
+	return MapBoard{board.BaseBoard, pieceGroupCopy}
+}
+
+func (board MapBoard) setPiece(piece common.Piece) MapBoard {
+	pieceGroupCopy := board.copyPieces(piece.Position())
+	pieceGroupCopy[piece.Position()] = piece
+
+	return MapBoard{board.BaseBoard, pieceGroupCopy}
+}
+
+func (board MapBoard) removePiece(position common.Position) MapBoard {
+	pieceGroupCopy := board.copyPieces(position)
+	return MapBoard{board.BaseBoard, pieceGroupCopy}
+}
+
+func (board MapBoard) copyPieces(excludedPosition common.Position) pieceGroup {
+	pieceGroupCopy := make(pieceGroup, len(board.pieces))
+	for position, piece := range board.pieces {
+		if position != excludedPosition {
+			pieceGroupCopy[position] = piece
+		}
+	}
+
+	return pieceGroupCopy
 }
@@ -55,3 +55,31 @@ func (board SliceBoard) ApplyMove(move common.Move) common.PieceStorage {
 	sliceBoard := SliceBoard{board.BaseBoard, pieceGroupCopy}
 	return WrapBasePieceStorage(sliceBoard)
 }
+
+// SetPiece ...
+//
+// It replaces a piece on the same position, if any.
+func (board SliceBoard) SetPiece(piece common.Piece) common.PieceStorage {
+	return board.setPiece(piece.Position(), piece)
+}
+
+// RemovePiece ...
+func (board SliceBoard) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return board.setPiece(position, nil)
+}
+
+func (board SliceBoard) setPiece(
+	position common.Position,
+	piece common.Piece,
+) common.PieceStorage {
+	pieceGroupCopy := make([]common.Piece, len(board.pieces))
+	copy(pieceGroupCopy, board.pieces)
+
+	positionIndex := board.Size().PositionIndex(position)
+	pieceGroupCopy[positionIndex] = piece
+
+	sliceBoard := SliceBoard{board.BaseBoard, pieceGroupCopy}
+	return WrapBasePieceStorage(sliceBoard)
+}
@@ -175,3 +175,114 @@ func TestSliceBoardApplyMove(test *testing.T) {
 		}
 	}
 }
+
+func TestSliceBoardSetPiece(test *testing.T) {
+	type args struct {
+		piece common.Piece
+	}
+	type data struct {
+		args       args
+		wantPieces []common.Piece
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				piece: MockPiece{
+					kind:     common.Rook,
+					color:    common.White,
+					position: common.Position{1, 2},
+				},
+			},
+			wantPieces: []common.Piece{
+				MockPiece{
+					kind:     common.Rook,
+					color:    common.White,
+					position: common.Position{1, 2},
+				},
+				MockPiece{
+					kind:     common.Queen,
+					color:    common.White,
+					position: common.Position{4, 2},
+				},
+				MockPiece{
+					kind:     common.King,
+					color:    common.Black,
+					position: common.Position{2, 3},
+				},
+			},
+		},
+		{
+			args: args{
+				piece: MockPiece{
+					kind:     common.Rook,
+					color:    common.White,
+					position: common.Position{2, 3},
+				},
+			},
+			wantPieces: []common.Piece{
+				MockPiece{
+					kind:     common.Queen,
+					color:    common.White,
+					position: common.Position{4, 2},
+				},
+				MockPiece{
+					kind:     common.Rook,
+					color:    common.White,
+					position: common.Position{2, 3},
+				},
+			},
+		},
+	} {
+		board := NewSliceBoard(common.Size{5, 5}, makeTestPieces())
+		gotNextBoard := board.SetPiece(data.args.piece)
+
+		if !reflect.DeepEqual(gotNextBoard.Pieces(), data.wantPieces) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(board.Pieces(), makeTestPieces()) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSliceBoardRemovePiece(test *testing.T) {
+	type args struct {
+		position common.Position
+	}
+	type data struct {
+		args       args
+		wantPieces []common.Piece
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				position: common.Position{2, 3},
+			},
+			wantPieces: []common.Piece{
+				MockPiece{
+					kind:     common.Queen,
+					color:    common.White,
+					position: common.Position{4, 2},
+				},
+			},
+		},
+		{
+			args: args{
+				position: common.Position{1, 2},
+			},
+			wantPieces: makeTestPieces(),
+		},
+	} {
+		board := NewSliceBoard(common.Size{5, 5}, makeTestPieces())
+		gotNextBoard := board.RemovePiece(data.args.position)
+
+		if !reflect.DeepEqual(gotNextBoard.Pieces(), data.wantPieces) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(board.Pieces(), makeTestPieces()) {
+			test.Fail()
+		}
+	}
+}
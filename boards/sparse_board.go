@@ -0,0 +1,153 @@
+package boards
+
+import (
+	"sort"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// SparseBoard ...
+//
+// It's a map board, which is sparse (see the common.SparsenessChecker
+// interface), so its size can be huge (e.g. 1000x1000) as long as pieces
+// implement the common.Reacher interface.
+//
+// Optionally, it limits its cells by a horizon, i.e. by a box around
+// its pieces extended by a margin (see the common.Box geometry). The horizon
+// is recalculated after each change of the board and makes a number of moves
+// of unlimited riders finite, so an effectively infinite board
+// becomes practical for analysis.
+type SparseBoard struct {
+	MapBoard
+
+	margin  int // a negative margin means no horizon
+	horizon common.Box
+}
+
+// NewSparseBoard ...
+func NewSparseBoard(
+	size common.Size,
+	pieces []common.Piece,
+) common.PieceStorage {
+	return SparseBoard{MapBoard: newMapBoard(size, pieces), margin: -1}
+}
+
+// NewSparseBoardWithHorizon ...
+//
+// The margin should be greater than or equal to zero.
+func NewSparseBoardWithHorizon(
+	size common.Size,
+	pieces []common.Piece,
+	margin int,
+) common.PieceStorage {
+	board := SparseBoard{margin: margin}
+	return board.update(newMapBoard(size, pieces))
+}
+
+// IsSparse ...
+func (board SparseBoard) IsSparse() bool {
+	return true
+}
+
+// Geometry ...
+//
+// It returns the horizon if it's used and the rectangular geometry otherwise.
+func (board SparseBoard) Geometry() common.Geometry {
+	if board.margin < 0 {
+		return common.Rectangular{}
+	}
+
+	return board.horizon
+}
+
+// Pieces ...
+//
+// It iterates over pieces only, not over all positions, and returns them
+// in the same order as the common.Pieces() function.
+func (board SparseBoard) Pieces() []common.Piece {
+	pieces := make([]common.Piece, 0, len(board.pieces))
+	for _, piece := range board.pieces {
+		pieces = append(pieces, piece)
+	}
+
+	sort.Slice(pieces, func(i int, j int) bool {
+		a, b := pieces[i].Position(), pieces[j].Position()
+		if a.Rank != b.Rank {
+			return a.Rank < b.Rank
+		}
+
+		return a.File < b.File
+	})
+
+	return pieces
+}
+
+// CheckMove ...
+//
+// It doesn't check for a check before or after the move.
+func (board SparseBoard) CheckMove(move common.Move) error {
+	return common.CheckMove(board, move)
+}
+
+// ApplyMove ...
+//
+// It doesn't check that the move is correct.
+func (board SparseBoard) ApplyMove(move common.Move) common.PieceStorage {
+	return board.update(board.applyMove(move))
+}
+
+// SetPiece ...
+//
+// It replaces a piece on the same position, if any. The horizon is extended
+// to the piece, if it's used.
+func (board SparseBoard) SetPiece(piece common.Piece) common.PieceStorage {
+	return board.update(board.setPiece(piece))
+}
+
+// RemovePiece ...
+func (board SparseBoard) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return board.update(board.removePiece(position))
+}
+
+func (board SparseBoard) update(mapBoard MapBoard) common.PieceStorage {
+	board.MapBoard = mapBoard
+	if board.margin >= 0 {
+		board.horizon = makeHorizon(mapBoard.pieces, board.margin)
+	}
+
+	return board
+}
+
+func makeHorizon(pieces pieceGroup, margin int) common.Box {
+	var horizon common.Box
+	isFirst := true
+	for position := range pieces {
+		if isFirst {
+			horizon = common.Box{Min: position, Max: position}
+			isFirst = false
+
+			continue
+		}
+
+		if position.File < horizon.Min.File {
+			horizon.Min.File = position.File
+		}
+		if position.File > horizon.Max.File {
+			horizon.Max.File = position.File
+		}
+		if position.Rank < horizon.Min.Rank {
+			horizon.Min.Rank = position.Rank
+		}
+		if position.Rank > horizon.Max.Rank {
+			horizon.Max.Rank = position.Rank
+		}
+	}
+
+	horizon.Min.File -= margin
+	horizon.Min.Rank -= margin
+	horizon.Max.File += margin
+	horizon.Max.Rank += margin
+	return horizon
+}
@@ -0,0 +1,223 @@
+package boards
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestNewSparseBoard(test *testing.T) {
+	board := NewSparseBoard(common.Size{5, 5}, []common.Piece{
+		MockPiece{position: common.Position{2, 3}},
+		MockPiece{position: common.Position{4, 2}},
+	})
+
+	expectedBoard := SparseBoard{
+		MapBoard: MapBoard{
+			BaseBoard: NewBaseBoard(common.Size{5, 5}),
+
+			pieces: pieceGroup{
+				common.Position{2, 3}: MockPiece{
+					position: common.Position{2, 3},
+				},
+				common.Position{4, 2}: MockPiece{
+					position: common.Position{4, 2},
+				},
+			},
+		},
+		margin: -1,
+	}
+	if !reflect.DeepEqual(board, expectedBoard) {
+		test.Fail()
+	}
+}
+
+func TestNewSparseBoardWithHorizon(test *testing.T) {
+	board := NewSparseBoardWithHorizon(
+		common.Size{1000, 1000},
+		[]common.Piece{
+			MockPiece{position: common.Position{20, 30}},
+			MockPiece{position: common.Position{40, 20}},
+		},
+		2,
+	)
+
+	expectedHorizon := common.Box{
+		Min: common.Position{18, 18},
+		Max: common.Position{42, 32},
+	}
+	if !reflect.DeepEqual(board.(SparseBoard).horizon, expectedHorizon) {
+		test.Fail()
+	}
+}
+
+func TestSparseBoardIsSparse(test *testing.T) {
+	board := NewSparseBoard(common.Size{5, 5}, nil)
+
+	if !common.IsSparse(board) {
+		test.Fail()
+	}
+}
+
+func TestSparseBoardGeometry(test *testing.T) {
+	type args struct {
+		board common.PieceStorage
+	}
+	type data struct {
+		args args
+		want common.Geometry
+	}
+
+	pieces := []common.Piece{MockPiece{position: common.Position{1, 2}}}
+	for _, data := range []data{
+		{
+			args: args{NewSparseBoard(common.Size{5, 5}, pieces)},
+			want: common.Rectangular{},
+		},
+		{
+			args: args{NewSparseBoardWithHorizon(common.Size{5, 5}, pieces, 1)},
+			want: common.Box{
+				Min: common.Position{0, 1},
+				Max: common.Position{2, 3},
+			},
+		},
+	} {
+		got := common.StorageGeometry(data.args.board)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSparseBoardPieces(test *testing.T) {
+	board := NewSparseBoard(common.Size{5, 5}, []common.Piece{
+		MockPiece{position: common.Position{2, 3}},
+		MockPiece{position: common.Position{4, 2}},
+		MockPiece{position: common.Position{1, 3}},
+	})
+	pieces := board.Pieces()
+
+	expectedPieces := []common.Piece{
+		MockPiece{position: common.Position{4, 2}},
+		MockPiece{position: common.Position{1, 3}},
+		MockPiece{position: common.Position{2, 3}},
+	}
+	if !reflect.DeepEqual(pieces, expectedPieces) {
+		test.Fail()
+	}
+}
+
+func TestSparseBoardCheckMove(test *testing.T) {
+	type args struct {
+		move common.Move
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	board := NewSparseBoardWithHorizon(
+		common.Size{1000, 1000},
+		[]common.Piece{
+			MockPiece{
+				position: common.Position{10, 10},
+				checkMove: func(move common.Move, storage common.PieceStorage) bool {
+					return true
+				},
+			},
+		},
+		2,
+	)
+	for _, data := range []data{
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{10, 10},
+					Finish: common.Position{12, 8},
+				},
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{10, 10},
+					Finish: common.Position{13, 10},
+				},
+			},
+			want: common.ErrOutOfSize,
+		},
+	} {
+		got := board.CheckMove(data.args.move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestSparseBoardApplyMove(test *testing.T) {
+	board := NewSparseBoardWithHorizon(
+		common.Size{1000, 1000},
+		[]common.Piece{
+			MockPiece{position: common.Position{10, 10}},
+			MockPiece{position: common.Position{20, 20}},
+		},
+		2,
+	)
+	nextBoard := board.ApplyMove(common.Move{
+		Start:  common.Position{20, 20},
+		Finish: common.Position{22, 8},
+	})
+
+	expectedNextBoard := NewSparseBoardWithHorizon(
+		common.Size{1000, 1000},
+		[]common.Piece{
+			MockPiece{position: common.Position{10, 10}},
+			MockPiece{position: common.Position{22, 8}},
+		},
+		2,
+	)
+	if !reflect.DeepEqual(nextBoard, expectedNextBoard) {
+		test.Fail()
+	}
+
+	expectedHorizon := common.Box{
+		Min: common.Position{8, 6},
+		Max: common.Position{24, 12},
+	}
+	if !reflect.DeepEqual(nextBoard.(SparseBoard).horizon, expectedHorizon) {
+		test.Fail()
+	}
+}
+
+func TestSparseBoardSetPiece(test *testing.T) {
+	board := NewSparseBoardWithHorizon(common.Size{1000, 1000}, nil, 2)
+	nextBoard := board.SetPiece(MockPiece{position: common.Position{500, 500}})
+
+	expectedNextBoard := NewSparseBoardWithHorizon(
+		common.Size{1000, 1000},
+		[]common.Piece{MockPiece{position: common.Position{500, 500}}},
+		2,
+	)
+	if !reflect.DeepEqual(nextBoard, expectedNextBoard) {
+		test.Fail()
+	}
+	if !common.HasPosition(nextBoard, common.Position{502, 498}) {
+		test.Fail()
+	}
+}
+
+func TestSparseBoardRemovePiece(test *testing.T) {
+	board := NewSparseBoard(common.Size{5, 5}, []common.Piece{
+		MockPiece{position: common.Position{1, 1}},
+	})
+	nextBoard := board.RemovePiece(common.Position{1, 1})
+
+	expectedNextBoard := NewSparseBoard(common.Size{5, 5}, nil)
+	if !reflect.DeepEqual(nextBoard, expectedNextBoard) {
+		test.Fail()
+	}
+}
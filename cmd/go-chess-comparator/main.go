@@ -9,12 +9,12 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/thewizardplusplus/go-chess-cli/encoding/ascii"
-	models "github.com/thewizardplusplus/go-chess-models"
 	"github.com/thewizardplusplus/go-chess-models/boards"
 	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/ascii"
 	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
 	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants"
 )
 
 type namedPieceStorageFactory struct {
@@ -28,6 +28,7 @@ type namedPieceStorage struct {
 }
 
 type state struct {
+	variant            variants.Variant
 	namedPieceStorages []namedPieceStorage
 	color              common.Color
 	currentDeep        int
@@ -39,66 +40,94 @@ type moveGenerator interface {
 		[]common.Move,
 		error,
 	)
+	ApplyMove(storage common.PieceStorage, move common.Move) common.PieceStorage
+	NextColor(storage common.PieceStorage, color common.Color) common.Color
 }
 
-var namedPieceStorageFactories = []namedPieceStorageFactory{
-	{
-		name:    "MapBoard",
-		factory: boards.NewMapBoard,
-	},
-	{
-		name:    "SliceBoard",
-		factory: boards.NewSliceBoard,
-	},
-	{
-		name: "BitBoard",
-		factory: func(
-			size common.Size,
-			pieceGroup []common.Piece,
-		) common.PieceStorage {
-			return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+func newNamedPieceStorageFactories(
+	pieceFactory common.PieceFactory,
+) []namedPieceStorageFactory {
+	return []namedPieceStorageFactory{
+		{
+			name:    "MapBoard",
+			factory: boards.NewMapBoard,
 		},
-	},
+		{
+			name:    "SliceBoard",
+			factory: boards.NewSliceBoard,
+		},
+		{
+			name: "BitBoard",
+			factory: func(
+				size common.Size,
+				pieceGroup []common.Piece,
+			) common.PieceStorage {
+				return boards.NewBitBoard(size, pieceGroup, pieceFactory)
+			},
+		},
+	}
 }
 
 func main() {
-	fen := flag.String("fen", "rnbqk/ppppp/5/PPPPP/RNBQK",
-		"board in Forsyth-Edwards Notation (default: Gardner's minichess)")
-	color := flag.String("color", "white",
-		"color that moves first (allowed: black, white)")
+	variantName := flag.String("variant", "gardner", fmt.Sprintf(
+		"variant preset (allowed: %s)",
+		strings.Join(variants.Names(), ", "),
+	))
+	fen := flag.String("fen", "",
+		"board in Forsyth-Edwards Notation (default: the variant initial position)")
+	color := flag.String("color", "",
+		"color that moves first (allowed: black, white, red, blue, yellow, green; "+
+			"default: the variant first color)")
 	mode := flag.String("mode", "depth-first",
 		"comparing mode (allowed: depth-first, breadth-first)")
 	deep := flag.Int("deep", 5,
 		"analysis deep (should be greater than or equal to zero)")
 	flag.Parse()
 
+	variant, ok := variants.Lookup(*variantName)
+	if !ok {
+		log.Fatal("incorrect variant")
+	}
+	if *fen == "" {
+		*fen = variant.InitialFEN
+	}
+	if *color == "" {
+		*color = ascii.EncodeColor(variant.FirstColor())
+	}
+
+	parsedColor, err := ascii.DecodeColor(*color)
+	if err != nil {
+		log.Fatalf("unable to decode the color: %s", err)
+	}
+
+	pieceFactory := variant.WrapPieceFactory(pieces.NewPiece)
+	namedPieceStorageFactories := newNamedPieceStorageFactories(pieceFactory)
+
 	var namedPieceStorages []namedPieceStorage
 	for _, namedPieceStorageFactory := range namedPieceStorageFactories {
 		storageName, storageFactory :=
 			namedPieceStorageFactory.name, namedPieceStorageFactory.factory
-		storage, err := uci.DecodePieceStorage(*fen, pieces.NewPiece, storageFactory)
+		storage, err :=
+			variant.DecodePieceStorage(*fen, pieceFactory, storageFactory)
 		if err != nil {
 			const message = "unable to decode the board to the %q storage: %s"
 			log.Fatalf(message, storageName, err)
 		}
 
+		storage = variant.WrapPieceStorage(storage, pieceFactory, parsedColor)
 		namedPieceStorages = append(namedPieceStorages, namedPieceStorage{
 			name:    storageName,
 			storage: storage,
 		})
 	}
 
-	parsedColor, err := ascii.DecodeColor(*color)
-	if err != nil {
-		log.Fatalf("unable to decode the color: %s", err)
-	}
-
 	if *deep < 0 {
 		log.Fatal("incorrect analysis deep")
 	}
 
-	var generator models.MoveGenerator
+	generator := variant.NewMoveGenerator()
 	initialState := state{
+		variant:            variant,
 		namedPieceStorages: namedPieceStorages,
 		color:              parsedColor,
 		currentDeep:        0,
@@ -142,9 +171,10 @@ func handleCurrentState(
 		currentState.currentDeep,
 		ascii.EncodeColor(currentState.color),
 	)
+	variant := currentState.variant
 	for _, namedPieceStorage := range currentState.namedPieceStorages {
 		log.Printf("  * piece storage kind: %s", namedPieceStorage.name)
-		log.Printf("    FEN: %s", uci.EncodePieceStorage(namedPieceStorage.storage))
+		log.Printf("    FEN: %s", variant.EncodePieceStorage(namedPieceStorage.storage))
 	}
 
 	if currentState.currentDeep == currentState.maximalDeep {
@@ -159,19 +189,25 @@ func handleCurrentState(
 	}
 
 	for _, move := range moves {
-		log.Printf("  apply move %s", uci.EncodeMove(move))
+		log.Printf("  apply move %s", variant.Notation().EncodeMove(move))
 
 		var nextNamedPieceStorages []namedPieceStorage
 		for _, namedPieceStorageInstance := range currentState.namedPieceStorages {
+			nextStorage :=
+				generator.ApplyMove(namedPieceStorageInstance.storage, move)
 			nextNamedPieceStorages = append(nextNamedPieceStorages, namedPieceStorage{
 				name:    namedPieceStorageInstance.name,
-				storage: namedPieceStorageInstance.storage.ApplyMove(move),
+				storage: nextStorage,
 			})
 		}
 
+		// the next color is the same for all piece storages
+		nextColor :=
+			generator.NextColor(nextNamedPieceStorages[0].storage, currentState.color)
 		nextStateHandler(state{
+			variant:            variant,
 			namedPieceStorages: nextNamedPieceStorages,
-			color:              currentState.color.Negative(),
+			color:              nextColor,
 			currentDeep:        currentState.currentDeep + 1,
 			maximalDeep:        currentState.maximalDeep,
 		})
@@ -182,6 +218,8 @@ func generateMoves(
 	generator moveGenerator,
 	currentState state,
 ) ([]common.Move, error) {
+	variant := currentState.variant
+	notation := variant.Notation()
 	var previousNamedPieceStorage namedPieceStorage
 	var previousMoves []common.Move
 	for _, namedPieceStorage := range currentState.namedPieceStorages {
@@ -210,10 +248,10 @@ func generateMoves(
 					"  expected moves: %+v\n"+
 					"  actual moves: %+v",
 				namedPieceStorage.name,
-				uci.EncodePieceStorage(previousNamedPieceStorage.storage),
-				uci.EncodePieceStorage(namedPieceStorage.storage),
-				encodeMoves(previousMoves),
-				encodeMoves(moves),
+				variant.EncodePieceStorage(previousNamedPieceStorage.storage),
+				variant.EncodePieceStorage(namedPieceStorage.storage),
+				encodeMoves(notation, previousMoves),
+				encodeMoves(notation, moves),
 			)
 		}
 
@@ -232,10 +270,10 @@ func less(positionOne common.Position, positionTwo common.Position) bool {
 	return positionOne.File < positionTwo.File
 }
 
-func encodeMoves(moves []common.Move) string {
+func encodeMoves(notation uci.Notation, moves []common.Move) string {
 	var encodedMoves []string
 	for _, move := range moves {
-		encodedMoves = append(encodedMoves, uci.EncodeMove(move))
+		encodedMoves = append(encodedMoves, notation.EncodeMove(move))
 	}
 
 	return strings.Join(encodedMoves, ", ")
@@ -5,24 +5,44 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strings"
 
-	"github.com/thewizardplusplus/go-chess-cli/encoding/ascii"
-	models "github.com/thewizardplusplus/go-chess-models"
 	"github.com/thewizardplusplus/go-chess-models/boards"
 	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/ascii"
 	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
 	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants"
 )
 
 func main() {
 	storageKind := flag.String("storage", "slice",
-		"piece storage kind (allowed: map, slice, bits)")
-	fen := flag.String("fen", "rnbqk/ppppp/5/PPPPP/RNBQK",
-		"board in Forsyth-Edwards Notation (default: Gardner's minichess)")
-	color := flag.String("color", "white",
-		"color that moves first (allowed: black, white)")
+		"piece storage kind (allowed: map, slice, bits, sparse)")
+	variantName := flag.String("variant", "gardner", fmt.Sprintf(
+		"variant preset (allowed: %s)",
+		strings.Join(variants.Names(), ", "),
+	))
+	fen := flag.String("fen", "",
+		"board in Forsyth-Edwards Notation (default: the variant initial position)")
+	color := flag.String("color", "",
+		"color that moves first (allowed: black, white, red, blue, yellow, green; "+
+			"default: the variant first color)")
 	flag.Parse()
 
+	variant, ok := variants.Lookup(*variantName)
+	if !ok {
+		log.Fatal("incorrect variant")
+	}
+	if *fen == "" {
+		*fen = variant.InitialFEN
+	}
+	if *color == "" {
+		*color = ascii.EncodeColor(variant.FirstColor())
+	}
+
+	notation := variant.Notation()
+	pieceFactory := variant.WrapPieceFactory(pieces.NewPiece)
+
 	var pieceStorageFactory uci.PieceStorageFactory
 	switch *storageKind {
 	case "map":
@@ -34,14 +54,16 @@ func main() {
 			size common.Size,
 			pieceGroup []common.Piece,
 		) common.PieceStorage {
-			return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+			return boards.NewBitBoard(size, pieceGroup, pieceFactory)
 		}
+	case "sparse":
+		pieceStorageFactory = boards.NewSparseBoard
 	default:
 		log.Fatal("incorrect piece storage kind")
 	}
 
 	storage, err :=
-		uci.DecodePieceStorage(*fen, pieces.NewPiece, pieceStorageFactory)
+		variant.DecodePieceStorage(*fen, pieceFactory, pieceStorageFactory)
 	if err != nil {
 		log.Fatalf("unable to decode the board: %s", err)
 	}
@@ -51,7 +73,9 @@ func main() {
 		log.Fatalf("unable to decode the color: %s", err)
 	}
 
-	var generator models.MoveGenerator
+	storage = variant.WrapPieceStorage(storage, pieceFactory, parsedColor)
+
+	generator := variant.NewMoveGenerator()
 	moves, err := generator.MovesForColor(storage, parsedColor)
 	if err != nil {
 		log.Fatalf("unable to generate moves: %s", err)
@@ -74,11 +98,11 @@ func main() {
 	fmt.Printf("%d move%s %s generated:\n", len(moves), unitEnding, linkingVerb)
 
 	for _, move := range moves {
-		nextStorage := storage.ApplyMove(move)
+		nextStorage := generator.ApplyMove(storage, move)
 		fmt.Printf(
 			"* %s -> %s\n",
-			uci.EncodeMove(move),
-			uci.EncodePieceStorage(nextStorage),
+			notation.EncodeMove(move),
+			variant.EncodePieceStorage(nextStorage),
 		)
 	}
 }
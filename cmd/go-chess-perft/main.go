@@ -7,22 +7,29 @@ import (
 	"os"
 	"runtime"
 	"runtime/pprof"
+	"strings"
 
-	"github.com/thewizardplusplus/go-chess-cli/encoding/ascii"
 	models "github.com/thewizardplusplus/go-chess-models"
 	"github.com/thewizardplusplus/go-chess-models/boards"
 	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/ascii"
 	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
 	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants"
 )
 
 func main() {
 	storageKind := flag.String("storage", "slice",
-		"piece storage kind (allowed: map, slice, bits)")
-	fen := flag.String("fen", "rnbqk/ppppp/5/PPPPP/RNBQK",
-		"board in Forsyth-Edwards Notation (default: Gardner's minichess)")
-	color := flag.String("color", "white",
-		"color that moves first (allowed: black, white)")
+		"piece storage kind (allowed: map, slice, bits, sparse)")
+	variantName := flag.String("variant", "gardner", fmt.Sprintf(
+		"variant preset (allowed: %s)",
+		strings.Join(variants.Names(), ", "),
+	))
+	fen := flag.String("fen", "",
+		"board in Forsyth-Edwards Notation (default: the variant initial position)")
+	color := flag.String("color", "",
+		"color that moves first (allowed: black, white, red, blue, yellow, green; "+
+			"default: the variant first color)")
 	deep := flag.Int("deep", 5,
 		"analysis deep (should be greater than or equal to zero)")
 	cpuProfile := flag.String("cpuProfile", "", "file for CPU profile writing")
@@ -30,6 +37,19 @@ func main() {
 		"file for memory profile writing")
 	flag.Parse()
 
+	variant, ok := variants.Lookup(*variantName)
+	if !ok {
+		log.Fatal("incorrect variant")
+	}
+	if *fen == "" {
+		*fen = variant.InitialFEN
+	}
+	if *color == "" {
+		*color = ascii.EncodeColor(variant.FirstColor())
+	}
+
+	pieceFactory := variant.WrapPieceFactory(pieces.NewPiece)
+
 	var pieceStorageFactory uci.PieceStorageFactory
 	switch *storageKind {
 	case "map":
@@ -41,14 +61,16 @@ func main() {
 			size common.Size,
 			pieceGroup []common.Piece,
 		) common.PieceStorage {
-			return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+			return boards.NewBitBoard(size, pieceGroup, pieceFactory)
 		}
+	case "sparse":
+		pieceStorageFactory = boards.NewSparseBoard
 	default:
 		log.Fatal("incorrect piece storage kind")
 	}
 
 	storage, err :=
-		uci.DecodePieceStorage(*fen, pieces.NewPiece, pieceStorageFactory)
+		variant.DecodePieceStorage(*fen, pieceFactory, pieceStorageFactory)
 	if err != nil {
 		log.Fatalf("unable to decode the board: %s", err)
 	}
@@ -58,6 +80,8 @@ func main() {
 		log.Fatalf("unable to decode the color: %s", err)
 	}
 
+	storage = variant.WrapPieceStorage(storage, pieceFactory, parsedColor)
+
 	if *deep < 0 {
 		log.Fatal("incorrect analysis deep")
 	}
@@ -79,7 +103,7 @@ func main() {
 		defer pprof.StopCPUProfile()
 	}
 
-	var generator models.MoveGenerator
+	generator := variant.NewMoveGenerator()
 	moveCount := models.Perft(generator, storage, parsedColor, *deep, nil)
 	unitEnding := ""
 	if moveCount != 1 {
@@ -11,6 +11,20 @@ const (
 	ColorCount
 )
 
+// ...
+//
+// These are colors of four-player chess. They aren't counted by ColorCount,
+// so data indexed by colors of two-player games isn't affected by them.
+// AllColorCount counts colors of both kinds.
+const (
+	Red Color = iota + ColorCount
+	Blue
+	Yellow
+	Green
+
+	AllColorCount
+)
+
 // Negative ...
 func (color Color) Negative() Color {
 	if color == Black {
@@ -19,3 +33,42 @@ func (color Color) Negative() Color {
 
 	return Black
 }
+
+// Partner ...
+//
+// It returns the color of the opposite player in four-player chess
+// (i.e. the partner in a team game). Colors of two-player games
+// have no partners, so they're returned as is.
+func (color Color) Partner() Color {
+	switch color {
+	case Red:
+		return Yellow
+	case Yellow:
+		return Red
+	case Blue:
+		return Green
+	case Green:
+		return Blue
+	}
+
+	return color
+}
+
+// Forward ...
+//
+// It returns a step of a pawn of the color, i.e. the direction
+// from the side of the color to the opposite one: white and red pawns move up
+// the ranks, black and yellow ones move down the ranks, blue ones move right
+// along the files and green ones move left along the files.
+func (color Color) Forward() Position {
+	switch color {
+	case Black, Yellow:
+		return Position{File: 0, Rank: -1}
+	case Blue:
+		return Position{File: 1, Rank: 0}
+	case Green:
+		return Position{File: -1, Rank: 0}
+	}
+
+	return Position{File: 0, Rank: 1}
+}
@@ -27,3 +27,83 @@ func TestColorNegative(test *testing.T) {
 		}
 	}
 }
+
+func TestColorPartner(test *testing.T) {
+	type data struct {
+		color Color
+		want  Color
+	}
+
+	for _, data := range []data{
+		{
+			color: Black,
+			want:  Black,
+		},
+		{
+			color: White,
+			want:  White,
+		},
+		{
+			color: Red,
+			want:  Yellow,
+		},
+		{
+			color: Yellow,
+			want:  Red,
+		},
+		{
+			color: Blue,
+			want:  Green,
+		},
+		{
+			color: Green,
+			want:  Blue,
+		},
+	} {
+		got := data.color.Partner()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestColorForward(test *testing.T) {
+	type data struct {
+		color Color
+		want  Position
+	}
+
+	for _, data := range []data{
+		{
+			color: White,
+			want:  Position{File: 0, Rank: 1},
+		},
+		{
+			color: Black,
+			want:  Position{File: 0, Rank: -1},
+		},
+		{
+			color: Red,
+			want:  Position{File: 0, Rank: 1},
+		},
+		{
+			color: Blue,
+			want:  Position{File: 1, Rank: 0},
+		},
+		{
+			color: Yellow,
+			want:  Position{File: 0, Rank: -1},
+		},
+		{
+			color: Green,
+			want:  Position{File: -1, Rank: 0},
+		},
+	} {
+		got := data.color.Forward()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
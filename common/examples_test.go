@@ -0,0 +1,43 @@
+package common_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/pieces/betza"
+)
+
+func ExampleRegisterKind() {
+	var silver common.Kind
+	silverMovement := betza.MustParse("FfW")
+	silver, _ = common.RegisterKind(common.KindDescriptor{
+		Name:   "silver",
+		Symbol: 's',
+		Value:  400,
+		Constructor: func(
+			color common.Color,
+			position common.Position,
+		) common.Piece {
+			return betza.NewPiece(silver, color, position, silverMovement)
+		},
+	})
+
+	storage, _ := uci.DecodePieceStorage(
+		"3k/4/4/S2K",
+		pieces.NewPiece,
+		func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+			return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+		},
+	)
+	piece, _ := storage.Piece(common.Position{File: 0, Rank: 0})
+	descriptor, _ := common.LookupKind(piece.Kind())
+	fmt.Println(descriptor.Name, descriptor.Value)
+	fmt.Println(uci.EncodePieceStorage(storage))
+
+	// Output:
+	// silver 400
+	// 3k/4/4/S2K
+}
@@ -0,0 +1,270 @@
+package common
+
+// Geometry ...
+//
+// It describes which positions inside a size are cells of a board.
+type Geometry interface {
+	HasPosition(size Size, position Position) bool
+}
+
+// GeometryGetter ...
+//
+// It's an optional interface of a BasePieceStorage. Without it,
+// the rectangular geometry is used.
+type GeometryGetter interface {
+	Geometry() Geometry
+}
+
+// Rectangular ...
+//
+// It's the default geometry, where all positions inside a size are cells.
+type Rectangular struct{}
+
+// HasPosition ...
+func (geometry Rectangular) HasPosition(size Size, position Position) bool {
+	return size.HasPosition(position)
+}
+
+// Hexagonal ...
+//
+// It describes a regular hexagon in axial coordinates, where a file is
+// the q axis and a rank is the r axis. The hexagon is inscribed
+// in a square size with an odd side, so its radius is (Width - 1) / 2
+// and its center is the position (radius, radius). E.g. a size 11x11
+// has 91 cells as in Glinski's chess.
+//
+// Six orthogonal directions are (0, 1), (1, 0), (1, -1) and opposite ones;
+// six diagonal directions are (1, 1), (2, -1), (1, -2) and opposite ones.
+type Hexagonal struct{}
+
+// HasPosition ...
+func (geometry Hexagonal) HasPosition(size Size, position Position) bool {
+	if !size.HasPosition(position) {
+		return false
+	}
+
+	radius := HexagonRadius(size)
+	sum := position.File + position.Rank - 2*radius
+	return sum >= -radius && sum <= radius
+}
+
+// Mask ...
+//
+// It's a rectangular geometry with disabled positions (holes), which pieces
+// can neither stand on nor pass through. It describes non-rectangular boards
+// (e.g. the cross board of four-player chess) and boards with holes.
+type Mask struct {
+	holes map[Position]struct{}
+}
+
+// NewMask ...
+func NewMask(holes []Position) Mask {
+	holeSet := make(map[Position]struct{}, len(holes))
+	for _, hole := range holes {
+		holeSet[hole] = struct{}{}
+	}
+
+	return Mask{holeSet}
+}
+
+// IsHole ...
+func (mask Mask) IsHole(position Position) bool {
+	_, ok := mask.holes[position]
+	return ok
+}
+
+// HasPosition ...
+func (mask Mask) HasPosition(size Size, position Position) bool {
+	return size.HasPosition(position) && !mask.IsHole(position)
+}
+
+// Topology ...
+//
+// It's an optional interface of a Geometry. It describes edges of a board,
+// which pieces can move across: if files are wrapped, the first file
+// is adjacent to the last one, and if ranks are wrapped, the first rank
+// is adjacent to the last one.
+type Topology interface {
+	WrapsFiles() bool
+	WrapsRanks() bool
+}
+
+// Cylinder ...
+//
+// It's a rectangular geometry, where files are wrapped
+// (see the Topology interface), as in cylinder chess.
+type Cylinder struct{}
+
+// HasPosition ...
+func (geometry Cylinder) HasPosition(size Size, position Position) bool {
+	return size.HasPosition(position)
+}
+
+// WrapsFiles ...
+func (geometry Cylinder) WrapsFiles() bool {
+	return true
+}
+
+// WrapsRanks ...
+func (geometry Cylinder) WrapsRanks() bool {
+	return false
+}
+
+// Torus ...
+//
+// It's a rectangular geometry, where both files and ranks are wrapped
+// (see the Topology interface), as in toroidal chess.
+type Torus struct{}
+
+// HasPosition ...
+func (geometry Torus) HasPosition(size Size, position Position) bool {
+	return size.HasPosition(position)
+}
+
+// WrapsFiles ...
+func (geometry Torus) WrapsFiles() bool {
+	return true
+}
+
+// WrapsRanks ...
+func (geometry Torus) WrapsRanks() bool {
+	return true
+}
+
+// Box ...
+//
+// It's a rectangular geometry limited by a box, where cells are positions
+// inside both a size and the box (including its borders). It makes
+// a number of moves of unlimited riders finite on huge boards.
+type Box struct {
+	Min Position
+	Max Position
+}
+
+// HasPosition ...
+func (geometry Box) HasPosition(size Size, position Position) bool {
+	return size.HasPosition(position) &&
+		position.File >= geometry.Min.File &&
+		position.File <= geometry.Max.File &&
+		position.Rank >= geometry.Min.Rank &&
+		position.Rank <= geometry.Max.Rank
+}
+
+// WrapPosition ...
+//
+// It moves the position inside the size across edges wrapped
+// by the topology. Coordinates along unwrapped edges stay as is.
+func WrapPosition(size Size, topology Topology, position Position) Position {
+	if topology.WrapsFiles() {
+		position.File = wrapCoordinate(position.File, size.Width)
+	}
+	if topology.WrapsRanks() {
+		position.Rank = wrapCoordinate(position.Rank, size.Height)
+	}
+
+	return position
+}
+
+// HexagonRadius ...
+//
+// It returns a radius of the hexagon inscribed in the size
+// (see the Hexagonal geometry).
+func HexagonRadius(size Size) int {
+	return (size.Width - 1) / 2
+}
+
+// StorageGeometry ...
+//
+// It returns the geometry of the piece storage if it implements
+// the GeometryGetter interface and the rectangular geometry otherwise.
+func StorageGeometry(storage BasePieceStorage) Geometry {
+	if getter, ok := storage.(GeometryGetter); ok {
+		return getter.Geometry()
+	}
+
+	return Rectangular{}
+}
+
+// HasPosition ...
+//
+// It checks that the position is a cell of the piece storage
+// by its geometry.
+func HasPosition(storage BasePieceStorage, position Position) bool {
+	return StorageGeometry(storage).HasPosition(storage.Size(), position)
+}
+
+// HasMove ...
+//
+// It checks that both move positions are cells of the piece storage
+// by its geometry.
+func HasMove(storage BasePieceStorage, move Move) bool {
+	return HasPosition(storage, move.Start) && HasPosition(storage, move.Finish)
+}
+
+// IteratePositions ...
+//
+// It iterates over cells of the piece storage by its geometry.
+func IteratePositions(
+	storage BasePieceStorage,
+	handler PositionHandler,
+) error {
+	size, geometry := storage.Size(), StorageGeometry(storage)
+	return size.IteratePositions(func(position Position) error {
+		if !geometry.HasPosition(size, position) {
+			return nil
+		}
+
+		return handler(position)
+	})
+}
+
+func wrapCoordinate(coordinate int, length int) int {
+	coordinate %= length
+	if coordinate < 0 {
+		coordinate += length
+	}
+
+	return coordinate
+}
+
+// it passes positions to the wrapped storage after moving them inside
+// the board across edges wrapped by the topology, so pieces can check
+// moves with finishes beyond these edges by plain subtraction
+type wrappedPieceStorage struct {
+	PieceStorage
+
+	geometry wrappedGeometry
+}
+
+func newWrappedPieceStorage(
+	storage PieceStorage,
+	geometry Geometry,
+	topology Topology,
+) wrappedPieceStorage {
+	return wrappedPieceStorage{storage, wrappedGeometry{geometry, topology}}
+}
+
+func (storage wrappedPieceStorage) Geometry() Geometry {
+	return storage.geometry
+}
+
+func (storage wrappedPieceStorage) Piece(position Position) (
+	piece Piece,
+	ok bool,
+) {
+	position = WrapPosition(storage.Size(), storage.geometry.topology, position)
+	return storage.PieceStorage.Piece(position)
+}
+
+type wrappedGeometry struct {
+	geometry Geometry
+	topology Topology
+}
+
+func (geometry wrappedGeometry) HasPosition(
+	size Size,
+	position Position,
+) bool {
+	position = WrapPosition(size, geometry.topology, position)
+	return geometry.geometry.HasPosition(size, position)
+}
@@ -0,0 +1,385 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+type MockGeometryPieceStorage struct {
+	MockBasePieceStorage
+
+	geometry Geometry
+}
+
+func (storage MockGeometryPieceStorage) Geometry() Geometry {
+	return storage.geometry
+}
+
+func TestHexagonalHasPosition(test *testing.T) {
+	type args struct {
+		size     Size
+		position Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{Size{11, 11}, Position{5, 5}},
+			want: true,
+		},
+		{
+			args: args{Size{11, 11}, Position{0, 5}},
+			want: true,
+		},
+		{
+			args: args{Size{11, 11}, Position{5, 0}},
+			want: true,
+		},
+		{
+			args: args{Size{11, 11}, Position{10, 0}},
+			want: true,
+		},
+		{
+			args: args{Size{11, 11}, Position{0, 10}},
+			want: true,
+		},
+		{
+			args: args{Size{11, 11}, Position{10, 5}},
+			want: true,
+		},
+		{
+			args: args{Size{11, 11}, Position{0, 4}},
+			want: false,
+		},
+		{
+			args: args{Size{11, 11}, Position{10, 6}},
+			want: false,
+		},
+		{
+			args: args{Size{11, 11}, Position{0, 0}},
+			want: false,
+		},
+		{
+			args: args{Size{11, 11}, Position{10, 10}},
+			want: false,
+		},
+		{
+			args: args{Size{11, 11}, Position{11, 0}},
+			want: false,
+		},
+		{
+			args: args{Size{11, 11}, Position{-1, 6}},
+			want: false,
+		},
+		{
+			args: args{Size{3, 3}, Position{0, 2}},
+			want: true,
+		},
+		{
+			args: args{Size{3, 3}, Position{2, 2}},
+			want: false,
+		},
+	} {
+		got := Hexagonal{}.HasPosition(data.args.size, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestMaskHasPosition(test *testing.T) {
+	type args struct {
+		position Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	mask := NewMask([]Position{{0, 0}, {2, 1}})
+	for _, data := range []data{
+		{
+			args: args{Position{1, 0}},
+			want: true,
+		},
+		{
+			args: args{Position{2, 2}},
+			want: true,
+		},
+		{
+			args: args{Position{0, 0}},
+			want: false,
+		},
+		{
+			args: args{Position{2, 1}},
+			want: false,
+		},
+		{
+			args: args{Position{3, 1}},
+			want: false,
+		},
+	} {
+		got := mask.HasPosition(Size{3, 3}, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestMaskIsHole(test *testing.T) {
+	mask := NewMask([]Position{{0, 0}, {2, 1}})
+
+	if !mask.IsHole(Position{2, 1}) || mask.IsHole(Position{1, 2}) {
+		test.Fail()
+	}
+	if (Mask{}).IsHole(Position{0, 0}) {
+		test.Fail()
+	}
+}
+
+func TestBoxHasPosition(test *testing.T) {
+	type args struct {
+		position Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	box := Box{Min: Position{1, 2}, Max: Position{3, 4}}
+	for _, data := range []data{
+		{
+			args: args{Position{1, 2}},
+			want: true,
+		},
+		{
+			args: args{Position{3, 4}},
+			want: true,
+		},
+		{
+			args: args{Position{0, 3}},
+			want: false,
+		},
+		{
+			args: args{Position{2, 5}},
+			want: false,
+		},
+		{
+			args: args{Position{2, 3}},
+			want: true,
+		},
+	} {
+		got := box.HasPosition(Size{10, 10}, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+
+	if box.HasPosition(Size{3, 10}, Position{3, 3}) {
+		test.Fail()
+	}
+}
+
+func TestStorageGeometry(test *testing.T) {
+	type args struct {
+		storage BasePieceStorage
+	}
+	type data struct {
+		args args
+		want Geometry
+	}
+
+	for _, data := range []data{
+		{
+			args: args{MockBasePieceStorage{}},
+			want: Rectangular{},
+		},
+		{
+			args: args{MockGeometryPieceStorage{geometry: Hexagonal{}}},
+			want: Hexagonal{},
+		},
+	} {
+		got := StorageGeometry(data.args.storage)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestHasMove(test *testing.T) {
+	type args struct {
+		storage BasePieceStorage
+		move    Move
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage: MockBasePieceStorage{size: Size{11, 11}},
+				move:    Move{Start: Position{5, 5}, Finish: Position{0, 0}},
+			},
+			want: true,
+		},
+		{
+			args: args{
+				storage: MockGeometryPieceStorage{
+					MockBasePieceStorage: MockBasePieceStorage{size: Size{11, 11}},
+					geometry:             Hexagonal{},
+				},
+				move: Move{Start: Position{5, 5}, Finish: Position{0, 5}},
+			},
+			want: true,
+		},
+		{
+			args: args{
+				storage: MockGeometryPieceStorage{
+					MockBasePieceStorage: MockBasePieceStorage{size: Size{11, 11}},
+					geometry:             Hexagonal{},
+				},
+				move: Move{Start: Position{5, 5}, Finish: Position{0, 0}},
+			},
+			want: false,
+		},
+		{
+			args: args{
+				storage: MockGeometryPieceStorage{
+					MockBasePieceStorage: MockBasePieceStorage{size: Size{11, 11}},
+					geometry:             Hexagonal{},
+				},
+				move: Move{Start: Position{10, 10}, Finish: Position{5, 5}},
+			},
+			want: false,
+		},
+	} {
+		got := HasMove(data.args.storage, data.args.move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestIteratePositions(test *testing.T) {
+	storage := MockGeometryPieceStorage{
+		MockBasePieceStorage: MockBasePieceStorage{size: Size{3, 3}},
+		geometry:             Hexagonal{},
+	}
+
+	var positions []Position
+	err := IteratePositions(storage, func(position Position) error {
+		positions = append(positions, position)
+		return nil
+	})
+
+	expectedPositions := []Position{
+		{1, 0},
+		{2, 0},
+		{0, 1},
+		{1, 1},
+		{2, 1},
+		{0, 2},
+		{1, 2},
+	}
+	if !reflect.DeepEqual(positions, expectedPositions) {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestIteratePositions_withCellCount(test *testing.T) {
+	storage := MockGeometryPieceStorage{
+		MockBasePieceStorage: MockBasePieceStorage{size: Size{11, 11}},
+		geometry:             Hexagonal{},
+	}
+
+	var count int
+	IteratePositions(storage, func(position Position) error { // nolint: errcheck
+		count++
+		return nil
+	})
+
+	if count != 91 {
+		test.Fail()
+	}
+}
+
+func TestWrapPosition(test *testing.T) {
+	type args struct {
+		topology Topology
+		position Position
+	}
+	type data struct {
+		args args
+		want Position
+	}
+
+	for _, data := range []data{
+		{
+			args: args{Cylinder{}, Position{2, 3}},
+			want: Position{2, 3},
+		},
+		{
+			args: args{Cylinder{}, Position{-1, 3}},
+			want: Position{3, 3},
+		},
+		{
+			args: args{Cylinder{}, Position{5, -1}},
+			want: Position{1, -1},
+		},
+		{
+			args: args{Torus{}, Position{5, -1}},
+			want: Position{1, 3},
+		},
+		{
+			args: args{Torus{}, Position{-4, 8}},
+			want: Position{0, 0},
+		},
+	} {
+		got := WrapPosition(Size{4, 4}, data.args.topology, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestWrappedPieceStorage(test *testing.T) {
+	var gotPosition Position
+	storage := newWrappedPieceStorage(
+		MockPieceStorage{
+			MockBasePieceStorage: MockBasePieceStorage{
+				size: Size{4, 4},
+				piece: func(position Position) (piece Piece, ok bool) {
+					gotPosition = position
+					return nil, false
+				},
+			},
+		},
+		Rectangular{},
+		Cylinder{},
+	)
+	storage.Piece(Position{-1, 2}) // nolint: errcheck
+
+	if gotPosition != (Position{3, 2}) {
+		test.Fail()
+	}
+	if !HasPosition(storage, Position{-1, 2}) {
+		test.Fail()
+	}
+	if HasPosition(storage, Position{1, 4}) {
+		test.Fail()
+	}
+}
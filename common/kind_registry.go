@@ -0,0 +1,138 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"unicode"
+)
+
+// PieceConstructor ...
+type PieceConstructor func(color Color, position Position) Piece
+
+// KindDescriptor ...
+type KindDescriptor struct {
+	Name   string // a lowercase English name (e.g. "knight")
+	Symbol rune   // a lowercase FEN symbol (e.g. 'n')
+	Value  int    // a material value in centipawns
+
+	// It's nil for built-in kinds, they're constructed
+	// by the pieces.NewPiece() function.
+	Constructor PieceConstructor
+}
+
+var (
+	kindRegistry = struct {
+		sync.RWMutex
+
+		descriptors []KindDescriptor
+	}{
+		descriptors: []KindDescriptor{
+			King:       {Name: "king", Symbol: 'k', Value: 0},
+			Queen:      {Name: "queen", Symbol: 'q', Value: 900},
+			Rook:       {Name: "rook", Symbol: 'r', Value: 500},
+			Bishop:     {Name: "bishop", Symbol: 'b', Value: 300},
+			Knight:     {Name: "knight", Symbol: 'n', Value: 300},
+			Pawn:       {Name: "pawn", Symbol: 'p', Value: 100},
+			Archbishop: {Name: "archbishop", Symbol: 'a', Value: 700},
+			Chancellor: {Name: "chancellor", Symbol: 'c', Value: 800},
+			Amazon:     {Name: "amazon", Symbol: 'z', Value: 1200},
+		},
+	}
+)
+
+// RegisterKind ...
+//
+// It registers an additional kind and returns its value. The kind is
+// picked up by the pieces.NewPiece() function, by FEN coding
+// and by the boards.BitBoard storage.
+//
+// The name and the FEN symbol of the kind should be unique. The FEN symbol
+// should be a lowercase letter.
+func RegisterKind(descriptor KindDescriptor) (Kind, error) {
+	if descriptor.Name == "" {
+		return 0, errors.New("empty name")
+	}
+	if !unicode.IsLetter(descriptor.Symbol) ||
+		!unicode.IsLower(descriptor.Symbol) {
+		return 0, errors.New("incorrect symbol")
+	}
+	if descriptor.Constructor == nil {
+		return 0, errors.New("no constructor")
+	}
+
+	kindRegistry.Lock()
+	defer kindRegistry.Unlock()
+
+	for _, registeredDescriptor := range kindRegistry.descriptors {
+		if registeredDescriptor.Name == descriptor.Name {
+			return 0, errors.New("duplicate name")
+		}
+		if registeredDescriptor.Symbol == descriptor.Symbol {
+			return 0, errors.New("duplicate symbol")
+		}
+	}
+
+	kind := Kind(len(kindRegistry.descriptors))
+	kindRegistry.descriptors = append(kindRegistry.descriptors, descriptor)
+
+	return kind, nil
+}
+
+// Kinds ...
+//
+// It returns all kinds: built-in and registered ones.
+func Kinds() []Kind {
+	kindRegistry.RLock()
+	defer kindRegistry.RUnlock()
+
+	kinds := make([]Kind, len(kindRegistry.descriptors))
+	for index := range kinds {
+		kinds[index] = Kind(index)
+	}
+
+	return kinds
+}
+
+// LookupKind ...
+func LookupKind(kind Kind) (descriptor KindDescriptor, ok bool) {
+	kindRegistry.RLock()
+	defer kindRegistry.RUnlock()
+
+	if kind < 0 || int(kind) >= len(kindRegistry.descriptors) {
+		return KindDescriptor{}, false
+	}
+
+	return kindRegistry.descriptors[kind], true
+}
+
+// LookupKindByName ...
+func LookupKindByName(name string) (kind Kind, ok bool) {
+	return lookupKindBy(func(descriptor KindDescriptor) bool {
+		return descriptor.Name == name
+	})
+}
+
+// LookupKindBySymbol ...
+//
+// It accepts a FEN symbol in any case.
+func LookupKindBySymbol(symbol rune) (kind Kind, ok bool) {
+	symbol = unicode.ToLower(symbol)
+	return lookupKindBy(func(descriptor KindDescriptor) bool {
+		return descriptor.Symbol == symbol
+	})
+}
+
+func lookupKindBy(
+	predicate func(descriptor KindDescriptor) bool,
+) (kind Kind, ok bool) {
+	kindRegistry.RLock()
+	defer kindRegistry.RUnlock()
+
+	for index, descriptor := range kindRegistry.descriptors {
+		if predicate(descriptor) {
+			return Kind(index), true
+		}
+	}
+
+	return 0, false
+}
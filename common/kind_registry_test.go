@@ -0,0 +1,268 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegisterKind(test *testing.T) {
+	constructor := func(color Color, position Position) Piece {
+		return MockPiece{color: color, position: position}
+	}
+
+	type args struct {
+		descriptor KindDescriptor
+	}
+	type data struct {
+		args    args
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				descriptor: KindDescriptor{
+					Name:        "test-kind-one",
+					Symbol:      'ь',
+					Value:       250,
+					Constructor: constructor,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				descriptor: KindDescriptor{
+					Name:        "",
+					Symbol:      'ю',
+					Constructor: constructor,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			args: args{
+				descriptor: KindDescriptor{
+					Name:        "test-kind-two",
+					Symbol:      'Ю',
+					Constructor: constructor,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			args: args{
+				descriptor: KindDescriptor{
+					Name:        "test-kind-two",
+					Symbol:      '#',
+					Constructor: constructor,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			args: args{
+				descriptor: KindDescriptor{
+					Name:   "test-kind-two",
+					Symbol: 'ю',
+				},
+			},
+			wantErr: true,
+		},
+		{
+			args: args{
+				descriptor: KindDescriptor{
+					Name:        "test-kind-one",
+					Symbol:      'ю',
+					Constructor: constructor,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			args: args{
+				descriptor: KindDescriptor{
+					Name:        "test-kind-two",
+					Symbol:      'n',
+					Constructor: constructor,
+				},
+			},
+			wantErr: true,
+		},
+	} {
+		kindsBefore := Kinds()
+		gotKind, gotErr := RegisterKind(data.args.descriptor)
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+		if hasErr {
+			if !reflect.DeepEqual(Kinds(), kindsBefore) {
+				test.Fail()
+			}
+
+			continue
+		}
+
+		if gotKind != Kind(len(kindsBefore)) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(Kinds(), append(kindsBefore, gotKind)) {
+			test.Fail()
+		}
+
+		gotDescriptor, ok := LookupKind(gotKind)
+		if !ok || gotDescriptor.Name != data.args.descriptor.Name ||
+			gotDescriptor.Symbol != data.args.descriptor.Symbol ||
+			gotDescriptor.Value != data.args.descriptor.Value {
+			test.Fail()
+		}
+
+		gotPiece := gotDescriptor.Constructor(White, Position{2, 3})
+		wantPiece := MockPiece{color: White, position: Position{2, 3}}
+		if !reflect.DeepEqual(gotPiece, wantPiece) {
+			test.Fail()
+		}
+	}
+}
+
+func TestKinds(test *testing.T) {
+	kinds := Kinds()
+
+	if len(kinds) < int(KindCount) {
+		test.FailNow()
+	}
+	for index, kind := range kinds {
+		if kind != Kind(index) {
+			test.Fail()
+		}
+	}
+}
+
+func TestLookupKind(test *testing.T) {
+	type args struct {
+		kind Kind
+	}
+	type data struct {
+		args           args
+		wantDescriptor KindDescriptor
+		wantOk         bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{Knight},
+			wantDescriptor: KindDescriptor{
+				Name:   "knight",
+				Symbol: 'n',
+				Value:  300,
+			},
+			wantOk: true,
+		},
+		{
+			args: args{Amazon},
+			wantDescriptor: KindDescriptor{
+				Name:   "amazon",
+				Symbol: 'z',
+				Value:  1200,
+			},
+			wantOk: true,
+		},
+		{
+			args:           args{-1},
+			wantDescriptor: KindDescriptor{},
+			wantOk:         false,
+		},
+		{
+			args:           args{1000},
+			wantDescriptor: KindDescriptor{},
+			wantOk:         false,
+		},
+	} {
+		gotDescriptor, gotOk := LookupKind(data.args.kind)
+
+		if !reflect.DeepEqual(gotDescriptor, data.wantDescriptor) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestLookupKindByName(test *testing.T) {
+	type args struct {
+		name string
+	}
+	type data struct {
+		args     args
+		wantKind Kind
+		wantOk   bool
+	}
+
+	for _, data := range []data{
+		{
+			args:     args{"rook"},
+			wantKind: Rook,
+			wantOk:   true,
+		},
+		{
+			args:     args{"chancellor"},
+			wantKind: Chancellor,
+			wantOk:   true,
+		},
+		{
+			args:     args{"unknown"},
+			wantKind: 0,
+			wantOk:   false,
+		},
+	} {
+		gotKind, gotOk := LookupKindByName(data.args.name)
+
+		if gotKind != data.wantKind {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestLookupKindBySymbol(test *testing.T) {
+	type args struct {
+		symbol rune
+	}
+	type data struct {
+		args     args
+		wantKind Kind
+		wantOk   bool
+	}
+
+	for _, data := range []data{
+		{
+			args:     args{'b'},
+			wantKind: Bishop,
+			wantOk:   true,
+		},
+		{
+			args:     args{'P'},
+			wantKind: Pawn,
+			wantOk:   true,
+		},
+		{
+			args:     args{'x'},
+			wantKind: 0,
+			wantOk:   false,
+		},
+	} {
+		gotKind, gotOk := LookupKindBySymbol(data.args.symbol)
+
+		if gotKind != data.wantKind {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
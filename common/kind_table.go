@@ -0,0 +1,46 @@
+package common
+
+import (
+	"unicode"
+)
+
+// KindTable ...
+//
+// It describes kinds of a variant by their fixed values. Unlike registered
+// kinds (see RegisterKind()), they're visible only to code that gets
+// the table explicitly (e.g. to FEN coding of the variant), so different
+// variants can use the same values and symbols.
+//
+// Values of kinds of a table should be KindCount or greater. They should be
+// small, because storages can index kinds (e.g. boards.BitBoard).
+// Constructors of descriptors aren't used.
+//
+// Kinds, which are absent in the table, are looked up among built-in
+// and registered ones, so a nil table means only them.
+type KindTable map[Kind]KindDescriptor
+
+// LookupKind ...
+func (table KindTable) LookupKind(kind Kind) (
+	descriptor KindDescriptor,
+	ok bool,
+) {
+	if descriptor, ok := table[kind]; ok {
+		return descriptor, true
+	}
+
+	return LookupKind(kind)
+}
+
+// LookupKindBySymbol ...
+//
+// It accepts a FEN symbol in any case.
+func (table KindTable) LookupKindBySymbol(symbol rune) (kind Kind, ok bool) {
+	symbol = unicode.ToLower(symbol)
+	for kind, descriptor := range table {
+		if descriptor.Symbol == symbol {
+			return kind, true
+		}
+	}
+
+	return LookupKindBySymbol(symbol)
+}
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testKindTable = KindTable{
+	KindCount:     {Name: "test-table-kind-one", Symbol: 'ф', Value: 150},
+	KindCount + 1: {Name: "test-table-kind-two", Symbol: 'ц', Value: 250},
+}
+
+func TestKindTableLookupKind(test *testing.T) {
+	type fields struct {
+		table KindTable
+	}
+	type args struct {
+		kind Kind
+	}
+	type data struct {
+		fields         fields
+		args           args
+		wantDescriptor KindDescriptor
+		wantOk         bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{testKindTable},
+			args:   args{KindCount + 1},
+			wantDescriptor: KindDescriptor{
+				Name:   "test-table-kind-two",
+				Symbol: 'ц',
+				Value:  250,
+			},
+			wantOk: true,
+		},
+		{
+			fields: fields{testKindTable},
+			args:   args{Knight},
+			wantDescriptor: KindDescriptor{
+				Name:   "knight",
+				Symbol: 'n',
+				Value:  300,
+			},
+			wantOk: true,
+		},
+		{
+			fields: fields{nil},
+			args:   args{Knight},
+			wantDescriptor: KindDescriptor{
+				Name:   "knight",
+				Symbol: 'n',
+				Value:  300,
+			},
+			wantOk: true,
+		},
+		{
+			fields:         fields{testKindTable},
+			args:           args{1000},
+			wantDescriptor: KindDescriptor{},
+			wantOk:         false,
+		},
+	} {
+		gotDescriptor, gotOk := data.fields.table.LookupKind(data.args.kind)
+
+		if !reflect.DeepEqual(gotDescriptor, data.wantDescriptor) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestKindTableLookupKindBySymbol(test *testing.T) {
+	type fields struct {
+		table KindTable
+	}
+	type args struct {
+		symbol rune
+	}
+	type data struct {
+		fields   fields
+		args     args
+		wantKind Kind
+		wantOk   bool
+	}
+
+	for _, data := range []data{
+		{
+			fields:   fields{testKindTable},
+			args:     args{'ф'},
+			wantKind: KindCount,
+			wantOk:   true,
+		},
+		{
+			fields:   fields{testKindTable},
+			args:     args{'Ц'},
+			wantKind: KindCount + 1,
+			wantOk:   true,
+		},
+		{
+			fields:   fields{testKindTable},
+			args:     args{'P'},
+			wantKind: Pawn,
+			wantOk:   true,
+		},
+		{
+			fields:   fields{nil},
+			args:     args{'ф'},
+			wantKind: 0,
+			wantOk:   false,
+		},
+	} {
+		gotKind, gotOk := data.fields.table.LookupKindBySymbol(data.args.symbol)
+
+		if gotKind != data.wantKind {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
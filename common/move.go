@@ -18,6 +18,16 @@ var (
 type Move struct {
 	Start  Position
 	Finish Position
+
+	// a kind of a piece that a pawn is promoted to;
+	// it's used only if the IsPromotion flag is set
+	Promotion   Kind
+	IsPromotion bool
+
+	// a kind of a piece that is dropped from a hand to the finish;
+	// it's used only if the IsDrop flag is set (the start is ignored then)
+	Drop   Kind
+	IsDrop bool
 }
 
 // IsZero ...
@@ -34,15 +44,157 @@ func (move Move) IsEmpty() bool {
 	return move.Start == move.Finish
 }
 
+// IsPawnPromotion ...
+//
+// It checks that the move takes a pawn to the last rank, i.e. to the edge
+// of the board in the forward direction of its color (see Color.Forward()),
+// regardless of the promotion fields of the move.
+func IsPawnPromotion(storage PieceStorage, move Move) bool {
+	if move.IsDrop {
+		return false
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || piece.Kind() != Pawn {
+		return false
+	}
+
+	size, forward := storage.Size(), piece.Color().Forward()
+	switch {
+	case forward.Rank > 0:
+		return move.Finish.Rank == size.Height-1
+	case forward.Rank < 0:
+		return move.Finish.Rank == 0
+	case forward.File > 0:
+		return move.Finish.File == size.Width-1
+	}
+
+	return move.Finish.File == 0
+}
+
+// Promotions ...
+//
+// It describes promotions of pawns: allowed kinds of promoted pieces
+// and a piece factory that makes them. The factory is used only
+// by ApplyMove().
+//
+// Whether a move takes a pawn to a promotion position depends on a variant
+// (e.g. see IsPawnPromotion()), so methods get it as a flag.
+type Promotions struct {
+	Kinds        []Kind
+	PieceFactory PieceFactory
+}
+
 // CheckMove ...
 //
+// It checks that the move is a promotion if and only if the flag is set
+// and that a kind of a promoted piece is allowed.
+func (promotions Promotions) CheckMove(move Move, isPromotion bool) error {
+	if move.IsPromotion != isPromotion {
+		return ErrIllegalMove
+	}
+	if move.IsPromotion && !promotions.isAllowedKind(move.Promotion) {
+		return ErrIllegalMove
+	}
+
+	return nil
+}
+
+// ExpandMove ...
+//
+// It expands the move to promotions to all allowed kinds if the flag is set.
+// It returns nil if the flag isn't set or the move is already a promotion.
+func (promotions Promotions) ExpandMove(move Move, isPromotion bool) []Move {
+	if move.IsPromotion || !isPromotion {
+		return nil
+	}
+
+	moves := make([]Move, 0, len(promotions.Kinds))
+	for _, kind := range promotions.Kinds {
+		move.Promotion, move.IsPromotion = kind, true
+		moves = append(moves, move)
+	}
+
+	return moves
+}
+
+// ApplyMove ...
+//
+// It applies the move to the storage and replaces a moved piece
+// with a promoted one if the move is a promotion.
+func (promotions Promotions) ApplyMove(
+	storage PieceStorage,
+	move Move,
+) PieceStorage {
+	piece, ok := storage.Piece(move.Start)
+
+	storage = storage.ApplyMove(move)
+	if move.IsPromotion && ok {
+		promotedPiece :=
+			promotions.PieceFactory(move.Promotion, piece.Color(), move.Finish)
+		storage = storage.SetPiece(promotedPiece)
+	}
+
+	return storage
+}
+
+func (promotions Promotions) isAllowedKind(kind Kind) bool {
+	for _, allowedKind := range promotions.Kinds {
+		if allowedKind == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsCheck ...
+//
+// It checks that the color attacks an enemy king,
+// i.e. that a move of the color to the king returns ErrKingCapture.
+func IsCheck(storage PieceStorage, color Color) bool {
+	pieces := storage.Pieces()
+	for _, king := range pieces {
+		if king.Kind() != King || king.Color() == color {
+			continue
+		}
+
+		for _, piece := range pieces {
+			if piece.Color() != color {
+				continue
+			}
+
+			move := Move{Start: piece.Position(), Finish: king.Position()}
+			if storage.CheckMove(move) == ErrKingCapture {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CheckMove ...
+//
+// If the geometry of the storage implements the Topology interface,
+// a piece also can move across wrapped edges of the board. It's checked
+// by passing to the piece the move, whose finish is shifted by the board
+// width and/or height beyond these edges, so the piece never makes
+// more than one lap.
+//
 // It doesn't check for a check before or after the move.
+//
+// It doesn't support drops.
 func CheckMove(storage PieceStorage, move Move) error {
-	if move.IsEmpty() {
+	if move.IsDrop || move.IsEmpty() {
 		return ErrNoMove
 	}
 
-	if !storage.Size().HasMove(move) {
+	// the geometry is got once, because this function is a hot path
+	// of a move generating
+	size, geometry := storage.Size(), StorageGeometry(storage)
+	if !geometry.HasPosition(size, move.Start) ||
+		!geometry.HasPosition(size, move.Finish) {
 		return ErrOutOfSize
 	}
 
@@ -56,7 +208,7 @@ func CheckMove(storage PieceStorage, move Move) error {
 		return ErrFriendlyTarget
 	}
 
-	if !piece.CheckMove(move, storage) {
+	if !checkPieceMove(storage, geometry, piece, move) {
 		return ErrIllegalMove
 	}
 
@@ -71,3 +223,47 @@ func CheckMove(storage PieceStorage, move Move) error {
 
 	return nil
 }
+
+func checkPieceMove(
+	storage PieceStorage,
+	geometry Geometry,
+	piece Piece,
+	move Move,
+) bool {
+	if piece.CheckMove(move, storage) {
+		return true
+	}
+
+	topology, ok := geometry.(Topology)
+	if !ok {
+		return false
+	}
+
+	size := storage.Size()
+	wrappedStorage := newWrappedPieceStorage(storage, geometry, topology)
+	for _, fileShift := range wrapShifts(size.Width, topology.WrapsFiles()) {
+		for _, rankShift := range wrapShifts(size.Height, topology.WrapsRanks()) {
+			// the move without shifts is already checked above
+			if fileShift == 0 && rankShift == 0 {
+				continue
+			}
+
+			wrappedMove := move
+			wrappedMove.Finish.File += fileShift
+			wrappedMove.Finish.Rank += rankShift
+			if piece.CheckMove(wrappedMove, wrappedStorage) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func wrapShifts(length int, isWrapped bool) []int {
+	if !isWrapped {
+		return []int{0}
+	}
+
+	return []int{0, -length, length}
+}
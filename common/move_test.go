@@ -1,6 +1,7 @@
 package common
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -66,6 +67,16 @@ func (storage MockBasePieceStorage) ApplyMove(move Move) PieceStorage {
 	panic("not implemented")
 }
 
+func (storage MockBasePieceStorage) SetPiece(piece Piece) PieceStorage {
+	panic("not implemented")
+}
+
+func (storage MockBasePieceStorage) RemovePiece(
+	position Position,
+) PieceStorage {
+	panic("not implemented")
+}
+
 type MockPieceGroupGetter struct {
 	pieces []Piece
 }
@@ -92,10 +103,21 @@ type MockPieceStorage struct {
 	MockMoveChecker
 }
 
+type MockTopologyPieceStorage struct {
+	MockPieceStorage
+
+	geometry Geometry
+}
+
+func (storage MockTopologyPieceStorage) Geometry() Geometry {
+	return storage.geometry
+}
+
 func TestMoveIsZero(test *testing.T) {
 	type fields struct {
-		start  Position
-		finish Position
+		start       Position
+		finish      Position
+		isPromotion bool
 	}
 	type data struct {
 		fields fields
@@ -124,6 +146,14 @@ func TestMoveIsZero(test *testing.T) {
 			},
 			want: false,
 		},
+		{
+			fields: fields{
+				start:       Position{0, 0},
+				finish:      Position{0, 0},
+				isPromotion: true,
+			},
+			want: false,
+		},
 		{
 			fields: fields{
 				start:  Position{0, 0},
@@ -133,8 +163,9 @@ func TestMoveIsZero(test *testing.T) {
 		},
 	} {
 		move := Move{
-			Start:  data.fields.start,
-			Finish: data.fields.finish,
+			Start:       data.fields.start,
+			Finish:      data.fields.finish,
+			IsPromotion: data.fields.isPromotion,
 		}
 		got := move.IsZero()
 
@@ -196,6 +227,388 @@ func TestMoveIsEmpty(test *testing.T) {
 	}
 }
 
+func TestIsPawnPromotion(test *testing.T) {
+	type fields struct {
+		pieces []Piece
+	}
+	type args struct {
+		move Move
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Pawn, color: White, position: Position{2, 2}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{2, 2}, Finish: Position{2, 3}},
+			},
+			want: true,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Pawn, color: Black, position: Position{2, 1}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{2, 1}, Finish: Position{1, 0}},
+			},
+			want: true,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Pawn, color: White, position: Position{2, 1}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{2, 1}, Finish: Position{2, 2}},
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Pawn, color: Blue, position: Position{2, 2}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{2, 2}, Finish: Position{3, 2}},
+			},
+			want: true,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Pawn, color: Blue, position: Position{2, 2}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{2, 2}, Finish: Position{2, 3}},
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Pawn, color: Yellow, position: Position{2, 1}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{2, 1}, Finish: Position{2, 0}},
+			},
+			want: true,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Pawn, color: Green, position: Position{1, 2}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{1, 2}, Finish: Position{0, 2}},
+			},
+			want: true,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Pawn, color: Green, position: Position{2, 2}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{2, 2}, Finish: Position{2, 3}},
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Rook, color: White, position: Position{2, 2}},
+				},
+			},
+			args: args{
+				move: Move{Start: Position{2, 2}, Finish: Position{2, 3}},
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				pieces: nil,
+			},
+			args: args{
+				move: Move{Start: Position{2, 2}, Finish: Position{2, 3}},
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				pieces: nil,
+			},
+			args: args{
+				move: Move{Finish: Position{2, 3}, Drop: Pawn, IsDrop: true},
+			},
+			want: false,
+		},
+	} {
+		storage := MockPieceStorage{
+			MockBasePieceStorage: MockBasePieceStorage{
+				size: Size{4, 4},
+				piece: func(position Position) (piece Piece, ok bool) {
+					for _, piece := range data.fields.pieces {
+						if piece.Position() == position {
+							return piece, true
+						}
+					}
+
+					return nil, false
+				},
+			},
+		}
+		got := IsPawnPromotion(storage, data.args.move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPromotionsCheckMove(test *testing.T) {
+	type args struct {
+		move        Move
+		isPromotion bool
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	promotions := Promotions{Kinds: []Kind{Queen, Knight}}
+	for _, data := range []data{
+		{
+			args: args{
+				move: Move{
+					Start:       Position{2, 2},
+					Finish:      Position{2, 3},
+					Promotion:   Knight,
+					IsPromotion: true,
+				},
+				isPromotion: true,
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				move:        Move{Start: Position{2, 1}, Finish: Position{2, 2}},
+				isPromotion: false,
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				move:        Move{Start: Position{2, 2}, Finish: Position{2, 3}},
+				isPromotion: true,
+			},
+			want: ErrIllegalMove,
+		},
+		{
+			args: args{
+				move: Move{
+					Start:       Position{2, 1},
+					Finish:      Position{2, 2},
+					Promotion:   Queen,
+					IsPromotion: true,
+				},
+				isPromotion: false,
+			},
+			want: ErrIllegalMove,
+		},
+		{
+			args: args{
+				move: Move{
+					Start:       Position{2, 2},
+					Finish:      Position{2, 3},
+					Promotion:   King,
+					IsPromotion: true,
+				},
+				isPromotion: true,
+			},
+			want: ErrIllegalMove,
+		},
+	} {
+		got := promotions.CheckMove(data.args.move, data.args.isPromotion)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPromotionsExpandMove(test *testing.T) {
+	type args struct {
+		move        Move
+		isPromotion bool
+	}
+	type data struct {
+		args args
+		want []Move
+	}
+
+	promotions := Promotions{Kinds: []Kind{Queen, Knight}}
+	for _, data := range []data{
+		{
+			args: args{
+				move:        Move{Start: Position{2, 2}, Finish: Position{2, 3}},
+				isPromotion: true,
+			},
+			want: []Move{
+				{
+					Start:       Position{2, 2},
+					Finish:      Position{2, 3},
+					Promotion:   Queen,
+					IsPromotion: true,
+				},
+				{
+					Start:       Position{2, 2},
+					Finish:      Position{2, 3},
+					Promotion:   Knight,
+					IsPromotion: true,
+				},
+			},
+		},
+		{
+			args: args{
+				move:        Move{Start: Position{2, 1}, Finish: Position{2, 2}},
+				isPromotion: false,
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				move: Move{
+					Start:       Position{2, 2},
+					Finish:      Position{2, 3},
+					Promotion:   Queen,
+					IsPromotion: true,
+				},
+				isPromotion: true,
+			},
+			want: nil,
+		},
+	} {
+		got := promotions.ExpandMove(data.args.move, data.args.isPromotion)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsCheck(test *testing.T) {
+	type fields struct {
+		pieces    []Piece
+		checkMove func(move Move) error
+	}
+	type args struct {
+		color Color
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   bool
+	}
+
+	attacker := Position{0, 0}
+	for _, data := range []data{
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Rook, color: White, position: Position{0, 0}},
+					MockPiece{kind: King, color: Black, position: Position{0, 3}},
+				},
+				checkMove: func(move Move) error {
+					if move.Start == attacker {
+						return ErrKingCapture
+					}
+
+					return ErrIllegalMove
+				},
+			},
+			args: args{color: White},
+			want: true,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Rook, color: White, position: Position{1, 0}},
+					MockPiece{kind: King, color: Black, position: Position{0, 3}},
+				},
+				checkMove: func(move Move) error {
+					if move.Start == attacker {
+						return ErrKingCapture
+					}
+
+					return ErrIllegalMove
+				},
+			},
+			args: args{color: White},
+			want: false,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Rook, color: White, position: Position{0, 0}},
+					MockPiece{kind: King, color: Black, position: Position{0, 3}},
+				},
+				checkMove: func(move Move) error {
+					if move.Start == attacker {
+						return ErrKingCapture
+					}
+
+					return ErrIllegalMove
+				},
+			},
+			args: args{color: Black},
+			want: false,
+		},
+		{
+			fields: fields{
+				pieces: []Piece{
+					MockPiece{kind: Rook, color: White, position: Position{0, 0}},
+					MockPiece{kind: Queen, color: Black, position: Position{0, 3}},
+				},
+				checkMove: func(move Move) error {
+					return nil
+				},
+			},
+			args: args{color: White},
+			want: false,
+		},
+	} {
+		storage := MockPieceStorage{
+			MockPieceGroupGetter: MockPieceGroupGetter{
+				pieces: data.fields.pieces,
+			},
+			MockMoveChecker: MockMoveChecker{
+				checkMove: data.fields.checkMove,
+			},
+		}
+		got := IsCheck(storage, data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
 func TestCheckMove(test *testing.T) {
 	type fields struct {
 		size  Size
@@ -226,6 +639,22 @@ func TestCheckMove(test *testing.T) {
 			},
 			want: ErrNoMove,
 		},
+		{
+			fields: fields{
+				size: Size{2, 2},
+				piece: func(position Position) (piece Piece, ok bool) {
+					return nil, false
+				},
+			},
+			args: args{
+				move: Move{
+					Finish: Position{1, 1},
+					Drop:   Pawn,
+					IsDrop: true,
+				},
+			},
+			want: ErrNoMove,
+		},
 		{
 			fields: fields{
 				size: Size{2, 2},
@@ -373,3 +802,76 @@ func TestCheckMove(test *testing.T) {
 		}
 	}
 }
+
+func TestCheckMove_withTopology(test *testing.T) {
+	type fields struct {
+		geometry Geometry
+	}
+	type args struct {
+		move Move
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   error
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{Cylinder{}},
+			args:   args{Move{Start: Position{0, 0}, Finish: Position{3, 0}}},
+			want:   nil,
+		},
+		{
+			fields: fields{Cylinder{}},
+			args:   args{Move{Start: Position{0, 0}, Finish: Position{3, 3}}},
+			want:   ErrIllegalMove,
+		},
+		{
+			fields: fields{Torus{}},
+			args:   args{Move{Start: Position{0, 0}, Finish: Position{3, 3}}},
+			want:   nil,
+		},
+		{
+			fields: fields{Torus{}},
+			args:   args{Move{Start: Position{0, 0}, Finish: Position{2, 0}}},
+			want:   ErrIllegalMove,
+		},
+		{
+			fields: fields{Rectangular{}},
+			args:   args{Move{Start: Position{0, 0}, Finish: Position{3, 0}}},
+			want:   ErrIllegalMove,
+		},
+	} {
+		storage := MockTopologyPieceStorage{
+			MockPieceStorage: MockPieceStorage{
+				MockBasePieceStorage: MockBasePieceStorage{
+					size: Size{4, 4},
+					piece: func(position Position) (piece Piece, ok bool) {
+						if position != (Position{0, 0}) {
+							return nil, false
+						}
+
+						piece = MockPiece{
+							position: position,
+							// it's a king-like piece
+							checkMove: func(move Move, storage PieceStorage) bool {
+								fileSteps := move.Finish.File - move.Start.File
+								rankSteps := move.Finish.Rank - move.Start.Rank
+								return fileSteps >= -1 && fileSteps <= 1 &&
+									rankSteps >= -1 && rankSteps <= 1
+							},
+						}
+						return piece, true
+					},
+				},
+			},
+			geometry: data.fields.geometry,
+		}
+		got := CheckMove(storage, data.args.move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
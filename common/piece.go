@@ -11,7 +11,11 @@ const (
 	Bishop
 	Knight
 	Pawn
+	Archbishop // bishop + knight
+	Chancellor // rook + knight
+	Amazon     // queen + knight
 
+	// it's a count of built-in kinds only (see the Kinds() function)
 	KindCount
 )
 
@@ -56,6 +60,12 @@ type BasePieceStorage interface {
 
 	// It shouldn't check that the move is correct.
 	ApplyMove(move Move) PieceStorage
+
+	// It should replace a piece on the same position, if any.
+	SetPiece(piece Piece) PieceStorage
+
+	// It should do nothing if there is no piece on the position.
+	RemovePiece(position Position) PieceStorage
 }
 
 // PieceGroupGetter ...
@@ -79,14 +89,13 @@ type PieceStorage interface {
 // Pieces ...
 func Pieces(storage PieceStorage) []Piece {
 	var pieces []Piece
-	storage.Size().
-		IteratePositions(func(position Position) error { // nolint: errcheck
-			if piece, ok := storage.Piece(position); ok {
-				pieces = append(pieces, piece)
-			}
-
-			return nil
-		})
+	IteratePositions(storage, func(position Position) error { // nolint: errcheck
+		if piece, ok := storage.Piece(position); ok {
+			pieces = append(pieces, piece)
+		}
+
+		return nil
+	})
 
 	return pieces
 }
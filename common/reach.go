@@ -0,0 +1,138 @@
+package common
+
+// Reacher ...
+//
+// It's an optional interface of a Piece. It returns positions, which
+// the piece can reach from its position on the piece storage: a superset
+// of finishes of its moves (they are still checked by the Piece.CheckMove()
+// method). The order of positions should be stable.
+type Reacher interface {
+	Reach(storage PieceStorage) []Position
+}
+
+// SparsenessChecker ...
+//
+// It's an optional interface of a BasePieceStorage. If the storage is sparse,
+// candidate finishes of a move of a piece implementing the Reacher interface
+// are only reachable positions instead of all cells of the storage,
+// so a huge storage doesn't slow a move generating down.
+//
+// Therefore, rules used with a sparse storage shouldn't allow moves
+// beyond a reach of pieces (e.g. castling).
+type SparsenessChecker interface {
+	IsSparse() bool
+}
+
+// IsSparse ...
+//
+// It checks that the piece storage implements the SparsenessChecker interface
+// and is sparse.
+func IsSparse(storage BasePieceStorage) bool {
+	checker, ok := storage.(SparsenessChecker)
+	return ok && checker.IsSparse()
+}
+
+// Leaps ...
+//
+// It returns positions at the steps from the start, which are cells
+// of the piece storage.
+func Leaps(storage PieceStorage, start Position, steps []Position) []Position {
+	var positions []Position
+	for _, step := range steps {
+		position := Position{start.File + step.File, start.Rank + step.Rank}
+		if HasPosition(storage, position) {
+			positions = append(positions, position)
+		}
+	}
+
+	return positions
+}
+
+// Rides ...
+//
+// It returns positions on lines from the start by the steps. Each line
+// goes up to the first obstacle: it includes a position occupied by a piece
+// and excludes a position, which isn't a cell of the piece storage.
+//
+// A line is limited by the max steps; zero max steps mean an unlimited line.
+func Rides(
+	storage PieceStorage,
+	start Position,
+	steps []Position,
+	maxSteps int,
+) []Position {
+	var positions []Position
+	for _, step := range steps {
+		position := start
+		for count := 1; maxSteps == 0 || count <= maxSteps; count++ {
+			position.File += step.File
+			position.Rank += step.Rank
+			if !HasPosition(storage, position) {
+				break
+			}
+
+			positions = append(positions, position)
+			if _, ok := storage.Piece(position); ok {
+				break
+			}
+		}
+	}
+
+	return positions
+}
+
+// IterateFinishes ...
+//
+// It iterates over candidate finishes of moves from the start.
+// If the piece storage is sparse (see the SparsenessChecker interface)
+// and a piece on the start implements the Reacher interface,
+// they are reachable positions of the piece without duplicates.
+// Otherwise, they are all cells of the piece storage.
+//
+// Wrapped edges (see the Topology interface) aren't supported
+// by reachable positions, so all cells are iterated over for them.
+func IterateFinishes(
+	storage PieceStorage,
+	start Position,
+	handler PositionHandler,
+) error {
+	reacher, ok := reacherOnPosition(storage, start)
+	if !ok {
+		return IteratePositions(storage, handler)
+	}
+
+	positions := reacher.Reach(storage)
+	handledPositions := make(map[Position]struct{}, len(positions))
+	for _, position := range positions {
+		if _, ok := handledPositions[position]; ok {
+			continue
+		}
+		handledPositions[position] = struct{}{}
+
+		if err := handler(position); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func reacherOnPosition(
+	storage PieceStorage,
+	position Position,
+) (reacher Reacher, ok bool) {
+	if !IsSparse(storage) {
+		return nil, false
+	}
+	if _, ok := StorageGeometry(storage).(Topology); ok {
+		return nil, false
+	}
+
+	piece, ok := storage.Piece(position)
+	if !ok {
+		return nil, false
+	}
+
+	reacher, ok = piece.(Reacher)
+	return reacher, ok
+}
@@ -0,0 +1,217 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+type MockReacherPiece struct {
+	MockPiece
+
+	reach func(storage PieceStorage) []Position
+}
+
+func (piece MockReacherPiece) Reach(storage PieceStorage) []Position {
+	if piece.reach == nil {
+		panic("not implemented")
+	}
+
+	return piece.reach(storage)
+}
+
+type MockSparsePieceStorage struct {
+	MockPieceStorage
+
+	geometry Geometry
+	isSparse bool
+}
+
+func (storage MockSparsePieceStorage) Geometry() Geometry {
+	if storage.geometry == nil {
+		return Rectangular{}
+	}
+
+	return storage.geometry
+}
+
+func (storage MockSparsePieceStorage) IsSparse() bool {
+	return storage.isSparse
+}
+
+func makeSparseStorage(
+	size Size,
+	geometry Geometry,
+	pieces ...Piece,
+) MockSparsePieceStorage {
+	return MockSparsePieceStorage{
+		MockPieceStorage: MockPieceStorage{
+			MockBasePieceStorage: MockBasePieceStorage{
+				size: size,
+				piece: func(position Position) (piece Piece, ok bool) {
+					for _, piece := range pieces {
+						if piece.Position() == position {
+							return piece, true
+						}
+					}
+
+					return nil, false
+				},
+			},
+		},
+		geometry: geometry,
+		isSparse: true,
+	}
+}
+
+func TestIsSparse(test *testing.T) {
+	type args struct {
+		storage BasePieceStorage
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{MockBasePieceStorage{}},
+			want: false,
+		},
+		{
+			args: args{MockSparsePieceStorage{isSparse: false}},
+			want: false,
+		},
+		{
+			args: args{MockSparsePieceStorage{isSparse: true}},
+			want: true,
+		},
+	} {
+		got := IsSparse(data.args.storage)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestLeaps(test *testing.T) {
+	storage := makeSparseStorage(Size{3, 3}, NewMask([]Position{{1, 1}}))
+	steps := []Position{{1, 1}, {0, 1}, {-1, 0}, {2, 0}}
+	got := Leaps(storage, Position{0, 0}, steps)
+
+	want := []Position{{0, 1}, {2, 0}}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestRides(test *testing.T) {
+	type args struct {
+		maxSteps int
+	}
+	type data struct {
+		args args
+		want []Position
+	}
+
+	storage := makeSparseStorage(
+		Size{5, 5},
+		NewMask([]Position{{2, 2}}),
+		MockPiece{position: Position{2, 0}},
+	)
+	for _, data := range []data{
+		{
+			args: args{maxSteps: 0},
+			want: []Position{
+				{1, 0},
+				{2, 0},
+				{0, 1},
+				{0, 2},
+				{0, 3},
+				{0, 4},
+				{1, 1},
+			},
+		},
+		{
+			args: args{maxSteps: 2},
+			want: []Position{{1, 0}, {2, 0}, {0, 1}, {0, 2}, {1, 1}},
+		},
+	} {
+		steps := []Position{{1, 0}, {0, 1}, {1, 1}}
+		got := Rides(storage, Position{0, 0}, steps, data.args.maxSteps)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestIterateFinishes(test *testing.T) {
+	type args struct {
+		storage PieceStorage
+	}
+	type data struct {
+		args args
+		want []Position
+	}
+
+	reacher := MockReacherPiece{
+		MockPiece: MockPiece{position: Position{0, 0}},
+		reach: func(storage PieceStorage) []Position {
+			return []Position{{1, 0}, {0, 1}, {1, 0}}
+		},
+	}
+	allPositions := []Position{{0, 0}, {1, 0}, {0, 1}, {1, 1}}
+	for _, data := range []data{
+		{
+			args: args{
+				storage: func() PieceStorage {
+					storage := makeSparseStorage(Size{2, 2}, nil, reacher)
+					storage.isSparse = false
+
+					return storage
+				}(),
+			},
+			want: allPositions,
+		},
+		{
+			args: args{makeSparseStorage(Size{2, 2}, nil, reacher)},
+			want: []Position{{1, 0}, {0, 1}},
+		},
+		{
+			args: args{makeSparseStorage(Size{2, 2}, Cylinder{}, reacher)},
+			want: allPositions,
+		},
+		{
+			args: args{
+				storage: makeSparseStorage(
+					Size{2, 2},
+					nil,
+					MockPiece{position: Position{0, 0}},
+				),
+			},
+			want: allPositions,
+		},
+		{
+			args: args{makeSparseStorage(Size{2, 2}, nil)},
+			want: allPositions,
+		},
+	} {
+		var got []Position
+		err := IterateFinishes(
+			data.args.storage,
+			Position{0, 0},
+			func(position Position) error {
+				got = append(got, position)
+				return nil
+			},
+		)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package ascii
+
+import (
+	"errors"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// DecodeColor ...
+//
+// It decodes a color from its lowercase English name (e.g. "black"),
+// including colors of four-player chess (e.g. "red").
+func DecodeColor(text string) (common.Color, error) {
+	switch text {
+	case "black":
+		return common.Black, nil
+	case "white":
+		return common.White, nil
+	case "red":
+		return common.Red, nil
+	case "blue":
+		return common.Blue, nil
+	case "yellow":
+		return common.Yellow, nil
+	case "green":
+		return common.Green, nil
+	default:
+		return 0, errors.New("unknown color")
+	}
+}
+
+// EncodeColor ...
+//
+// It converts the color to its lowercase English name (e.g. "black"),
+// including colors of four-player chess (e.g. "red").
+func EncodeColor(color common.Color) string {
+	switch color {
+	case common.Black:
+		return "black"
+	case common.Red:
+		return "red"
+	case common.Blue:
+		return "blue"
+	case common.Yellow:
+		return "yellow"
+	case common.Green:
+		return "green"
+	}
+
+	return "white"
+}
@@ -0,0 +1,92 @@
+package ascii
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestDecodeColor(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args      args
+		wantColor common.Color
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			args:      args{"black"},
+			wantColor: common.Black,
+			wantErr:   false,
+		},
+		{
+			args:      args{"white"},
+			wantColor: common.White,
+			wantErr:   false,
+		},
+		{
+			args:      args{"red"},
+			wantColor: common.Red,
+			wantErr:   false,
+		},
+		{
+			args:      args{"green"},
+			wantColor: common.Green,
+			wantErr:   false,
+		},
+		{
+			args:      args{"w"},
+			wantColor: 0,
+			wantErr:   true,
+		},
+	} {
+		gotColor, gotErr := DecodeColor(data.args.text)
+
+		if gotColor != data.wantColor {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeColor(test *testing.T) {
+	type args struct {
+		color common.Color
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.Black},
+			want: "black",
+		},
+		{
+			args: args{common.White},
+			want: "white",
+		},
+		{
+			args: args{common.Blue},
+			want: "blue",
+		},
+		{
+			args: args{common.Yellow},
+			want: "yellow",
+		},
+	} {
+		got := EncodeColor(data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
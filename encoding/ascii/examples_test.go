@@ -0,0 +1,30 @@
+package ascii_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/ascii"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func ExamplePieceStorageEncoder_EncodePieceStorage() {
+	const fen = "rnbqk/ppppp/5/PPPPP/RNBQK"
+	storage, _ := uci.DecodePieceStorage(fen, pieces.NewPiece, boards.NewMapBoard)
+	encoder := ascii.PieceStorageEncoder{
+		PieceEncoder:   ascii.EncodeUnicodePiece,
+		HasCoordinates: true,
+		Highlights:     []common.Position{{File: 2, Rank: 2}},
+	}
+	fmt.Print(encoder.EncodePieceStorage(storage))
+
+	// Output:
+	// 5  ♜  ♞  ♝  ♛  ♚
+	// 4  ♟  ♟  ♟  ♟  ♟
+	// 3  .  . [.] .  .
+	// 2  ♙  ♙  ♙  ♙  ♙
+	// 1  ♖  ♘  ♗  ♕  ♔
+	//    a  b  c  d  e
+}
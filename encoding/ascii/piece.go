@@ -0,0 +1,52 @@
+package ascii
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+var (
+	unicodePieces = [common.ColorCount][common.KindCount]rune{
+		common.Black: {
+			common.King:   '♚',
+			common.Queen:  '♛',
+			common.Rook:   '♜',
+			common.Bishop: '♝',
+			common.Knight: '♞',
+			common.Pawn:   '♟',
+		},
+		common.White: {
+			common.King:   '♔',
+			common.Queen:  '♕',
+			common.Rook:   '♖',
+			common.Bishop: '♗',
+			common.Knight: '♘',
+			common.Pawn:   '♙',
+		},
+	}
+)
+
+// PieceEncoder ...
+type PieceEncoder func(piece common.Piece) string
+
+// EncodePiece ...
+//
+// It converts the piece to its FEN symbol.
+func EncodePiece(piece common.Piece) string {
+	return uci.EncodePiece(piece)
+}
+
+// EncodeUnicodePiece ...
+//
+// It converts the piece to a Unicode chess glyph. If there is no glyph
+// for the piece, its FEN symbol is used instead.
+func EncodeUnicodePiece(piece common.Piece) string {
+	color, kind := piece.Color(), piece.Kind()
+	if color < 0 || color >= common.ColorCount ||
+		kind < 0 || kind >= common.KindCount ||
+		unicodePieces[color][kind] == 0 {
+		return EncodePiece(piece)
+	}
+
+	return string(unicodePieces[color][kind])
+}
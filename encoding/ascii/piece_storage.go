@@ -0,0 +1,121 @@
+package ascii
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Orientation ...
+type Orientation int
+
+// ...
+const (
+	WhiteAtBottom Orientation = iota
+	BlackAtBottom
+)
+
+// PieceStorageEncoder ...
+//
+// Its zero value draws pieces by FEN symbols with white at the bottom
+// and without coordinates.
+type PieceStorageEncoder struct {
+	// if it's nil, the EncodePiece() function is used
+	PieceEncoder PieceEncoder
+	// if it's empty, "." is used
+	Placeholder    string
+	Orientation    Orientation
+	HasCoordinates bool
+	// highlighted squares are enclosed in square brackets
+	Highlights []common.Position
+}
+
+// EncodePieceStorage ...
+//
+// It draws the storage as text lines (one line per rank), each of them
+// ends with a newline. Trailing spaces of lines are trimmed.
+func (encoder PieceStorageEncoder) EncodePieceStorage(
+	storage common.PieceStorage,
+) string {
+	size := storage.Size()
+	rankLabelWidth := len(strconv.Itoa(size.Height))
+
+	var lines []string
+	for _, rank := range encoder.order(size.Height, BlackAtBottom) {
+		var line string
+		if encoder.HasCoordinates {
+			rankLabel := strconv.Itoa(rank + 1)
+			line += strings.Repeat(" ", rankLabelWidth-len(rankLabel))
+			line += rankLabel + " "
+		}
+
+		for _, file := range encoder.order(size.Width, WhiteAtBottom) {
+			position := common.Position{File: file, Rank: rank}
+			line += encoder.encodeSquare(storage, position)
+		}
+
+		lines = append(lines, line)
+	}
+
+	if encoder.HasCoordinates {
+		line := strings.Repeat(" ", rankLabelWidth+1)
+		for _, file := range encoder.order(size.Width, WhiteAtBottom) {
+			line += " " + string(rune('a'+file)) + " "
+		}
+
+		lines = append(lines, line)
+	}
+
+	var text strings.Builder
+	for _, line := range lines {
+		text.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+
+	return text.String()
+}
+
+// it returns indices in ascending order for the specified orientation
+// and in descending order for the rest one
+func (encoder PieceStorageEncoder) order(
+	count int,
+	ascendingOrientation Orientation,
+) []int {
+	indices := make([]int, 0, count)
+	for i := 0; i < count; i++ {
+		if encoder.Orientation == ascendingOrientation {
+			indices = append(indices, i)
+		} else {
+			indices = append(indices, count-i-1)
+		}
+	}
+
+	return indices
+}
+
+func (encoder PieceStorageEncoder) encodeSquare(
+	storage common.PieceStorage,
+	position common.Position,
+) string {
+	symbol := encoder.Placeholder
+	if symbol == "" {
+		symbol = "."
+	}
+
+	if piece, ok := storage.Piece(position); ok {
+		pieceEncoder := encoder.PieceEncoder
+		if pieceEncoder == nil {
+			pieceEncoder = EncodePiece
+		}
+
+		symbol = pieceEncoder(piece)
+	}
+
+	for _, highlight := range encoder.Highlights {
+		if highlight == position {
+			return "[" + symbol + "]"
+		}
+	}
+
+	return " " + symbol + " "
+}
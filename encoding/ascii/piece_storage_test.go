@@ -0,0 +1,115 @@
+package ascii
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestPieceStorageEncoderEncodePieceStorage(test *testing.T) {
+	storage := boards.NewMapBoard(
+		common.Size{Width: 3, Height: 2},
+		[]common.Piece{
+			pieces.NewKing(common.White, common.Position{File: 1, Rank: 0}),
+			pieces.NewRook(common.Black, common.Position{File: 2, Rank: 1}),
+		},
+	)
+
+	type fields struct {
+		pieceEncoder   PieceEncoder
+		placeholder    string
+		orientation    Orientation
+		hasCoordinates bool
+		highlights     []common.Position
+	}
+	type data struct {
+		fields fields
+		want   string
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{},
+			want: "" +
+				" .  .  r\n" +
+				" .  K  .\n",
+		},
+		{
+			fields: fields{
+				pieceEncoder: EncodeUnicodePiece,
+				placeholder:  "·",
+			},
+			want: "" +
+				" ·  ·  ♜\n" +
+				" ·  ♔  ·\n",
+		},
+		{
+			fields: fields{
+				orientation: BlackAtBottom,
+			},
+			want: "" +
+				" .  K  .\n" +
+				" r  .  .\n",
+		},
+		{
+			fields: fields{
+				hasCoordinates: true,
+				highlights: []common.Position{
+					{File: 1, Rank: 0},
+					{File: 0, Rank: 1},
+				},
+			},
+			want: "" +
+				"2 [.] .  r\n" +
+				"1  . [K] .\n" +
+				"   a  b  c\n",
+		},
+		{
+			fields: fields{
+				orientation:    BlackAtBottom,
+				hasCoordinates: true,
+			},
+			want: "" +
+				"1  .  K  .\n" +
+				"2  r  .  .\n" +
+				"   c  b  a\n",
+		},
+	} {
+		encoder := PieceStorageEncoder{
+			PieceEncoder:   data.fields.pieceEncoder,
+			Placeholder:    data.fields.placeholder,
+			Orientation:    data.fields.orientation,
+			HasCoordinates: data.fields.hasCoordinates,
+			Highlights:     data.fields.highlights,
+		}
+		got := encoder.EncodePieceStorage(storage)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageEncoderEncodePieceStorageWithLargeSize(test *testing.T) {
+	storage := boards.NewMapBoard(common.Size{Width: 2, Height: 10}, nil)
+	encoder := PieceStorageEncoder{HasCoordinates: true}
+	got := encoder.EncodePieceStorage(storage)
+
+	want := "" +
+		"10  .  .\n" +
+		" 9  .  .\n" +
+		" 8  .  .\n" +
+		" 7  .  .\n" +
+		" 6  .  .\n" +
+		" 5  .  .\n" +
+		" 4  .  .\n" +
+		" 3  .  .\n" +
+		" 2  .  .\n" +
+		" 1  .  .\n" +
+		"    a  b\n"
+	if got != want {
+		test.Fail()
+	}
+}
@@ -0,0 +1,62 @@
+package ascii
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestEncodePiece(test *testing.T) {
+	type args struct {
+		piece common.Piece
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{pieces.NewKnight(common.White, common.Position{})},
+			want: "N",
+		},
+		{
+			args: args{pieces.NewPawn(common.Black, common.Position{})},
+			want: "p",
+		},
+	} {
+		got := EncodePiece(data.args.piece)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeUnicodePiece(test *testing.T) {
+	type args struct {
+		piece common.Piece
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{pieces.NewKnight(common.White, common.Position{})},
+			want: "♘",
+		},
+		{
+			args: args{pieces.NewPawn(common.Black, common.Position{})},
+			want: "♟",
+		},
+	} {
+		got := EncodeUnicodePiece(data.args.piece)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package binary
+
+import (
+	"errors"
+)
+
+type bitWriter struct {
+	data     []byte
+	bitCount int
+}
+
+func (writer *bitWriter) WriteBits(value uint, width int) {
+	for i := 0; i < width; i++ {
+		if writer.bitCount%8 == 0 {
+			writer.data = append(writer.data, 0)
+		}
+
+		if value&(1<<uint(i)) != 0 {
+			writer.data[len(writer.data)-1] |= 1 << uint(writer.bitCount%8)
+		}
+
+		writer.bitCount++
+	}
+}
+
+func (writer *bitWriter) Bytes() []byte {
+	return writer.data
+}
+
+type bitReader struct {
+	data     []byte
+	bitCount int
+}
+
+func (reader *bitReader) ReadBits(width int) (uint, error) {
+	var value uint
+	for i := 0; i < width; i++ {
+		byteIndex := reader.bitCount / 8
+		if byteIndex >= len(reader.data) {
+			return 0, errors.New("unexpected end of data")
+		}
+
+		if reader.data[byteIndex]&(1<<uint(reader.bitCount%8)) != 0 {
+			value |= 1 << uint(i)
+		}
+
+		reader.bitCount++
+	}
+
+	return value, nil
+}
+
+// it returns a count of bytes that were read, including a partial last one
+func (reader *bitReader) ByteCount() int {
+	return (reader.bitCount + 7) / 8
+}
+
+func bitWidth(value uint) int {
+	width := 1
+	for value>>uint(width) != 0 {
+		width++
+	}
+
+	return width
+}
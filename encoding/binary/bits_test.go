@@ -0,0 +1,66 @@
+package binary
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBitWriter(test *testing.T) {
+	writer := new(bitWriter)
+	writer.WriteBits(1, 1)
+	writer.WriteBits(0, 1)
+	writer.WriteBits(5, 3)
+	writer.WriteBits(0xab, 8)
+
+	// 1 + 0 << 1 + 101 << 2 + 10101011 << 5
+	want := []byte{0b01110101, 0b00010101}
+	if !reflect.DeepEqual(writer.Bytes(), want) {
+		test.Fail()
+	}
+}
+
+func TestBitReader(test *testing.T) {
+	reader := &bitReader{data: []byte{0b01110101, 0b00010101}}
+
+	for _, data := range []struct {
+		width     int
+		wantValue uint
+	}{
+		{width: 1, wantValue: 1},
+		{width: 1, wantValue: 0},
+		{width: 3, wantValue: 5},
+		{width: 8, wantValue: 0xab},
+	} {
+		value, err := reader.ReadBits(data.width)
+
+		if value != data.wantValue {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+
+	if reader.ByteCount() != 2 {
+		test.Fail()
+	}
+
+	if _, err := reader.ReadBits(4); err == nil {
+		test.Fail()
+	}
+}
+
+func TestBitWidth(test *testing.T) {
+	for value, want := range map[uint]int{
+		0:  1,
+		1:  1,
+		2:  2,
+		3:  2,
+		11: 4,
+		16: 5,
+	} {
+		if got := bitWidth(value); got != want {
+			test.Fail()
+		}
+	}
+}
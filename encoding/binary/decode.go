@@ -0,0 +1,95 @@
+package binary
+
+import (
+	stdbinary "encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+const (
+	maxCodeWidth = 32
+)
+
+// DecodePieceStorage ...
+func DecodePieceStorage(
+	data []byte,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	width, widthLength := stdbinary.Uvarint(data)
+	if widthLength <= 0 || width == 0 {
+		return nil, errors.New("incorrect width")
+	}
+	data = data[widthLength:]
+
+	height, heightLength := stdbinary.Uvarint(data)
+	if heightLength <= 0 || height == 0 {
+		return nil, errors.New("incorrect height")
+	}
+	data = data[heightLength:]
+
+	if len(data) == 0 {
+		return nil, errors.New("no code width")
+	}
+	codeWidth := int(data[0])
+	if codeWidth == 0 || codeWidth > maxCodeWidth {
+		return nil, errors.New("incorrect code width")
+	}
+	data = data[1:]
+
+	size := common.Size{Width: int(width), Height: int(height)}
+	if uint64(size.Width) != width || uint64(size.Height) != height ||
+		size.PositionCount()/size.Width != size.Height {
+		return nil, errors.New("too large size")
+	}
+
+	occupancy := &bitReader{data: data}
+	var positions []common.Position
+	if err := size.IteratePositions(func(position common.Position) error {
+		isOccupied, err := occupancy.ReadBits(1)
+		if err != nil {
+			return err
+		}
+
+		if isOccupied == 1 {
+			positions = append(positions, position)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("incorrect occupancy: %s", err)
+	}
+	data = data[occupancy.ByteCount():]
+
+	packedCodes := &bitReader{data: data}
+	pieces := make([]common.Piece, 0, len(positions))
+	for _, position := range positions {
+		code, err := packedCodes.ReadBits(codeWidth)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect piece code: %s", err)
+		}
+
+		kind, color := decodePiece(code)
+		piece := pieceFactory(kind, color, position)
+		if piece == nil {
+			return nil, errors.New("incorrect piece code: unknown kind")
+		}
+
+		pieces = append(pieces, piece)
+	}
+	if packedCodes.ByteCount() != len(data) {
+		return nil, errors.New("trailing data")
+	}
+
+	storage := pieceStorageFactory(size, pieces)
+	return storage, nil
+}
+
+func decodePiece(code uint) (common.Kind, common.Color) {
+	kind := common.Kind(code / uint(common.AllColorCount))
+	color := common.Color(code % uint(common.AllColorCount))
+	return kind, color
+}
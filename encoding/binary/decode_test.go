@@ -0,0 +1,150 @@
+package binary
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		data []byte
+	}
+	type data struct {
+		args    args
+		wantFEN string
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{[]byte{3, 2, 1, 0b00000000}},
+			wantFEN: "3/3",
+			wantErr: false,
+		},
+		{
+			args:    args{[]byte{3, 2, 4, 0b00101010, 0b00000001, 0b00001100}},
+			wantFEN: "k1r/1K1",
+			wantErr: false,
+		},
+		{
+			args:    args{[]byte{}},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{[]byte{0, 2, 1, 0b00000000}},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{[]byte{3}},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{[]byte{3, 2}},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{[]byte{3, 2, 0, 0b00000000}},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{[]byte{3, 2, 1}},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{[]byte{3, 2, 4, 0b00101010, 0b00000001}},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			// a code of an unknown kind
+			args:    args{[]byte{3, 2, 6, 0b00000001, 0b00111111}},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{[]byte{3, 2, 1, 0b00000000, 0b00000000}},
+			wantFEN: "",
+			wantErr: true,
+		},
+	} {
+		for _, factory := range pieceStorageFactories {
+			gotStorage, gotErr :=
+				DecodePieceStorage(data.args.data, pieces.NewPiece, factory)
+
+			var gotFEN string
+			if gotStorage != nil {
+				gotFEN = uci.EncodePieceStorage(gotStorage)
+			}
+			if gotFEN != data.wantFEN {
+				test.Fail()
+			}
+
+			hasErr := gotErr != nil
+			if hasErr != data.wantErr {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestPieceStorageRoundTrip(test *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		size := common.Size{
+			Width:  1 + random.Intn(16),
+			Height: 1 + random.Intn(16),
+		}
+
+		var pieceGroup []common.Piece
+		for _, position := range size.Positions() {
+			if random.Intn(3) != 0 {
+				continue
+			}
+
+			kind := common.Kind(random.Intn(int(common.KindCount)))
+			color := common.Color(random.Intn(int(common.AllColorCount)))
+			pieceGroup = append(pieceGroup, pieces.NewPiece(kind, color, position))
+		}
+
+		var previousData []byte
+		for _, factory := range pieceStorageFactories {
+			storage := factory(size, pieceGroup)
+			data := EncodePieceStorage(storage)
+			if previousData != nil && !reflect.DeepEqual(data, previousData) {
+				test.Fail()
+			}
+
+			decodedStorage, err :=
+				DecodePieceStorage(data, pieces.NewPiece, factory)
+			if err != nil {
+				test.Fail()
+				continue
+			}
+
+			if !reflect.DeepEqual(decodedStorage.Size(), size) {
+				test.Fail()
+			}
+			if uci.EncodePieceStorage(decodedStorage) !=
+				uci.EncodePieceStorage(storage) {
+				test.Fail()
+			}
+			// FEN doesn't distinguish colors of four-player chess
+			if !reflect.DeepEqual(EncodePieceStorage(decodedStorage), data) {
+				test.Fail()
+			}
+
+			previousData = data
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Package binary implements compact binary coding of piece storages.
+//
+// The format is the following (all bit groups are packed starting
+// from the least significant bit of a byte):
+//
+//   - a width of a board (unsigned varint);
+//   - a height of a board (unsigned varint);
+//   - a width of a piece code in bits (one byte);
+//   - an occupancy bitset: one bit per position in order of position indices
+//     (see the common.Size.PositionIndex() method), padded to a whole byte;
+//   - piece codes: one code per occupied position in the same order,
+//     where the code is kind * common.AllColorCount + color, padded
+//     to a whole byte.
+//
+// The width of a piece code is the minimal one sufficient for all pieces
+// of the board, so the output depends only on the board content
+// and not on the storage implementation.
+//
+// Colors of both two-player and four-player games are supported
+// (see common.AllColorCount).
+package binary
+
+import (
+	stdbinary "encoding/binary"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// EncodePieceStorage ...
+func EncodePieceStorage(storage common.PieceStorage) []byte {
+	size := storage.Size()
+	occupancy := new(bitWriter)
+	var codes []uint
+	var maxCode uint
+	size.
+		IteratePositions(func(position common.Position) error { // nolint: errcheck
+			piece, ok := storage.Piece(position)
+			if !ok {
+				occupancy.WriteBits(0, 1)
+				return nil
+			}
+
+			code := encodePiece(piece)
+			if maxCode < code {
+				maxCode = code
+			}
+
+			occupancy.WriteBits(1, 1)
+			codes = append(codes, code)
+
+			return nil
+		})
+
+	codeWidth := bitWidth(maxCode)
+	packedCodes := new(bitWriter)
+	for _, code := range codes {
+		packedCodes.WriteBits(code, codeWidth)
+	}
+
+	data := stdbinary.AppendUvarint(nil, uint64(size.Width))
+	data = stdbinary.AppendUvarint(data, uint64(size.Height))
+	data = append(data, byte(codeWidth))
+	data = append(data, occupancy.Bytes()...)
+	data = append(data, packedCodes.Bytes()...)
+	return data
+}
+
+func encodePiece(piece common.Piece) uint {
+	return uint(piece.Kind())*uint(common.AllColorCount) + uint(piece.Color())
+}
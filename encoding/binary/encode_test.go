@@ -0,0 +1,87 @@
+package binary
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+var (
+	pieceStorageFactories = []uci.PieceStorageFactory{
+		boards.NewMapBoard,
+		boards.NewSliceBoard,
+		func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+			return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+		},
+	}
+)
+
+func TestEncodePieceStorage(test *testing.T) {
+	type args struct {
+		size   common.Size
+		pieces []common.Piece
+	}
+	type data struct {
+		args args
+		want []byte
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				size:   common.Size{Width: 3, Height: 2},
+				pieces: nil,
+			},
+			want: []byte{3, 2, 1, 0b00000000},
+		},
+		{
+			args: args{
+				size: common.Size{Width: 3, Height: 2},
+				pieces: []common.Piece{
+					pieces.NewKing(common.White, common.Position{File: 1, Rank: 0}),
+					pieces.NewKing(common.Black, common.Position{File: 0, Rank: 1}),
+					pieces.NewRook(common.Black, common.Position{File: 2, Rank: 1}),
+				},
+			},
+			// codes: white king = 1, black king = 0, black rook = 12
+			want: []byte{3, 2, 4, 0b00101010, 0b00000001, 0b00001100},
+		},
+		{
+			args: args{
+				size: common.Size{Width: 3, Height: 2},
+				pieces: []common.Piece{
+					pieces.NewRook(common.Red, common.Position{File: 0, Rank: 0}),
+					pieces.NewPawn(common.Green, common.Position{File: 2, Rank: 1}),
+				},
+			},
+			// codes: red rook = 14, green pawn = 35
+			want: []byte{3, 2, 6, 0b00100001, 0b11001110, 0b00001000},
+		},
+		{
+			args: args{
+				size: common.Size{Width: 200, Height: 1},
+				pieces: []common.Piece{
+					pieces.NewPawn(common.White, common.Position{File: 199, Rank: 0}),
+				},
+			},
+			// a width of 200 takes two bytes as an unsigned varint
+			want: append(
+				[]byte{200, 1, 1, 5},
+				append(make([]byte, 24), 0b10000000, 0b00011111)...,
+			),
+		},
+	} {
+		for _, factory := range pieceStorageFactories {
+			storage := factory(data.args.size, data.args.pieces)
+			got := EncodePieceStorage(storage)
+
+			if !reflect.DeepEqual(got, data.want) {
+				test.Fail()
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package binary_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/binary"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func ExampleEncodePieceStorage() {
+	board := boards.NewMapBoard(common.Size{Width: 5, Height: 5}, []common.Piece{
+		pieces.NewRook(common.Black, common.Position{File: 2, Rank: 2}),
+		pieces.NewBishop(common.White, common.Position{File: 3, Rank: 3}),
+	})
+	data := binary.EncodePieceStorage(board)
+	fmt.Printf("%08b\n", data)
+
+	// Output: [00000101 00000101 00000101 00000000 00010000 00000100 00000000 01101100 00000010]
+}
+
+func ExampleDecodePieceStorage() {
+	data := []byte{5, 5, 5, 0, 16, 4, 0, 108, 2}
+	storage, _ :=
+		binary.DecodePieceStorage(data, pieces.NewPiece, boards.NewMapBoard)
+	fmt.Printf("%v\n", uci.EncodePieceStorage(storage))
+
+	// Output: 5/3B1/2r2/5/5
+}
@@ -0,0 +1,163 @@
+// Package diagram implements drawing of board diagrams as images.
+//
+// A diagram is built as a set of filled polygons, which is serialized
+// as SVG or rasterized via the standard image packages (e.g. to PNG).
+package diagram
+
+import (
+	"image/color"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/ascii"
+)
+
+const (
+	defaultSquareSize = 45
+)
+
+// ...
+var (
+	DefaultLightSquareColor = color.NRGBA{0xf0, 0xd9, 0xb5, 0xff}
+	DefaultDarkSquareColor  = color.NRGBA{0xb5, 0x88, 0x63, 0xff}
+	DefaultHighlightColor   = color.NRGBA{0x9b, 0xc7, 0x00, 0x99}
+	DefaultArrowColor       = color.NRGBA{0x15, 0x78, 0x1b, 0xaa}
+	DefaultBlackPieceColor  = color.NRGBA{0x22, 0x22, 0x22, 0xff}
+	DefaultWhitePieceColor  = color.NRGBA{0xff, 0xff, 0xff, 0xff}
+)
+
+// Encoder ...
+//
+// Its zero value draws squares of 45 pixels with white at the bottom
+// and with default colors.
+type Encoder struct {
+	// if it's zero, 45 pixels are used
+	SquareSize  int
+	Orientation ascii.Orientation
+	Highlights  []common.Position
+	// arrows are drawn from a start to a finish of moves
+	Arrows []common.Move
+
+	// if they're nil, corresponding default colors are used
+	LightSquareColor color.Color
+	DarkSquareColor  color.Color
+	HighlightColor   color.Color
+	ArrowColor       color.Color
+	BlackPieceColor  color.Color
+	WhitePieceColor  color.Color
+}
+
+// ImageSize ...
+//
+// It returns a size of the diagram of the storage in pixels.
+func (encoder Encoder) ImageSize(storage common.PieceStorage) (
+	width int,
+	height int,
+) {
+	size, squareSize := storage.Size(), encoder.squareSize()
+	return size.Width * squareSize, size.Height * squareSize
+}
+
+func (encoder Encoder) squareSize() int {
+	if encoder.SquareSize <= 0 {
+		return defaultSquareSize
+	}
+
+	return encoder.SquareSize
+}
+
+func (encoder Encoder) scene(storage common.PieceStorage) []primitive {
+	size, squareSize := storage.Size(), float64(encoder.squareSize())
+
+	var primitives []primitive
+	for _, position := range size.Positions() {
+		squareColor := encoder.LightSquareColor
+		defaultSquareColor := DefaultLightSquareColor
+		if (position.File+position.Rank)%2 == 0 {
+			squareColor = encoder.DarkSquareColor
+			defaultSquareColor = DefaultDarkSquareColor
+		}
+
+		corner := encoder.corner(size, position)
+		primitives = append(primitives, primitive{
+			polygon: rectangle(corner.X, corner.Y, squareSize, squareSize),
+			fill:    toNRGBA(squareColor, defaultSquareColor),
+		})
+	}
+
+	for _, position := range encoder.Highlights {
+		if !size.HasPosition(position) {
+			continue
+		}
+
+		corner := encoder.corner(size, position)
+		primitives = append(primitives, primitive{
+			polygon: rectangle(corner.X, corner.Y, squareSize, squareSize),
+			fill:    toNRGBA(encoder.HighlightColor, DefaultHighlightColor),
+		})
+	}
+
+	for _, piece := range common.Pieces(storage) {
+		fill := toNRGBA(encoder.WhitePieceColor, DefaultWhitePieceColor)
+		stroke := toNRGBA(encoder.BlackPieceColor, DefaultBlackPieceColor)
+		if piece.Color() == common.Black {
+			fill, stroke = stroke, fill
+		}
+
+		corner := encoder.corner(size, piece.Position())
+		scale := squareSize / shapeSize
+		for _, polygon := range pieceShape(piece.Kind()) {
+			primitives = append(primitives, primitive{
+				polygon:     polygon.Transform(scale, corner),
+				fill:        fill,
+				stroke:      stroke,
+				strokeWidth: 3 * scale,
+			})
+		}
+	}
+
+	for _, move := range encoder.Arrows {
+		if !size.HasMove(move) || move.IsEmpty() {
+			continue
+		}
+
+		start, finish :=
+			encoder.center(size, move.Start), encoder.center(size, move.Finish)
+		primitives = append(primitives, primitive{
+			polygon: arrow(start, finish, squareSize/6),
+			fill:    toNRGBA(encoder.ArrowColor, DefaultArrowColor),
+		})
+	}
+
+	return primitives
+}
+
+// it returns a top left corner of the square in pixels
+func (encoder Encoder) corner(
+	size common.Size,
+	position common.Position,
+) point {
+	column, row := position.File, size.Height-position.Rank-1
+	if encoder.Orientation == ascii.BlackAtBottom {
+		column, row = size.Width-position.File-1, position.Rank
+	}
+
+	squareSize := float64(encoder.squareSize())
+	return point{float64(column) * squareSize, float64(row) * squareSize}
+}
+
+func (encoder Encoder) center(
+	size common.Size,
+	position common.Position,
+) point {
+	corner := encoder.corner(size, position)
+	halfSquareSize := float64(encoder.squareSize()) / 2
+	return point{corner.X + halfSquareSize, corner.Y + halfSquareSize}
+}
+
+func toNRGBA(value color.Color, defaultValue color.NRGBA) color.NRGBA {
+	if value == nil {
+		return defaultValue
+	}
+
+	return color.NRGBAModel.Convert(value).(color.NRGBA)
+}
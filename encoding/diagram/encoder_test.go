@@ -0,0 +1,151 @@
+package diagram
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/ascii"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestEncoderImageSize(test *testing.T) {
+	type fields struct {
+		squareSize int
+	}
+	type data struct {
+		fields     fields
+		wantWidth  int
+		wantHeight int
+	}
+
+	for _, data := range []data{
+		{
+			fields:     fields{squareSize: 0},
+			wantWidth:  135,
+			wantHeight: 90,
+		},
+		{
+			fields:     fields{squareSize: 10},
+			wantWidth:  30,
+			wantHeight: 20,
+		},
+	} {
+		storage := boards.NewMapBoard(common.Size{Width: 3, Height: 2}, nil)
+		encoder := Encoder{SquareSize: data.fields.squareSize}
+		gotWidth, gotHeight := encoder.ImageSize(storage)
+
+		if gotWidth != data.wantWidth {
+			test.Fail()
+		}
+		if gotHeight != data.wantHeight {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncoderCorner(test *testing.T) {
+	type fields struct {
+		orientation ascii.Orientation
+	}
+	type args struct {
+		position common.Position
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   point
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{ascii.WhiteAtBottom},
+			args:   args{common.Position{File: 0, Rank: 0}},
+			want:   point{0, 10},
+		},
+		{
+			fields: fields{ascii.WhiteAtBottom},
+			args:   args{common.Position{File: 2, Rank: 1}},
+			want:   point{20, 0},
+		},
+		{
+			fields: fields{ascii.BlackAtBottom},
+			args:   args{common.Position{File: 0, Rank: 0}},
+			want:   point{20, 0},
+		},
+		{
+			fields: fields{ascii.BlackAtBottom},
+			args:   args{common.Position{File: 2, Rank: 1}},
+			want:   point{0, 10},
+		},
+	} {
+		encoder := Encoder{SquareSize: 10, Orientation: data.fields.orientation}
+		size := common.Size{Width: 3, Height: 2}
+		got := encoder.corner(size, data.args.position)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncoderScene(test *testing.T) {
+	storage := boards.NewMapBoard(common.Size{Width: 2, Height: 1}, []common.Piece{
+		pieces.NewRook(common.Black, common.Position{File: 1, Rank: 0}),
+	})
+	encoder := Encoder{
+		SquareSize:     10,
+		Highlights:     []common.Position{{File: 0, Rank: 0}, {File: 5, Rank: 5}},
+		HighlightColor: color.White,
+		Arrows: []common.Move{
+			{
+				Start:  common.Position{File: 0, Rank: 0},
+				Finish: common.Position{File: 1, Rank: 0},
+			},
+			{
+				Start:  common.Position{File: 0, Rank: 0},
+				Finish: common.Position{File: 0, Rank: 0},
+			},
+		},
+	}
+	got := encoder.scene(storage)
+
+	// 2 squares, 1 highlight, 2 polygons of the rook and 1 arrow
+	if len(got) != 6 {
+		test.FailNow()
+	}
+
+	wantSquares := []primitive{
+		{
+			polygon: rectangle(0, 0, 10, 10),
+			fill:    DefaultDarkSquareColor,
+		},
+		{
+			polygon: rectangle(10, 0, 10, 10),
+			fill:    DefaultLightSquareColor,
+		},
+		{
+			polygon: rectangle(0, 0, 10, 10),
+			fill:    color.NRGBA{0xff, 0xff, 0xff, 0xff},
+		},
+	}
+	if !reflect.DeepEqual(got[:3], wantSquares) {
+		test.Fail()
+	}
+
+	scale := float64(encoder.SquareSize) / shapeSize
+	for _, piecePrimitive := range got[3:5] {
+		if piecePrimitive.fill != DefaultBlackPieceColor ||
+			piecePrimitive.stroke != DefaultWhitePieceColor ||
+			piecePrimitive.strokeWidth != 3*scale {
+			test.Fail()
+		}
+	}
+
+	wantArrow := arrow(point{5, 5}, point{15, 5}, 10.0/6)
+	if !reflect.DeepEqual(got[5].polygon, wantArrow) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,30 @@
+package diagram_test
+
+import (
+	"os"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/diagram"
+)
+
+func ExampleEncoder_EncodeSVG() {
+	board := boards.NewMapBoard(common.Size{Width: 2, Height: 1}, nil)
+	encoder := diagram.Encoder{
+		SquareSize: 10,
+		Arrows: []common.Move{
+			{
+				Start:  common.Position{File: 0, Rank: 0},
+				Finish: common.Position{File: 1, Rank: 0},
+			},
+		},
+	}
+	encoder.EncodeSVG(os.Stdout, board) // nolint: errcheck
+
+	// Output:
+	// <svg xmlns="http://www.w3.org/2000/svg" width="20" height="10" viewBox="0 0 20 10">
+	// <polygon points="0,0 10,0 10,10 0,10" fill="#b58863"/>
+	// <polygon points="10,0 20,0 20,10 10,10" fill="#f0d9b5"/>
+	// <polygon points="5,5.83 11.67,5.83 11.67,6.67 15,5 11.67,3.33 11.67,4.17 5,4.17" fill="#15781b" fill-opacity="0.67"/>
+	// </svg>
+}
@@ -0,0 +1,141 @@
+package diagram
+
+import (
+	"image/color"
+	"math"
+	"sort"
+)
+
+type point struct {
+	X float64
+	Y float64
+}
+
+type polygon []point
+
+// it's a polygon with its appearance in pixels of the resulting image
+type primitive struct {
+	polygon     polygon
+	fill        color.NRGBA
+	stroke      color.NRGBA
+	strokeWidth float64
+}
+
+func rectangle(x float64, y float64, width float64, height float64) polygon {
+	return polygon{
+		{x, y},
+		{x + width, y},
+		{x + width, y + height},
+		{x, y + height},
+	}
+}
+
+func circle(x float64, y float64, radius float64) polygon {
+	return ellipse(x, y, radius, radius)
+}
+
+func ellipse(x float64, y float64, radiusX float64, radiusY float64) polygon {
+	const segmentCount = 24
+
+	var points polygon
+	for i := 0; i < segmentCount; i++ {
+		angle := 2 * math.Pi * float64(i) / segmentCount
+		points = append(points, point{
+			X: x + radiusX*math.Cos(angle),
+			Y: y + radiusY*math.Sin(angle),
+		})
+	}
+
+	return points
+}
+
+func (points polygon) Transform(scale float64, shift point) polygon {
+	transformedPoints := make(polygon, 0, len(points))
+	for _, point := range points {
+		point.X = point.X*scale + shift.X
+		point.Y = point.Y*scale + shift.Y
+		transformedPoints = append(transformedPoints, point)
+	}
+
+	return transformedPoints
+}
+
+// it returns sorted X coordinates of crossings of the horizontal line
+// with polygon edges, so spans between odd and even crossings are inside
+// the polygon (the even-odd rule)
+func (points polygon) Crossings(y float64) []float64 {
+	var crossings []float64
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		a, b := points[i], points[j]
+		if (a.Y > y) != (b.Y > y) {
+			crossing := (b.X-a.X)*(y-a.Y)/(b.Y-a.Y) + a.X
+			crossings = append(crossings, crossing)
+		}
+	}
+
+	sort.Float64s(crossings)
+	return crossings
+}
+
+func (points polygon) Bounds() (min point, max point) {
+	min = point{math.Inf(1), math.Inf(1)}
+	max = point{math.Inf(-1), math.Inf(-1)}
+	for _, point := range points {
+		min.X, min.Y = math.Min(min.X, point.X), math.Min(min.Y, point.Y)
+		max.X, max.Y = math.Max(max.X, point.X), math.Max(max.Y, point.Y)
+	}
+
+	return min, max
+}
+
+// it returns polygons which approximate a stroke of the polygon outline
+func (points polygon) Stroke(width float64) []polygon {
+	var polygons []polygon
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		polygons = append(polygons, line(points[j], points[i], width))
+		polygons = append(polygons, circle(points[i].X, points[i].Y, width/2))
+	}
+
+	return polygons
+}
+
+func line(start point, finish point, width float64) polygon {
+	dx, dy := finish.X-start.X, finish.Y-start.Y
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return nil
+	}
+
+	// a normal vector with a half of the width as a length
+	nx, ny := -dy/length*width/2, dx/length*width/2
+	return polygon{
+		{start.X + nx, start.Y + ny},
+		{finish.X + nx, finish.Y + ny},
+		{finish.X - nx, finish.Y - ny},
+		{start.X - nx, start.Y - ny},
+	}
+}
+
+func arrow(start point, finish point, width float64) polygon {
+	dx, dy := finish.X-start.X, finish.Y-start.Y
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return nil
+	}
+
+	// unit vectors along the arrow and normal to it
+	ux, uy := dx/length, dy/length
+	nx, ny := -uy, ux
+
+	headLength := math.Min(2*width, length)
+	headBase := point{finish.X - ux*headLength, finish.Y - uy*headLength}
+	return polygon{
+		{start.X + nx*width/2, start.Y + ny*width/2},
+		{headBase.X + nx*width/2, headBase.Y + ny*width/2},
+		{headBase.X + nx*width, headBase.Y + ny*width},
+		finish,
+		{headBase.X - nx*width, headBase.Y - ny*width},
+		{headBase.X - nx*width/2, headBase.Y - ny*width/2},
+		{start.X - nx*width/2, start.Y - ny*width/2},
+	}
+}
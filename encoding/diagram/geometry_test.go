@@ -0,0 +1,139 @@
+package diagram
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRectangle(test *testing.T) {
+	got := rectangle(1, 2, 3, 4)
+
+	want := polygon{{1, 2}, {4, 2}, {4, 6}, {1, 6}}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestEllipse(test *testing.T) {
+	got := ellipse(10, 20, 4, 2)
+
+	if len(got) != 24 {
+		test.Fail()
+	}
+
+	min, max := got.Bounds()
+	if !reflect.DeepEqual(min, point{6, 18}) ||
+		!reflect.DeepEqual(max, point{14, 22}) {
+		test.Fail()
+	}
+}
+
+func TestPolygonTransform(test *testing.T) {
+	got := polygon{{1, 2}, {3, 4}}.Transform(2, point{10, 20})
+
+	want := polygon{{12, 24}, {16, 28}}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestPolygonCrossings(test *testing.T) {
+	type args struct {
+		y float64
+	}
+	type data struct {
+		args args
+		want []float64
+	}
+
+	// a "U" shape
+	shape := polygon{
+		{0, 0},
+		{1, 0},
+		{1, 2},
+		{2, 2},
+		{2, 0},
+		{3, 0},
+		{3, 3},
+		{0, 3},
+	}
+	for _, data := range []data{
+		{
+			args: args{1},
+			want: []float64{0, 1, 2, 3},
+		},
+		{
+			args: args{2.5},
+			want: []float64{0, 3},
+		},
+		{
+			args: args{4},
+			want: nil,
+		},
+	} {
+		got := shape.Crossings(data.args.y)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestPolygonBounds(test *testing.T) {
+	min, max := polygon{{1, 5}, {3, -2}, {-4, 0}}.Bounds()
+
+	if !reflect.DeepEqual(min, point{-4, -2}) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(max, point{3, 5}) {
+		test.Fail()
+	}
+}
+
+func TestPolygonStroke(test *testing.T) {
+	got := rectangle(0, 0, 10, 10).Stroke(2)
+
+	// an edge and a vertex join per each vertex
+	if len(got) != 8 {
+		test.Fail()
+	}
+
+	wantEdge := polygon{{1, 10}, {1, 0}, {-1, 0}, {-1, 10}}
+	if !reflect.DeepEqual(got[0], wantEdge) {
+		test.Fail()
+	}
+}
+
+func TestLine(test *testing.T) {
+	got := line(point{0, 0}, point{10, 0}, 2)
+
+	want := polygon{{0, 1}, {10, 1}, {10, -1}, {0, -1}}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+
+	if line(point{1, 1}, point{1, 1}, 2) != nil {
+		test.Fail()
+	}
+}
+
+func TestArrow(test *testing.T) {
+	got := arrow(point{0, 0}, point{10, 0}, 2)
+
+	want := polygon{
+		{0, 1},
+		{6, 1},
+		{6, 2},
+		{10, 0},
+		{6, -2},
+		{6, -1},
+		{0, -1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+
+	if arrow(point{1, 1}, point{1, 1}, 2) != nil {
+		test.Fail()
+	}
+}
@@ -0,0 +1,109 @@
+package diagram
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+const (
+	maxPaletteSize = 256
+)
+
+// EncodeGIF ...
+//
+// It writes an animated GIF image, where the first frame is a diagram
+// of the storage and each next one is a diagram after a next move
+// with the last move highlighted. Frames are shown with the specified delay
+// (rounded to hundredths of a second) in an endless loop.
+//
+// It doesn't check that moves are correct.
+func (encoder Encoder) EncodeGIF(
+	writer io.Writer,
+	storage common.PieceStorage,
+	moves []common.Move,
+	delay time.Duration,
+) error {
+	frames := []*image.RGBA{encoder.frame(storage, nil)}
+	for _, move := range moves {
+		storage = storage.ApplyMove(move)
+
+		lastMove := []common.Position{move.Start, move.Finish}
+		frames = append(frames, encoder.frame(storage, lastMove))
+	}
+
+	palette := makePalette(frames)
+	const hundredth = 10 * time.Millisecond
+	delayInHundredths := int(delay.Round(hundredth) / hundredth)
+	animation := &gif.GIF{LoopCount: 0}
+	for _, frame := range frames {
+		palettedFrame := image.NewPaletted(frame.Bounds(), palette)
+		draw.Draw(palettedFrame, frame.Bounds(), frame, image.Point{}, draw.Src)
+
+		animation.Image = append(animation.Image, palettedFrame)
+		animation.Delay = append(animation.Delay, delayInHundredths)
+	}
+
+	return gif.EncodeAll(writer, animation)
+}
+
+func (encoder Encoder) frame(
+	storage common.PieceStorage,
+	highlights []common.Position,
+) *image.RGBA {
+	encoder.Highlights = highlights
+	encoder.Arrows = nil
+
+	return encoder.Image(storage)
+}
+
+// it returns the most frequent colors of the frames; the rest colors
+// are approximated by the nearest ones from the palette
+func makePalette(frames []*image.RGBA) color.Palette {
+	counts := make(map[color.RGBA]int)
+	for _, frame := range frames {
+		bounds := frame.Bounds()
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				counts[frame.RGBAAt(x, y)]++
+			}
+		}
+	}
+
+	colors := make([]color.RGBA, 0, len(counts))
+	for value := range counts {
+		colors = append(colors, value)
+	}
+
+	sort.Slice(colors, func(i int, j int) bool {
+		a, b := colors[i], colors[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+
+		// for a deterministic order
+		return colorKey(a) < colorKey(b)
+	})
+
+	if len(colors) > maxPaletteSize {
+		colors = colors[:maxPaletteSize]
+	}
+
+	palette := make(color.Palette, 0, len(colors))
+	for _, value := range colors {
+		palette = append(palette, value)
+	}
+
+	return palette
+}
+
+func colorKey(value color.RGBA) uint32 {
+	return uint32(value.R)<<24 | uint32(value.G)<<16 |
+		uint32(value.B)<<8 | uint32(value.A)
+}
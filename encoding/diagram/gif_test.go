@@ -0,0 +1,139 @@
+package diagram
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestEncoderEncodeGIF(test *testing.T) {
+	type args struct {
+		boardInFEN string
+		moves      []string
+		delay      time.Duration
+	}
+	type data struct {
+		args       args
+		wantBounds image.Rectangle
+		wantDelays []int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				boardInFEN: "rnbqk/ppppp/5/PPPPP/RNBQK",
+				moves:      []string{"b2b3", "c4b3", "a1a4"},
+				delay:      time.Second,
+			},
+			wantBounds: image.Rect(0, 0, 50, 50),
+			wantDelays: []int{100, 100, 100, 100},
+		},
+		{
+			args: args{
+				boardInFEN: "12/12/12/12/12/12/12/12/12/12/12/K10k",
+				moves:      []string{"a1b2"},
+				delay:      1234 * time.Millisecond,
+			},
+			wantBounds: image.Rect(0, 0, 120, 120),
+			wantDelays: []int{123, 123},
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var moves []common.Move
+		for _, text := range data.args.moves {
+			move, err := uci.DecodeMove(text)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			moves = append(moves, move)
+		}
+
+		var buffer bytes.Buffer
+		encoder := Encoder{SquareSize: 10}
+		err = encoder.EncodeGIF(&buffer, storage, moves, data.args.delay)
+		if err != nil {
+			test.FailNow()
+		}
+
+		animation, err := gif.DecodeAll(&buffer)
+		if err != nil {
+			test.FailNow()
+		}
+
+		if len(animation.Image) != len(moves)+1 {
+			test.FailNow()
+		}
+		for _, frame := range animation.Image {
+			if frame.Bounds() != data.wantBounds {
+				test.Fail()
+			}
+		}
+		if !reflect.DeepEqual(animation.Delay, data.wantDelays) {
+			test.Fail()
+		}
+
+		// the start of the last move should be highlighted in the last frame
+		lastMove := moves[len(moves)-1]
+		corner := encoder.corner(storage.Size(), lastMove.Start)
+		lastFrame := animation.Image[len(animation.Image)-1]
+		gotColor := lastFrame.At(int(corner.X), int(corner.Y))
+		previousFrame := animation.Image[len(animation.Image)-2]
+		previousColor := previousFrame.At(int(corner.X), int(corner.Y))
+		if reflect.DeepEqual(gotColor, previousColor) {
+			test.Fail()
+		}
+	}
+}
+
+func TestMakePalette(test *testing.T) {
+	red := color.RGBA{0xff, 0, 0, 0xff}
+	green := color.RGBA{0, 0xff, 0, 0xff}
+	blue := color.RGBA{0, 0, 0xff, 0xff}
+	black := color.RGBA{0, 0, 0, 0xff}
+
+	frame := image.NewRGBA(image.Rect(0, 0, 8, 1))
+	for x, value := range []color.RGBA{
+		green, red, blue, red, black, green, red, blue,
+	} {
+		frame.SetRGBA(x, 0, value)
+	}
+
+	got := makePalette([]*image.RGBA{frame})
+
+	// colors with the same count are ordered by their values
+	want := color.Palette{red, blue, green, black}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestMakePaletteWithLimit(test *testing.T) {
+	frame := image.NewRGBA(image.Rect(0, 0, 300, 1))
+	for x := 0; x < 300; x++ {
+		frame.SetRGBA(x, 0, color.RGBA{uint8(x), uint8(x >> 8), 0, 0xff})
+	}
+
+	got := makePalette([]*image.RGBA{frame})
+
+	if len(got) != maxPaletteSize {
+		test.Fail()
+	}
+}
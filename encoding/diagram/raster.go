@@ -0,0 +1,131 @@
+package diagram
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+const (
+	// a pixel is sampled in a grid of this size for anti-aliasing
+	sampleGridSize = 4
+)
+
+// Image ...
+//
+// It rasterizes the diagram of the storage.
+func (encoder Encoder) Image(storage common.PieceStorage) *image.RGBA {
+	width, height := encoder.ImageSize(storage)
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	for _, primitive := range encoder.scene(storage) {
+		fill(canvas, []polygon{primitive.polygon}, primitive.fill)
+		if primitive.strokeWidth > 0 {
+			stroke := primitive.polygon.Stroke(primitive.strokeWidth)
+			fill(canvas, stroke, primitive.stroke)
+		}
+	}
+
+	return canvas
+}
+
+// EncodePNG ...
+//
+// It writes the rasterized diagram of the storage as a PNG image.
+func (encoder Encoder) EncodePNG(
+	writer io.Writer,
+	storage common.PieceStorage,
+) error {
+	return png.Encode(writer, encoder.Image(storage))
+}
+
+// it fills a union of the polygons
+func fill(canvas draw.Image, polygons []polygon, value color.NRGBA) {
+	min := point{math.Inf(1), math.Inf(1)}
+	max := point{math.Inf(-1), math.Inf(-1)}
+	for _, polygon := range polygons {
+		polygonMin, polygonMax := polygon.Bounds()
+		min.X, min.Y = math.Min(min.X, polygonMin.X), math.Min(min.Y, polygonMin.Y)
+		max.X, max.Y = math.Max(max.X, polygonMax.X), math.Max(max.Y, polygonMax.Y)
+	}
+
+	bounds := image.Rect(
+		int(math.Floor(min.X)),
+		int(math.Floor(min.Y)),
+		int(math.Ceil(max.X)),
+		int(math.Ceil(max.Y)),
+	).Intersect(canvas.Bounds())
+	if bounds.Empty() {
+		return
+	}
+
+	// samples are marked row by row via spans between crossings
+	// of the sample row with polygon edges
+	mask := image.NewAlpha(bounds)
+	samples := make([]bool, bounds.Dx()*sampleGridSize)
+	coverages := make([]int, bounds.Dx())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for i := range coverages {
+			coverages[i] = 0
+		}
+
+		for row := 0; row < sampleGridSize; row++ {
+			for i := range samples {
+				samples[i] = false
+			}
+
+			sampleY := float64(y) + (float64(row)+0.5)/sampleGridSize
+			for _, polygon := range polygons {
+				crossings := polygon.Crossings(sampleY)
+				for i := 0; i+1 < len(crossings); i += 2 {
+					markSamples(samples, bounds.Min.X, crossings[i], crossings[i+1])
+				}
+			}
+
+			for i, isCovered := range samples {
+				if isCovered {
+					coverages[i/sampleGridSize]++
+				}
+			}
+		}
+
+		for i, coverage := range coverages {
+			alpha := 0xff * coverage / (sampleGridSize * sampleGridSize)
+			mask.SetAlpha(bounds.Min.X+i, y, color.Alpha{uint8(alpha)})
+		}
+	}
+
+	source := image.NewUniform(value)
+	draw.DrawMask(
+		canvas,
+		bounds,
+		source,
+		image.Point{},
+		mask,
+		bounds.Min,
+		draw.Over,
+	)
+}
+
+// it marks samples, which centers are inside the [start, finish) span
+func markSamples(samples []bool, minX int, start float64, finish float64) {
+	toIndex := func(x float64) int {
+		index := int(math.Ceil((x-float64(minX))*sampleGridSize - 0.5))
+		if index < 0 {
+			return 0
+		}
+		if index > len(samples) {
+			return len(samples)
+		}
+
+		return index
+	}
+
+	for i := toIndex(start); i < toIndex(finish); i++ {
+		samples[i] = true
+	}
+}
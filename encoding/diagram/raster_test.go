@@ -0,0 +1,94 @@
+package diagram
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestEncoderImage(test *testing.T) {
+	storage := boards.NewMapBoard(common.Size{Width: 3, Height: 2}, []common.Piece{
+		pieces.NewPawn(common.Black, common.Position{File: 1, Rank: 0}),
+	})
+	encoder := Encoder{
+		SquareSize:     100,
+		Highlights:     []common.Position{{File: 2, Rank: 1}},
+		HighlightColor: color.NRGBA{0x00, 0x00, 0xff, 0xff},
+	}
+	got := encoder.Image(storage)
+
+	if got.Bounds() != image.Rect(0, 0, 300, 200) {
+		test.FailNow()
+	}
+
+	for _, data := range []struct {
+		x         int
+		y         int
+		wantColor color.Color
+	}{
+		// a dark square
+		{x: 1, y: 101, wantColor: color.RGBA{0xb5, 0x88, 0x63, 0xff}},
+		// a light square
+		{x: 1, y: 1, wantColor: color.RGBA{0xf0, 0xd9, 0xb5, 0xff}},
+		// a highlighted square
+		{x: 299, y: 1, wantColor: color.RGBA{0x00, 0x00, 0xff, 0xff}},
+		// a body of the black pawn
+		{x: 150, y: 170, wantColor: color.RGBA{0x22, 0x22, 0x22, 0xff}},
+		// an outline of the black pawn (in a middle of a bottom edge)
+		{x: 150, y: 188, wantColor: color.RGBA{0xff, 0xff, 0xff, 0xff}},
+	} {
+		gotColor := got.At(data.x, data.y)
+
+		if !reflect.DeepEqual(gotColor, data.wantColor) {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncoderEncodePNG(test *testing.T) {
+	storage := boards.NewMapBoard(common.Size{Width: 3, Height: 2}, nil)
+	encoder := Encoder{SquareSize: 10}
+
+	var buffer bytes.Buffer
+	err := encoder.EncodePNG(&buffer, storage)
+	if err != nil {
+		test.FailNow()
+	}
+
+	decodedImage, err := png.Decode(&buffer)
+	if err != nil {
+		test.FailNow()
+	}
+
+	if decodedImage.Bounds() != image.Rect(0, 0, 30, 20) {
+		test.Fail()
+	}
+}
+
+func TestFill(test *testing.T) {
+	canvas := image.NewRGBA(image.Rect(0, 0, 4, 1))
+	fill(
+		canvas,
+		[]polygon{rectangle(0, 0, 1.5, 1), rectangle(1, 0, 1, 1)},
+		color.NRGBA{0xff, 0x00, 0x00, 0xff},
+	)
+
+	want := []color.Color{
+		color.RGBA{0xff, 0x00, 0x00, 0xff},
+		color.RGBA{0xff, 0x00, 0x00, 0xff},
+		color.RGBA{0x00, 0x00, 0x00, 0x00},
+		color.RGBA{0x00, 0x00, 0x00, 0x00},
+	}
+	for x, wantColor := range want {
+		if !reflect.DeepEqual(canvas.At(x, 0), wantColor) {
+			test.Fail()
+		}
+	}
+}
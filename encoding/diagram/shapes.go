@@ -0,0 +1,76 @@
+package diagram
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+const (
+	shapeSize = 100
+)
+
+// shapes of pieces are described in a square with a side of shapeSize,
+// the Y axis is directed downwards
+var (
+	pieceBase = rectangle(22, 78, 56, 10)
+	shapes    = map[common.Kind][]polygon{
+		common.King: {
+			pieceBase,
+			{{30, 78}, {22, 44}, {40, 50}, {50, 38}, {60, 50}, {78, 44}, {70, 78}},
+			rectangle(46, 10, 8, 28),
+			rectangle(38, 17, 24, 8),
+		},
+		common.Queen: {
+			pieceBase,
+			{
+				{28, 78}, {18, 30}, {36, 54}, {42, 20}, {50, 52},
+				{58, 20}, {64, 54}, {82, 30}, {72, 78},
+			},
+			circle(18, 27, 5),
+			circle(42, 17, 5),
+			circle(58, 17, 5),
+			circle(82, 27, 5),
+		},
+		common.Rook: {
+			pieceBase,
+			{
+				{24, 18}, {34, 18}, {34, 26}, {45, 26}, {45, 18}, {55, 18},
+				{55, 26}, {66, 26}, {66, 18}, {76, 18}, {76, 36}, {68, 42},
+				{68, 78}, {32, 78}, {32, 42}, {24, 36},
+			},
+		},
+		common.Bishop: {
+			pieceBase,
+			{{34, 78}, {40, 64}, {60, 64}, {66, 78}},
+			ellipse(50, 44, 16, 22),
+			circle(50, 17, 6),
+		},
+		common.Knight: {
+			pieceBase,
+			{
+				{30, 78}, {70, 78}, {70, 56}, {74, 36}, {64, 20}, {52, 12},
+				{46, 20}, {36, 28}, {22, 46}, {24, 56}, {34, 58}, {46, 48},
+				{36, 66},
+			},
+		},
+		common.Pawn: {
+			pieceBase,
+			{{40, 44}, {60, 44}, {68, 78}, {32, 78}},
+			circle(50, 32, 13),
+		},
+	}
+
+	// it's used for kinds without a special shape
+	defaultShape = []polygon{
+		pieceBase,
+		circle(50, 50, 26),
+	}
+)
+
+func pieceShape(kind common.Kind) []polygon {
+	shape, ok := shapes[kind]
+	if !ok {
+		return defaultShape
+	}
+
+	return shape
+}
@@ -0,0 +1,88 @@
+package diagram
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// EncodeSVG ...
+//
+// It writes the diagram of the storage as an SVG document.
+func (encoder Encoder) EncodeSVG(
+	writer io.Writer,
+	storage common.PieceStorage,
+) error {
+	width, height := encoder.ImageSize(storage)
+	bufferedWriter := bufio.NewWriter(writer)
+	fmt.Fprintf(
+		bufferedWriter,
+		`<svg xmlns="http://www.w3.org/2000/svg" `+
+			`width="%d" height="%d" viewBox="0 0 %[1]d %[2]d">`+"\n",
+		width,
+		height,
+	)
+	for _, primitive := range encoder.scene(storage) {
+		fmt.Fprintf(
+			bufferedWriter,
+			`<polygon points="%s" %s`,
+			encodePoints(primitive.polygon),
+			encodePaint("fill", primitive.fill),
+		)
+		if primitive.strokeWidth > 0 {
+			fmt.Fprintf(
+				bufferedWriter,
+				` %s stroke-width="%s" stroke-linejoin="round"`,
+				encodePaint("stroke", primitive.stroke),
+				encodeNumber(primitive.strokeWidth),
+			)
+		}
+
+		fmt.Fprint(bufferedWriter, "/>\n")
+	}
+	fmt.Fprint(bufferedWriter, "</svg>\n")
+
+	return bufferedWriter.Flush()
+}
+
+func encodePoints(points polygon) string {
+	encodedPoints := make([]string, 0, len(points))
+	for _, point := range points {
+		encodedPoint := encodeNumber(point.X) + "," + encodeNumber(point.Y)
+		encodedPoints = append(encodedPoints, encodedPoint)
+	}
+
+	return strings.Join(encodedPoints, " ")
+}
+
+func encodePaint(attribute string, value color.NRGBA) string {
+	paint := fmt.Sprintf(
+		`%s="#%02x%02x%02x"`,
+		attribute,
+		value.R,
+		value.G,
+		value.B,
+	)
+	if value.A != 0xff {
+		opacity := encodeNumber(float64(value.A) / 0xff)
+		paint += fmt.Sprintf(` %s-opacity="%s"`, attribute, opacity)
+	}
+
+	return paint
+}
+
+func encodeNumber(number float64) string {
+	text := strconv.FormatFloat(number, 'f', 2, 64)
+	text = strings.TrimRight(text, "0")
+	text = strings.TrimSuffix(text, ".")
+	if text == "-0" {
+		text = "0"
+	}
+
+	return text
+}
@@ -0,0 +1,78 @@
+package diagram
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestEncoderEncodeSVG(test *testing.T) {
+	storage := boards.NewMapBoard(common.Size{Width: 2, Height: 1}, nil)
+	encoder := Encoder{
+		SquareSize: 10,
+		Highlights: []common.Position{{File: 1, Rank: 0}},
+	}
+
+	var buffer bytes.Buffer
+	err := encoder.EncodeSVG(&buffer, storage)
+
+	want := `<svg xmlns="http://www.w3.org/2000/svg" ` +
+		`width="20" height="10" viewBox="0 0 20 10">` + "\n" +
+		`<polygon points="0,0 10,0 10,10 0,10" fill="#b58863"/>` + "\n" +
+		`<polygon points="10,0 20,0 20,10 10,10" fill="#f0d9b5"/>` + "\n" +
+		`<polygon points="10,0 20,0 20,10 10,10" ` +
+		`fill="#9bc700" fill-opacity="0.6"/>` + "\n" +
+		`</svg>` + "\n"
+	if buffer.String() != want {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestEncodePaint(test *testing.T) {
+	type args struct {
+		attribute string
+		value     color.NRGBA
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"fill", color.NRGBA{0x12, 0xab, 0x00, 0xff}},
+			want: `fill="#12ab00"`,
+		},
+		{
+			args: args{"stroke", color.NRGBA{0x12, 0xab, 0x00, 0x80}},
+			want: `stroke="#12ab00" stroke-opacity="0.5"`,
+		},
+	} {
+		got := encodePaint(data.args.attribute, data.args.value)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeNumber(test *testing.T) {
+	for number, want := range map[float64]string{
+		0:        "0",
+		-0.001:   "0",
+		12:       "12",
+		12.5:     "12.5",
+		1.0 / 3:  "0.33",
+		-2.0 / 3: "-0.67",
+	} {
+		if got := encodeNumber(number); got != want {
+			test.Fail()
+		}
+	}
+}
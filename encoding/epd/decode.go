@@ -0,0 +1,198 @@
+package epd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/san"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+const (
+	positionFieldCount = 4
+)
+
+// DecodeColor ...
+//
+// It decodes a color from the active color field of FEN.
+func DecodeColor(text string) (common.Color, error) {
+	switch text {
+	case "b":
+		return common.Black, nil
+	case "w":
+		return common.White, nil
+	default:
+		return 0, errors.New("unknown color")
+	}
+}
+
+// DecodeRecord ...
+//
+// It decodes a record from a single line of Extended Position Description.
+func DecodeRecord(
+	text string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (Record, error) {
+	fields := strings.Fields(text)
+	if len(fields) < positionFieldCount {
+		return Record{}, errors.New("incorrect field count")
+	}
+
+	storage, err :=
+		uci.DecodePieceStorage(fields[0], pieceFactory, pieceStorageFactory)
+	if err != nil {
+		return Record{}, fmt.Errorf("incorrect piece placement: %s", err)
+	}
+
+	color, err := DecodeColor(fields[1])
+	if err != nil {
+		return Record{}, fmt.Errorf("incorrect active color: %s", err)
+	}
+
+	castling := fields[2]
+	if err := checkCastling(castling); err != nil {
+		return Record{}, fmt.Errorf("incorrect castling: %s", err)
+	}
+
+	enPassant := fields[3]
+	if enPassant != "-" {
+		if _, err := uci.DecodePosition(enPassant); err != nil {
+			return Record{}, fmt.Errorf("incorrect en passant: %s", err)
+		}
+	}
+
+	// operations can contain quoted strings, so they are split separately
+	operationsText := text
+	for i := 0; i < positionFieldCount; i++ {
+		operationsText = strings.TrimLeftFunc(operationsText, unicode.IsSpace)
+		operationsText = strings.TrimLeftFunc(operationsText, func(r rune) bool {
+			return !unicode.IsSpace(r)
+		})
+	}
+
+	operations, err := decodeOperations(operationsText)
+	if err != nil {
+		return Record{}, err
+	}
+
+	for index, operation := range operations {
+		if !IsMoveOpcode(operation.Opcode) {
+			continue
+		}
+
+		moves, err := decodeMoves(operation, storage, color)
+		if err != nil {
+			const message = "incorrect moves of the %q opcode: %s"
+			return Record{}, fmt.Errorf(message, operation.Opcode, err)
+		}
+
+		operations[index].Moves = moves
+	}
+
+	record := Record{
+		Storage:    storage,
+		Color:      color,
+		Castling:   castling,
+		EnPassant:  enPassant,
+		Operations: operations,
+	}
+	return record, nil
+}
+
+func checkCastling(text string) error {
+	if text == "-" {
+		return nil
+	}
+
+	// besides the KQkq symbols, files of rooks are allowed (X-FEN/Shredder-FEN)
+	for _, symbol := range text {
+		if (symbol < 'a' || symbol > 'z') && (symbol < 'A' || symbol > 'Z') {
+			return errors.New("unknown symbol")
+		}
+	}
+
+	return nil
+}
+
+func decodeOperations(text string) ([]Operation, error) {
+	var operations []Operation
+	var operation Operation
+	var token strings.Builder
+	var isQuoted, hasToken bool
+	flushToken := func() {
+		if !hasToken {
+			return
+		}
+
+		if operation.Opcode == "" {
+			operation.Opcode = token.String()
+		} else {
+			operation.Operands = append(operation.Operands, token.String())
+		}
+
+		token.Reset()
+		hasToken = false
+	}
+
+	for _, symbol := range text {
+		switch {
+		case isQuoted && symbol == '"':
+			isQuoted = false
+		case isQuoted:
+			token.WriteRune(symbol)
+		case symbol == '"':
+			isQuoted, hasToken = true, true
+		case symbol == ';':
+			flushToken()
+			if operation.Opcode == "" {
+				return nil, errors.New("operation without an opcode")
+			}
+
+			operations = append(operations, operation)
+			operation = Operation{}
+		case unicode.IsSpace(symbol):
+			flushToken()
+		default:
+			token.WriteRune(symbol)
+			hasToken = true
+		}
+	}
+	if isQuoted {
+		return nil, errors.New("unterminated string")
+	}
+
+	flushToken()
+	if operation.Opcode != "" {
+		return nil, errors.New("unterminated operation")
+	}
+
+	return operations, nil
+}
+
+func decodeMoves(
+	operation Operation,
+	storage common.PieceStorage,
+	color common.Color,
+) ([]common.Move, error) {
+	moves := make([]common.Move, 0, len(operation.Operands))
+	for _, operand := range operation.Operands {
+		move, err := san.DecodeMove(operand, storage, color)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect move %q: %s", operand, err)
+		}
+
+		moves = append(moves, move)
+
+		// moves of a principal variation are applied one after another
+		if operation.Opcode == "pv" {
+			storage = storage.ApplyMove(move)
+			color = color.Negative()
+		}
+	}
+
+	return moves, nil
+}
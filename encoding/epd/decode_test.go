@@ -0,0 +1,246 @@
+package epd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestDecodeColor(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args      args
+		wantColor common.Color
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			args:      args{"b"},
+			wantColor: common.Black,
+			wantErr:   false,
+		},
+		{
+			args:      args{"w"},
+			wantColor: common.White,
+			wantErr:   false,
+		},
+		{
+			args:      args{"x"},
+			wantColor: 0,
+			wantErr:   true,
+		},
+	} {
+		gotColor, gotErr := DecodeColor(data.args.text)
+
+		if gotColor != data.wantColor {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodeRecord(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args       args
+		wantRecord Record
+		wantErr    bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 w - -",
+			},
+			wantRecord: Record{
+				Storage: boards.NewMapBoard(
+					common.Size{Width: 8, Height: 8},
+					[]common.Piece{
+						pieces.NewKing(common.White, common.Position{File: 4, Rank: 0}),
+						pieces.NewKnight(common.White, common.Position{File: 6, Rank: 0}),
+						pieces.NewKing(common.Black, common.Position{File: 4, Rank: 7}),
+					},
+				),
+				Color:      common.White,
+				Castling:   "-",
+				EnPassant:  "-",
+				Operations: nil,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text: `4k3/8/8/8/8/8/8/4K1N1 w - - ` +
+					`bm Nf3 Ne2; id "test; #1"; c0 "a  comment"; D1 7;`,
+			},
+			wantRecord: Record{
+				Storage: boards.NewMapBoard(
+					common.Size{Width: 8, Height: 8},
+					[]common.Piece{
+						pieces.NewKing(common.White, common.Position{File: 4, Rank: 0}),
+						pieces.NewKnight(common.White, common.Position{File: 6, Rank: 0}),
+						pieces.NewKing(common.Black, common.Position{File: 4, Rank: 7}),
+					},
+				),
+				Color:     common.White,
+				Castling:  "-",
+				EnPassant: "-",
+				Operations: []Operation{
+					{
+						Opcode:   "bm",
+						Operands: []string{"Nf3", "Ne2"},
+						Moves: []common.Move{
+							{
+								Start:  common.Position{File: 6, Rank: 0},
+								Finish: common.Position{File: 5, Rank: 2},
+							},
+							{
+								Start:  common.Position{File: 6, Rank: 0},
+								Finish: common.Position{File: 4, Rank: 1},
+							},
+						},
+					},
+					{
+						Opcode:   "id",
+						Operands: []string{"test; #1"},
+					},
+					{
+						Opcode:   "c0",
+						Operands: []string{"a  comment"},
+					},
+					{
+						Opcode:   "D1",
+						Operands: []string{"7"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 b KQkq e3 pv Kd7 Nf3 Ke6;",
+			},
+			wantRecord: Record{
+				Storage: boards.NewMapBoard(
+					common.Size{Width: 8, Height: 8},
+					[]common.Piece{
+						pieces.NewKing(common.White, common.Position{File: 4, Rank: 0}),
+						pieces.NewKnight(common.White, common.Position{File: 6, Rank: 0}),
+						pieces.NewKing(common.Black, common.Position{File: 4, Rank: 7}),
+					},
+				),
+				Color:     common.Black,
+				Castling:  "KQkq",
+				EnPassant: "e3",
+				Operations: []Operation{
+					{
+						Opcode:   "pv",
+						Operands: []string{"Kd7", "Nf3", "Ke6"},
+						Moves: []common.Move{
+							{
+								Start:  common.Position{File: 4, Rank: 7},
+								Finish: common.Position{File: 3, Rank: 6},
+							},
+							{
+								Start:  common.Position{File: 6, Rank: 0},
+								Finish: common.Position{File: 5, Rank: 2},
+							},
+							{
+								Start:  common.Position{File: 3, Rank: 6},
+								Finish: common.Position{File: 4, Rank: 5},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 w -",
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1X1 w - -",
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 x - -",
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 w K1 -",
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 w - e",
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text: `4k3/8/8/8/8/8/8/4K1N1 w - - id "test;`,
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 w - - id test",
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 w - - ;",
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text: "4k3/8/8/8/8/8/8/4K1N1 w - - bm Nf4;",
+			},
+			wantRecord: Record{},
+			wantErr:    true,
+		},
+	} {
+		gotRecord, gotErr :=
+			DecodeRecord(data.args.text, pieces.NewPiece, boards.NewMapBoard)
+
+		if !reflect.DeepEqual(gotRecord, data.wantRecord) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package epd
+
+import (
+	"strings"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/san"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// EncodeColor ...
+//
+// It converts the color to the active color field of FEN.
+func EncodeColor(color common.Color) string {
+	if color == common.Black {
+		return "b"
+	}
+
+	return "w"
+}
+
+// EncodeRecord ...
+//
+// It converts the record to a single line of Extended Position Description.
+//
+// Operands of move opcodes are always encoded from the Moves field
+// of the operation, operands of the rest opcodes are encoded
+// from the Operands field.
+func EncodeRecord(record Record) string {
+	fields := []string{
+		uci.EncodePieceStorage(record.Storage),
+		EncodeColor(record.Color),
+		defaultField(record.Castling),
+		defaultField(record.EnPassant),
+	}
+	for _, operation := range record.Operations {
+		fields = append(fields, encodeOperation(operation, record))
+	}
+
+	return strings.Join(fields, " ")
+}
+
+func defaultField(field string) string {
+	if field == "" {
+		return "-"
+	}
+
+	return field
+}
+
+func encodeOperation(operation Operation, record Record) string {
+	operands := operation.Operands
+	if IsMoveOpcode(operation.Opcode) {
+		operands = encodeMoves(operation, record.Storage)
+	}
+
+	parts := []string{operation.Opcode}
+	for _, operand := range operands {
+		if IsStringOpcode(operation.Opcode) || needsQuotes(operand) {
+			operand = `"` + operand + `"`
+		}
+
+		parts = append(parts, operand)
+	}
+
+	return strings.Join(parts, " ") + ";"
+}
+
+func encodeMoves(
+	operation Operation,
+	storage common.PieceStorage,
+) []string {
+	operands := make([]string, 0, len(operation.Moves))
+	for _, move := range operation.Moves {
+		operands = append(operands, san.EncodeMove(move, storage))
+
+		// moves of a principal variation are applied one after another
+		if operation.Opcode == "pv" {
+			storage = storage.ApplyMove(move)
+		}
+	}
+
+	return operands
+}
+
+func needsQuotes(operand string) bool {
+	return operand == "" || strings.ContainsAny(operand, " \t;")
+}
@@ -0,0 +1,142 @@
+package epd
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestEncodeColor(test *testing.T) {
+	type args struct {
+		color common.Color
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.Black},
+			want: "b",
+		},
+		{
+			args: args{common.White},
+			want: "w",
+		},
+	} {
+		got := EncodeColor(data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeRecord(test *testing.T) {
+	storage := boards.NewMapBoard(
+		common.Size{Width: 8, Height: 8},
+		[]common.Piece{
+			pieces.NewKing(common.White, common.Position{File: 4, Rank: 0}),
+			pieces.NewKnight(common.White, common.Position{File: 6, Rank: 0}),
+			pieces.NewKing(common.Black, common.Position{File: 4, Rank: 7}),
+		},
+	)
+
+	type args struct {
+		record Record
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				record: Record{
+					Storage: storage,
+					Color:   common.White,
+				},
+			},
+			want: "4k3/8/8/8/8/8/8/4K1N1 w - -",
+		},
+		{
+			args: args{
+				record: Record{
+					Storage:   storage,
+					Color:     common.Black,
+					Castling:  "KQkq",
+					EnPassant: "e3",
+					Operations: []Operation{
+						{
+							Opcode:   "pv",
+							Operands: []string{"e8d7", "g1f3"},
+							Moves: []common.Move{
+								{
+									Start:  common.Position{File: 4, Rank: 7},
+									Finish: common.Position{File: 3, Rank: 6},
+								},
+								{
+									Start:  common.Position{File: 6, Rank: 0},
+									Finish: common.Position{File: 5, Rank: 2},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: "4k3/8/8/8/8/8/8/4K1N1 b KQkq e3 pv Kd7 Nf3;",
+		},
+		{
+			args: args{
+				record: Record{
+					Storage:   storage,
+					Color:     common.White,
+					Castling:  "-",
+					EnPassant: "-",
+					Operations: []Operation{
+						{
+							Opcode: "bm",
+							Moves: []common.Move{
+								{
+									Start:  common.Position{File: 6, Rank: 0},
+									Finish: common.Position{File: 5, Rank: 2},
+								},
+								{
+									Start:  common.Position{File: 6, Rank: 0},
+									Finish: common.Position{File: 4, Rank: 1},
+								},
+							},
+						},
+						{
+							Opcode:   "id",
+							Operands: []string{"test"},
+						},
+						{
+							Opcode:   "c1",
+							Operands: []string{"a comment"},
+						},
+						{
+							Opcode:   "D1",
+							Operands: []string{"7"},
+						},
+						{
+							Opcode: "noop",
+						},
+					},
+				},
+			},
+			want: `4k3/8/8/8/8/8/8/4K1N1 w - - ` +
+				`bm Nf3 Ne2; id "test"; c1 "a comment"; D1 7; noop;`,
+		},
+	} {
+		got := EncodeRecord(data.args.record)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package epd_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/encoding/epd"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func ExampleDecodeRecord() {
+	const text = `4k3/8/8/8/8/8/8/4K1N1 w - - bm Nf3; id "example";`
+	record, _ := epd.DecodeRecord(text, pieces.NewPiece, boards.NewMapBoard)
+	operation, _ := record.Operation("bm")
+	fmt.Printf("%+v\n", operation.Moves)
+
+	// Output: [{Start:{File:6 Rank:0} Finish:{File:5 Rank:2} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}]
+}
+
+func ExampleEncodeRecord() {
+	const text = `4k3/8/8/8/8/8/8/4K1N1 w - - bm g1f3; id example;`
+	record, _ := epd.DecodeRecord(text, pieces.NewPiece, boards.NewMapBoard)
+	fmt.Println(epd.EncodeRecord(record))
+
+	// Output: 4k3/8/8/8/8/8/8/4K1N1 w - - bm Nf3; id "example";
+}
@@ -0,0 +1,70 @@
+package epd
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Operation ...
+//
+// For move opcodes (see the IsMoveOpcode() function), the Moves field
+// contains operands decoded relative to the record position.
+type Operation struct {
+	Opcode   string
+	Operands []string
+	Moves    []common.Move
+}
+
+// Record ...
+//
+// It represents a single line of Extended Position Description.
+//
+// The Castling and EnPassant fields are stored as is; "-" means their absence.
+type Record struct {
+	Storage    common.PieceStorage
+	Color      common.Color
+	Castling   string
+	EnPassant  string
+	Operations []Operation
+}
+
+// Operation ...
+//
+// It returns the first operation with the specified opcode.
+func (record Record) Operation(opcode string) (operation Operation, ok bool) {
+	for _, operation := range record.Operations {
+		if operation.Opcode == opcode {
+			return operation, true
+		}
+	}
+
+	return Operation{}, false
+}
+
+// IsMoveOpcode ...
+//
+// It checks that operands of the opcode are moves in SAN.
+// Operands of the "pv" opcode are a sequence of moves, while operands
+// of the rest move opcodes are alternatives in the record position.
+func IsMoveOpcode(opcode string) bool {
+	switch opcode {
+	case "am", "bm", "pm", "sm", "pv":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsStringOpcode ...
+//
+// It checks that operands of the opcode are strings that should be quoted.
+func IsStringOpcode(opcode string) bool {
+	switch opcode {
+	case "id", "eco", "nic", "tcgs", "tcri", "tcsi":
+		return true
+	}
+
+	// comments (c0...c9) and variation names (v0...v9)
+	return len(opcode) == 2 &&
+		(opcode[0] == 'c' || opcode[0] == 'v') &&
+		opcode[1] >= '0' && opcode[1] <= '9'
+}
@@ -0,0 +1,87 @@
+package epd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRecordOperation(test *testing.T) {
+	type fields struct {
+		operations []Operation
+	}
+	type args struct {
+		opcode string
+	}
+	type data struct {
+		fields        fields
+		args          args
+		wantOperation Operation
+		wantOk        bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				operations: []Operation{
+					{Opcode: "id", Operands: []string{"one"}},
+					{Opcode: "c0", Operands: []string{"two"}},
+					{Opcode: "id", Operands: []string{"three"}},
+				},
+			},
+			args:          args{"id"},
+			wantOperation: Operation{Opcode: "id", Operands: []string{"one"}},
+			wantOk:        true,
+		},
+		{
+			fields: fields{
+				operations: []Operation{
+					{Opcode: "id", Operands: []string{"one"}},
+				},
+			},
+			args:          args{"bm"},
+			wantOperation: Operation{},
+			wantOk:        false,
+		},
+	} {
+		record := Record{Operations: data.fields.operations}
+		gotOperation, gotOk := record.Operation(data.args.opcode)
+
+		if !reflect.DeepEqual(gotOperation, data.wantOperation) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsMoveOpcode(test *testing.T) {
+	for opcode, want := range map[string]bool{
+		"am": true,
+		"bm": true,
+		"pv": true,
+		"id": false,
+		"D1": false,
+	} {
+		if got := IsMoveOpcode(opcode); got != want {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsStringOpcode(test *testing.T) {
+	for opcode, want := range map[string]bool{
+		"id":  true,
+		"c0":  true,
+		"v9":  true,
+		"c":   false,
+		"c10": false,
+		"cx":  false,
+		"bm":  false,
+		"D1":  false,
+	} {
+		if got := IsStringOpcode(opcode); got != want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package json
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// DecodePosition ...
+func DecodePosition(data []byte) (common.Position, error) {
+	var position Position
+	if err := stdjson.Unmarshal(data, &position); err != nil {
+		return common.Position{}, err
+	}
+
+	return position.ToCommon(), nil
+}
+
+// DecodeMove ...
+func DecodeMove(data []byte) (common.Move, error) {
+	var move Move
+	if err := stdjson.Unmarshal(data, &move); err != nil {
+		return common.Move{}, err
+	}
+
+	return move.ToCommon(), nil
+}
+
+// DecodePiece ...
+func DecodePiece(
+	data []byte,
+	factory common.PieceFactory,
+) (common.Piece, error) {
+	var piece Piece
+	if err := stdjson.Unmarshal(data, &piece); err != nil {
+		return nil, err
+	}
+
+	return piece.ToCommon(factory), nil
+}
+
+// DecodePieceStorage ...
+//
+// It checks that pieces are inside the size and don't overlap.
+func DecodePieceStorage(
+	data []byte,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	var storage PieceStorage
+	if err := stdjson.Unmarshal(data, &storage); err != nil {
+		return nil, err
+	}
+
+	size := storage.Size.ToCommon()
+	if size.Width <= 0 || size.Height <= 0 {
+		return nil, errors.New("incorrect size")
+	}
+
+	occupiedPositions := make(map[common.Position]struct{})
+	for index, piece := range storage.Pieces {
+		position := piece.Position.ToCommon()
+		if !size.HasPosition(position) {
+			return nil, fmt.Errorf("piece #%d is out of size", index)
+		}
+		if _, ok := occupiedPositions[position]; ok {
+			return nil, fmt.Errorf("piece #%d overlaps another one", index)
+		}
+
+		occupiedPositions[position] = struct{}{}
+	}
+
+	return storage.ToCommon(pieceFactory, pieceStorageFactory), nil
+}
@@ -0,0 +1,251 @@
+package json
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestDecodePosition(test *testing.T) {
+	type args struct {
+		data string
+	}
+	type data struct {
+		args         args
+		wantPosition common.Position
+		wantErr      bool
+	}
+
+	for _, data := range []data{
+		{
+			args:         args{`{"file":4,"rank":1}`},
+			wantPosition: common.Position{File: 4, Rank: 1},
+			wantErr:      false,
+		},
+		{
+			args:         args{`{"file":"e","rank":1}`},
+			wantPosition: common.Position{},
+			wantErr:      true,
+		},
+	} {
+		gotPosition, gotErr := DecodePosition([]byte(data.args.data))
+
+		if gotPosition != data.wantPosition {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodeMove(test *testing.T) {
+	type args struct {
+		data string
+	}
+	type data struct {
+		args     args
+		wantMove common.Move
+		wantErr  bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{`{"start":{"file":4,"rank":1},"finish":{"file":4,"rank":2}}`},
+			wantMove: common.Move{
+				Start:  common.Position{File: 4, Rank: 1},
+				Finish: common.Position{File: 4, Rank: 2},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				`{"start":{"file":4,"rank":6},"finish":{"file":4,"rank":7},` +
+					`"promotion":"king"}`,
+			},
+			wantMove: common.Move{
+				Start:       common.Position{File: 4, Rank: 6},
+				Finish:      common.Position{File: 4, Rank: 7},
+				Promotion:   common.King,
+				IsPromotion: true,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				`{"start":{"file":0,"rank":0},"finish":{"file":4,"rank":3},` +
+					`"drop":"pawn"}`,
+			},
+			wantMove: common.Move{
+				Finish: common.Position{File: 4, Rank: 3},
+				Drop:   common.Pawn,
+				IsDrop: true,
+			},
+			wantErr: false,
+		},
+		{
+			args:     args{`{"start":[4,1]}`},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{
+				`{"start":{"file":4,"rank":6},"finish":{"file":4,"rank":7},` +
+					`"promotion":"unknown"}`,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+	} {
+		gotMove, gotErr := DecodeMove([]byte(data.args.data))
+
+		if gotMove != data.wantMove {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodePiece(test *testing.T) {
+	type args struct {
+		data string
+	}
+	type data struct {
+		args      args
+		wantPiece common.Piece
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				`{"kind":"knight","color":"white","position":{"file":1,"rank":0}}`,
+			},
+			wantPiece: pieces.NewKnight(
+				common.White,
+				common.Position{File: 1, Rank: 0},
+			),
+			wantErr: false,
+		},
+		{
+			args: args{
+				`{"kind":"wizard","color":"white","position":{"file":1,"rank":0}}`,
+			},
+			wantPiece: nil,
+			wantErr:   true,
+		},
+		{
+			args: args{
+				`{"kind":"knight","color":"red","position":{"file":1,"rank":0}}`,
+			},
+			wantPiece: pieces.NewKnight(
+				common.Red,
+				common.Position{File: 1, Rank: 0},
+			),
+			wantErr: false,
+		},
+		{
+			args: args{
+				`{"kind":"knight","color":"pink","position":{"file":1,"rank":0}}`,
+			},
+			wantPiece: nil,
+			wantErr:   true,
+		},
+	} {
+		gotPiece, gotErr := DecodePiece([]byte(data.args.data), pieces.NewPiece)
+
+		if !reflect.DeepEqual(gotPiece, data.wantPiece) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		data string
+	}
+	type data struct {
+		args        args
+		wantStorage common.PieceStorage
+		wantErr     bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				`{"size":{"width":3,"height":2},"pieces":[` +
+					`{"kind":"king","color":"white","position":{"file":1,"rank":0}},` +
+					`{"kind":"rook","color":"black","position":{"file":2,"rank":1}}]}`,
+			},
+			wantStorage: boards.NewMapBoard(
+				common.Size{Width: 3, Height: 2},
+				[]common.Piece{
+					pieces.NewKing(common.White, common.Position{File: 1, Rank: 0}),
+					pieces.NewRook(common.Black, common.Position{File: 2, Rank: 1}),
+				},
+			),
+			wantErr: false,
+		},
+		{
+			args: args{
+				`{"size":{"width":0,"height":2},"pieces":[]}`,
+			},
+			wantStorage: nil,
+			wantErr:     true,
+		},
+		{
+			args: args{
+				`{"size":{"width":3,"height":2},"pieces":[` +
+					`{"kind":"king","color":"white","position":{"file":3,"rank":0}}]}`,
+			},
+			wantStorage: nil,
+			wantErr:     true,
+		},
+		{
+			args: args{
+				`{"size":{"width":3,"height":2},"pieces":[` +
+					`{"kind":"king","color":"white","position":{"file":1,"rank":0}},` +
+					`{"kind":"rook","color":"black","position":{"file":1,"rank":0}}]}`,
+			},
+			wantStorage: nil,
+			wantErr:     true,
+		},
+		{
+			args: args{
+				`{"size":{"width":3,"height":2},"pieces":[` +
+					`{"kind":"king","color":"pink","position":{"file":1,"rank":0}}]}`,
+			},
+			wantStorage: nil,
+			wantErr:     true,
+		},
+	} {
+		gotStorage, gotErr := DecodePieceStorage(
+			[]byte(data.args.data),
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+
+		if !reflect.DeepEqual(gotStorage, data.wantStorage) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package json
+
+import (
+	stdjson "encoding/json"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// EncodePosition ...
+func EncodePosition(position common.Position) ([]byte, error) {
+	return stdjson.Marshal(NewPosition(position))
+}
+
+// EncodeMove ...
+func EncodeMove(move common.Move) ([]byte, error) {
+	return stdjson.Marshal(NewMove(move))
+}
+
+// EncodePiece ...
+func EncodePiece(piece common.Piece) ([]byte, error) {
+	return stdjson.Marshal(NewPiece(piece))
+}
+
+// EncodePieceStorage ...
+func EncodePieceStorage(storage common.PieceStorage) ([]byte, error) {
+	return stdjson.Marshal(NewPieceStorage(storage))
+}
@@ -0,0 +1,178 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+type MockPiece struct {
+	kind     common.Kind
+	color    common.Color
+	position common.Position
+}
+
+func (piece MockPiece) Kind() common.Kind {
+	return piece.kind
+}
+
+func (piece MockPiece) Color() common.Color {
+	return piece.color
+}
+
+func (piece MockPiece) Position() common.Position {
+	return piece.position
+}
+
+func (piece MockPiece) ApplyPosition(position common.Position) common.Piece {
+	panic("not implemented")
+}
+
+func (piece MockPiece) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	panic("not implemented")
+}
+
+func TestEncodePosition(test *testing.T) {
+	data, err := EncodePosition(common.Position{File: 4, Rank: 1})
+
+	if string(data) != `{"file":4,"rank":1}` {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestEncodeMove(test *testing.T) {
+	data, err := EncodeMove(common.Move{
+		Start:  common.Position{File: 4, Rank: 1},
+		Finish: common.Position{File: 4, Rank: 2},
+	})
+
+	const want = `{"start":{"file":4,"rank":1},"finish":{"file":4,"rank":2}}`
+	if string(data) != want {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestEncodeMove_withPromotion(test *testing.T) {
+	data, err := EncodeMove(common.Move{
+		Start:       common.Position{File: 4, Rank: 6},
+		Finish:      common.Position{File: 4, Rank: 7},
+		Promotion:   common.Queen,
+		IsPromotion: true,
+	})
+
+	const want = `{"start":{"file":4,"rank":6},"finish":{"file":4,"rank":7},` +
+		`"promotion":"queen"}`
+	if string(data) != want {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestEncodeMove_withDrop(test *testing.T) {
+	data, err := EncodeMove(common.Move{
+		Finish: common.Position{File: 4, Rank: 3},
+		Drop:   common.Knight,
+		IsDrop: true,
+	})
+
+	const want = `{"start":{"file":0,"rank":0},"finish":{"file":4,"rank":3},` +
+		`"drop":"knight"}`
+	if string(data) != want {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestEncodePiece(test *testing.T) {
+	type args struct {
+		piece common.Piece
+	}
+	type data struct {
+		args     args
+		wantData string
+		wantErr  bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				piece: pieces.NewKnight(common.White, common.Position{File: 1, Rank: 0}),
+			},
+			wantData: `{"kind":"knight","color":"white",` +
+				`"position":{"file":1,"rank":0}}`,
+			wantErr: false,
+		},
+		{
+			args: args{
+				piece: pieces.NewKnight(common.Green, common.Position{File: 1, Rank: 0}),
+			},
+			wantData: `{"kind":"knight","color":"green",` +
+				`"position":{"file":1,"rank":0}}`,
+			wantErr: false,
+		},
+		{
+			args: args{
+				piece: MockPiece{kind: common.KindCount, color: common.Black},
+			},
+			wantData: "",
+			wantErr:  true,
+		},
+	} {
+		gotData, gotErr := EncodePiece(data.args.piece)
+
+		if string(gotData) != data.wantData {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePieceStorage(test *testing.T) {
+	for _, factory := range []func(
+		size common.Size,
+		pieces []common.Piece,
+	) common.PieceStorage{
+		boards.NewMapBoard,
+		boards.NewSliceBoard,
+		func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+			return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+		},
+	} {
+		storage := factory(common.Size{Width: 3, Height: 2}, []common.Piece{
+			pieces.NewRook(common.Black, common.Position{File: 2, Rank: 1}),
+			pieces.NewKing(common.Black, common.Position{File: 0, Rank: 1}),
+			pieces.NewKing(common.White, common.Position{File: 1, Rank: 0}),
+		})
+		data, err := EncodePieceStorage(storage)
+
+		const want = `{"size":{"width":3,"height":2},"pieces":[` +
+			`{"kind":"king","color":"white","position":{"file":1,"rank":0}},` +
+			`{"kind":"king","color":"black","position":{"file":0,"rank":1}},` +
+			`{"kind":"rook","color":"black","position":{"file":2,"rank":1}}]}`
+		if string(data) != want {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package json_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/json"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func ExampleEncodePieceStorage() {
+	board := boards.NewMapBoard(common.Size{Width: 5, Height: 5}, []common.Piece{
+		pieces.NewRook(common.Black, common.Position{File: 2, Rank: 2}),
+		pieces.NewBishop(common.White, common.Position{File: 3, Rank: 3}),
+	})
+	data, _ := json.EncodePieceStorage(board)
+	fmt.Printf("%s\n", data)
+
+	// Output: {"size":{"width":5,"height":5},"pieces":[{"kind":"rook","color":"black","position":{"file":2,"rank":2}},{"kind":"bishop","color":"white","position":{"file":3,"rank":3}}]}
+}
+
+func ExampleDecodePieceStorage() {
+	const data = `{"size":{"width":5,"height":5},"pieces":[` +
+		`{"kind":"rook","color":"black","position":{"file":2,"rank":2}},` +
+		`{"kind":"bishop","color":"white","position":{"file":3,"rank":3}}]}`
+	storage, _ :=
+		json.DecodePieceStorage([]byte(data), pieces.NewPiece, boards.NewMapBoard)
+	fmt.Printf("%v\n", uci.EncodePieceStorage(storage))
+
+	// Output: 5/3B1/2r2/5/5
+}
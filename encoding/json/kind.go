@@ -0,0 +1,73 @@
+package json
+
+import (
+	"errors"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+var (
+	colorNames = map[common.Color]string{
+		common.Black:  "black",
+		common.White:  "white",
+		common.Red:    "red",
+		common.Blue:   "blue",
+		common.Yellow: "yellow",
+		common.Green:  "green",
+	}
+)
+
+// Kind ...
+//
+// It's represented in JSON as a lowercase English name of the kind
+// (e.g. "knight"), including registered ones (see common.RegisterKind()).
+type Kind common.Kind
+
+// MarshalText ...
+func (kind Kind) MarshalText() ([]byte, error) {
+	descriptor, ok := common.LookupKind(common.Kind(kind))
+	if !ok {
+		return nil, errors.New("unknown kind")
+	}
+
+	return []byte(descriptor.Name), nil
+}
+
+// UnmarshalText ...
+func (kind *Kind) UnmarshalText(text []byte) error {
+	knownKind, ok := common.LookupKindByName(string(text))
+	if !ok {
+		return errors.New("unknown kind")
+	}
+
+	*kind = Kind(knownKind)
+	return nil
+}
+
+// Color ...
+//
+// It's represented in JSON as a lowercase English name of the color
+// (e.g. "black"), including colors of four-player chess (e.g. "red").
+type Color common.Color
+
+// MarshalText ...
+func (color Color) MarshalText() ([]byte, error) {
+	name, ok := colorNames[common.Color(color)]
+	if !ok {
+		return nil, errors.New("unknown color")
+	}
+
+	return []byte(name), nil
+}
+
+// UnmarshalText ...
+func (color *Color) UnmarshalText(text []byte) error {
+	for knownColor, name := range colorNames {
+		if name == string(text) {
+			*color = Color(knownColor)
+			return nil
+		}
+	}
+
+	return errors.New("unknown color")
+}
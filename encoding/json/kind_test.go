@@ -0,0 +1,178 @@
+package json
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestKindMarshalText(test *testing.T) {
+	type data struct {
+		kind     Kind
+		wantText []byte
+		wantErr  bool
+	}
+
+	for _, data := range []data{
+		{
+			kind:     Kind(common.King),
+			wantText: []byte("king"),
+			wantErr:  false,
+		},
+		{
+			kind:     Kind(common.Pawn),
+			wantText: []byte("pawn"),
+			wantErr:  false,
+		},
+		{
+			kind:     Kind(common.Amazon),
+			wantText: []byte("amazon"),
+			wantErr:  false,
+		},
+		{
+			kind:     Kind(common.KindCount),
+			wantText: nil,
+			wantErr:  true,
+		},
+	} {
+		gotText, gotErr := data.kind.MarshalText()
+
+		if !reflect.DeepEqual(gotText, data.wantText) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestKindUnmarshalText(test *testing.T) {
+	type data struct {
+		text     []byte
+		wantKind Kind
+		wantErr  bool
+	}
+
+	for _, data := range []data{
+		{
+			text:     []byte("queen"),
+			wantKind: Kind(common.Queen),
+			wantErr:  false,
+		},
+		{
+			text:     []byte("chancellor"),
+			wantKind: Kind(common.Chancellor),
+			wantErr:  false,
+		},
+		{
+			text:     []byte("knight"),
+			wantKind: Kind(common.Knight),
+			wantErr:  false,
+		},
+		{
+			text:     []byte("Knight"),
+			wantKind: 0,
+			wantErr:  true,
+		},
+	} {
+		var gotKind Kind
+		gotErr := gotKind.UnmarshalText(data.text)
+
+		if gotKind != data.wantKind {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestColorMarshalText(test *testing.T) {
+	type data struct {
+		color    Color
+		wantText []byte
+		wantErr  bool
+	}
+
+	for _, data := range []data{
+		{
+			color:    Color(common.Black),
+			wantText: []byte("black"),
+			wantErr:  false,
+		},
+		{
+			color:    Color(common.White),
+			wantText: []byte("white"),
+			wantErr:  false,
+		},
+		{
+			color:    Color(common.Red),
+			wantText: []byte("red"),
+			wantErr:  false,
+		},
+		{
+			color:    Color(common.AllColorCount),
+			wantText: nil,
+			wantErr:  true,
+		},
+	} {
+		gotText, gotErr := data.color.MarshalText()
+
+		if !reflect.DeepEqual(gotText, data.wantText) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestColorUnmarshalText(test *testing.T) {
+	type data struct {
+		text      []byte
+		wantColor Color
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			text:      []byte("black"),
+			wantColor: Color(common.Black),
+			wantErr:   false,
+		},
+		{
+			text:      []byte("white"),
+			wantColor: Color(common.White),
+			wantErr:   false,
+		},
+		{
+			text:      []byte("red"),
+			wantColor: Color(common.Red),
+			wantErr:   false,
+		},
+		{
+			text:      []byte("pink"),
+			wantColor: 0,
+			wantErr:   true,
+		},
+	} {
+		var gotColor Color
+		gotErr := gotColor.UnmarshalText(data.text)
+
+		if gotColor != data.wantColor {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Package json implements coding of chess entities in JSON.
+//
+// The schema of JSON documents is the following:
+//
+//	position:      {"file": 4, "rank": 1}
+//	move:          {"start": <position>, "finish": <position>}
+//	               {"start": <position>, "finish": <position>, "promotion": "queen"}
+//	               {"start": <position>, "finish": <position>, "drop": "pawn"}
+//	piece:         {"kind": "knight", "color": "white", "position": <position>}
+//	piece storage: {"size": {"width": 8, "height": 8}, "pieces": [<piece>, ...]}
+//
+// Files and ranks are zero-based. Kinds are lowercase names of built-in
+// and registered kinds (e.g. "king", "knight" or "chancellor"); colors
+// are "black" and "white" and also "red", "blue", "yellow" and "green"
+// of four-player chess.
+// The promotion and drop fields of a move are optional (the start of a drop
+// is ignored).
+// Pieces of a piece storage are ordered by ranks and then by files.
+package json
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// Size ...
+type Size struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// NewSize ...
+func NewSize(size common.Size) Size {
+	return Size{Width: size.Width, Height: size.Height}
+}
+
+// ToCommon ...
+func (size Size) ToCommon() common.Size {
+	return common.Size{Width: size.Width, Height: size.Height}
+}
+
+// Position ...
+type Position struct {
+	File int `json:"file"`
+	Rank int `json:"rank"`
+}
+
+// NewPosition ...
+func NewPosition(position common.Position) Position {
+	return Position{File: position.File, Rank: position.Rank}
+}
+
+// ToCommon ...
+func (position Position) ToCommon() common.Position {
+	return common.Position{File: position.File, Rank: position.Rank}
+}
+
+// Move ...
+type Move struct {
+	Start     Position `json:"start"`
+	Finish    Position `json:"finish"`
+	Promotion *Kind    `json:"promotion,omitempty"`
+	Drop      *Kind    `json:"drop,omitempty"`
+}
+
+// NewMove ...
+func NewMove(move common.Move) Move {
+	var promotion *Kind
+	if move.IsPromotion {
+		kind := Kind(move.Promotion)
+		promotion = &kind
+	}
+
+	var drop *Kind
+	if move.IsDrop {
+		kind := Kind(move.Drop)
+		drop = &kind
+	}
+
+	return Move{
+		Start:     NewPosition(move.Start),
+		Finish:    NewPosition(move.Finish),
+		Promotion: promotion,
+		Drop:      drop,
+	}
+}
+
+// ToCommon ...
+func (move Move) ToCommon() common.Move {
+	commonMove := common.Move{
+		Start:  move.Start.ToCommon(),
+		Finish: move.Finish.ToCommon(),
+	}
+	if move.Promotion != nil {
+		commonMove.Promotion = common.Kind(*move.Promotion)
+		commonMove.IsPromotion = true
+	}
+	if move.Drop != nil {
+		commonMove.Drop = common.Kind(*move.Drop)
+		commonMove.IsDrop = true
+	}
+
+	return commonMove
+}
+
+// Piece ...
+type Piece struct {
+	Kind     Kind     `json:"kind"`
+	Color    Color    `json:"color"`
+	Position Position `json:"position"`
+}
+
+// NewPiece ...
+func NewPiece(piece common.Piece) Piece {
+	return Piece{
+		Kind:     Kind(piece.Kind()),
+		Color:    Color(piece.Color()),
+		Position: NewPosition(piece.Position()),
+	}
+}
+
+// ToCommon ...
+func (piece Piece) ToCommon(factory common.PieceFactory) common.Piece {
+	kind, color := common.Kind(piece.Kind), common.Color(piece.Color)
+	return factory(kind, color, piece.Position.ToCommon())
+}
+
+// PieceStorage ...
+type PieceStorage struct {
+	Size   Size    `json:"size"`
+	Pieces []Piece `json:"pieces"`
+}
+
+// NewPieceStorage ...
+func NewPieceStorage(storage common.PieceStorage) PieceStorage {
+	// use the common.Pieces() function instead of the Pieces() method
+	// for a deterministic order of pieces
+	storagePieces := common.Pieces(storage)
+	pieces := make([]Piece, 0, len(storagePieces))
+	for _, piece := range storagePieces {
+		pieces = append(pieces, NewPiece(piece))
+	}
+
+	return PieceStorage{Size: NewSize(storage.Size()), Pieces: pieces}
+}
+
+// ToCommon ...
+//
+// It doesn't check that pieces are inside the size and don't overlap;
+// see the DecodePieceStorage() function for that.
+func (storage PieceStorage) ToCommon(
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) common.PieceStorage {
+	pieces := make([]common.Piece, 0, len(storage.Pieces))
+	for _, piece := range storage.Pieces {
+		pieces = append(pieces, piece.ToCommon(pieceFactory))
+	}
+
+	return pieceStorageFactory(storage.Size.ToCommon(), pieces)
+}
@@ -0,0 +1,43 @@
+package san
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// it doesn't check that the move is correct
+func isLegalMove(storage common.PieceStorage, move common.Move) bool {
+	piece, ok := storage.Piece(move.Start)
+	if !ok {
+		return false
+	}
+
+	var generator models.MoveGenerator
+	nextStorage := storage.ApplyMove(move)
+	_, err := generator.MovesForColor(nextStorage, piece.Color().Negative())
+	return err == nil
+}
+
+// it returns starts of all legal moves of pieces of the same kind and color
+// as the specified one that finish on the specified position
+func rivalStarts(
+	storage common.PieceStorage,
+	piece common.Piece,
+	finish common.Position,
+) []common.Position {
+	var starts []common.Position
+	for _, rival := range storage.Pieces() {
+		if rival.Kind() != piece.Kind() || rival.Color() != piece.Color() {
+			continue
+		}
+
+		move := common.Move{Start: rival.Position(), Finish: finish}
+		if storage.CheckMove(move) != nil || !isLegalMove(storage, move) {
+			continue
+		}
+
+		starts = append(starts, rival.Position())
+	}
+
+	return starts
+}
@@ -0,0 +1,159 @@
+package san
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+const (
+	annotationSymbols = "+#!?"
+)
+
+var (
+	moveInSAN = regexp.MustCompile(
+		`^(?P<kind>[A-Z])?(?P<file>[a-z])?(?P<rank>[0-9]+)?x?` +
+			`(?P<finish>[a-z][0-9]+)` +
+			`(?:=(?P<promotion>[A-Z]))?$`,
+	)
+)
+
+// DecodeMove ...
+//
+// It decodes a move from Standard Algebraic Notation relative
+// to the specified storage. A move in pure algebraic coordinate notation
+// is also accepted. Ranks can have several digits (e.g. "Ra10").
+//
+// A suffix of a check, a checkmate or an annotation (e.g. "+", "#" or "!?")
+// is optional and ignored.
+//
+// A promotion is marked by the "=" symbol followed by an uppercase kind
+// of a promoted piece (e.g. "e8=Q"); only a pawn move to the last rank
+// can be a promotion.
+//
+// It takes into account possible checks, so the decoded move is always legal.
+func DecodeMove(
+	text string,
+	storage common.PieceStorage,
+	color common.Color,
+) (common.Move, error) {
+	text = strings.TrimRight(text, annotationSymbols)
+	if strings.HasPrefix(text, "O-O") || strings.HasPrefix(text, "0-0") {
+		return common.Move{}, errors.New("castling isn't supported")
+	}
+
+	// pawn moves in SAN can't have a rank of their start
+	match := moveInSAN.FindStringSubmatch(text)
+	if match == nil || match[moveInSAN.SubexpIndex("kind")] == "" &&
+		match[moveInSAN.SubexpIndex("rank")] != "" {
+		return decodeCoordinateMove(text, storage, color)
+	}
+
+	var promotion common.Kind
+	promotionInSAN := match[moveInSAN.SubexpIndex("promotion")]
+	if promotionInSAN != "" {
+		var ok bool
+		promotion, ok = common.LookupKindBySymbol(rune(promotionInSAN[0]))
+		if !ok {
+			return common.Move{}, errors.New("incorrect promotion")
+		}
+	}
+
+	kind := common.Pawn
+	if kindInSAN := match[moveInSAN.SubexpIndex("kind")]; kindInSAN != "" {
+		piece, err := uci.DecodePiece(rune(kindInSAN[0]), pieces.NewPiece)
+		if err != nil {
+			return common.Move{}, fmt.Errorf("incorrect kind: %s", err)
+		}
+
+		kind = piece.Kind()
+	}
+
+	finish, err := uci.DecodePosition(match[moveInSAN.SubexpIndex("finish")])
+	if err != nil {
+		return common.Move{}, fmt.Errorf("incorrect finish: %s", err)
+	}
+
+	startFile, startRank := -1, -1
+	if file := match[moveInSAN.SubexpIndex("file")]; file != "" {
+		startFile = int(file[0]) - 'a'
+	}
+	if rank := match[moveInSAN.SubexpIndex("rank")]; rank != "" {
+		rankNumber, err := strconv.Atoi(rank)
+		if err != nil {
+			return common.Move{}, fmt.Errorf("incorrect rank: %s", err)
+		}
+
+		startRank = rankNumber - 1
+	}
+
+	var moves []common.Move
+	for _, piece := range storage.Pieces() {
+		if piece.Kind() != kind || piece.Color() != color {
+			continue
+		}
+
+		start := piece.Position()
+		if (startFile != -1 && start.File != startFile) ||
+			(startRank != -1 && start.Rank != startRank) {
+			continue
+		}
+
+		move := common.Move{Start: start, Finish: finish}
+		if promotionInSAN != "" {
+			move.Promotion, move.IsPromotion = promotion, true
+		}
+		if !isCorrectMove(storage, move) {
+			continue
+		}
+
+		moves = append(moves, move)
+	}
+
+	switch len(moves) {
+	case 0:
+		return common.Move{}, errors.New("illegal move")
+	case 1:
+		return moves[0], nil
+	default:
+		return common.Move{}, errors.New("ambiguous move")
+	}
+}
+
+func decodeCoordinateMove(
+	text string,
+	storage common.PieceStorage,
+	color common.Color,
+) (common.Move, error) {
+	move, err := uci.DecodeMove(text)
+	if err != nil {
+		return common.Move{}, fmt.Errorf("incorrect move: %s", err)
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || piece.Color() != color {
+		return common.Move{}, errors.New("no piece of the color")
+	}
+
+	if !isCorrectMove(storage, move) {
+		return common.Move{}, errors.New("illegal move")
+	}
+
+	return move, nil
+}
+
+// it checks that the move is legal and that only a pawn move
+// to the last rank is a promotion
+func isCorrectMove(storage common.PieceStorage, move common.Move) bool {
+	if move.IsPromotion && !common.IsPawnPromotion(storage, move) {
+		return false
+	}
+
+	return storage.CheckMove(move) == nil && isLegalMove(storage, move)
+}
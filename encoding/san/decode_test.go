@@ -0,0 +1,284 @@
+package san
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestDecodeMove(test *testing.T) {
+	type args struct {
+		text       string
+		boardInFEN string
+		color      common.Color
+	}
+	type data struct {
+		args     args
+		wantMove common.Move
+		wantErr  bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				text:       "e4",
+				boardInFEN: "4k3/8/8/8/8/8/4P3/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{
+				text:       "e3",
+				boardInFEN: "4k3/8/8/8/8/8/4P3/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:  common.Position{File: 4, Rank: 1},
+				Finish: common.Position{File: 4, Rank: 2},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "exd5+",
+				boardInFEN: "4k3/8/8/3p4/4P3/8/8/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:  common.Position{File: 4, Rank: 3},
+				Finish: common.Position{File: 3, Rank: 4},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "Nbd2",
+				boardInFEN: "4k3/8/8/8/8/5N2/8/1N2K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:  common.Position{File: 1, Rank: 0},
+				Finish: common.Position{File: 3, Rank: 1},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "Nd2",
+				boardInFEN: "4k3/8/8/8/8/5N2/8/1N2K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{
+				// the knight on e2 is pinned, so there is no ambiguity
+				text:       "Nc3",
+				boardInFEN: "4r1k1/8/8/8/8/8/4N3/1N2K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:  common.Position{File: 1, Rank: 0},
+				Finish: common.Position{File: 2, Rank: 2},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "R1a3",
+				boardInFEN: "R3k3/8/8/8/8/8/8/R3K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:  common.Position{File: 0, Rank: 0},
+				Finish: common.Position{File: 0, Rank: 2},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "Ra10",
+				boardInFEN: "4k3/8/8/8/8/8/8/8/8/R3K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:  common.Position{File: 0, Rank: 0},
+				Finish: common.Position{File: 0, Rank: 9},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "R10a5",
+				boardInFEN: "R3k3/8/8/8/8/8/8/8/8/R3K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:  common.Position{File: 0, Rank: 9},
+				Finish: common.Position{File: 0, Rank: 4},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "Qxh2!?",
+				boardInFEN: "4k3/8/8/8/8/8/7P/q3K3",
+				color:      common.Black,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{
+				text:       "Qh8",
+				boardInFEN: "q3k3/8/8/8/8/8/8/4K3",
+				color:      common.Black,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{
+				text:       "d8h4",
+				boardInFEN: "3qk3/8/8/8/8/8/8/4K3",
+				color:      common.Black,
+			},
+			wantMove: common.Move{
+				Start:  common.Position{File: 3, Rank: 7},
+				Finish: common.Position{File: 7, Rank: 3},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "d8h4",
+				boardInFEN: "3qk3/8/8/8/8/8/8/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{
+				text:       "O-O",
+				boardInFEN: "4k3/8/8/8/8/8/8/4K2R",
+				color:      common.White,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{
+				text:       "e8=Q",
+				boardInFEN: "k7/4P3/8/8/8/8/8/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:       common.Position{File: 4, Rank: 6},
+				Finish:      common.Position{File: 4, Rank: 7},
+				Promotion:   common.Queen,
+				IsPromotion: true,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "dxe8=N+",
+				boardInFEN: "k3r3/3P4/8/8/8/8/8/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:       common.Position{File: 3, Rank: 6},
+				Finish:      common.Position{File: 4, Rank: 7},
+				Promotion:   common.Knight,
+				IsPromotion: true,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "e1=R",
+				boardInFEN: "k7/8/8/8/8/8/4p3/K7",
+				color:      common.Black,
+			},
+			wantMove: common.Move{
+				Start:       common.Position{File: 4, Rank: 1},
+				Finish:      common.Position{File: 4, Rank: 0},
+				Promotion:   common.Rook,
+				IsPromotion: true,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "e7e8q",
+				boardInFEN: "k7/4P3/8/8/8/8/8/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{
+				Start:       common.Position{File: 4, Rank: 6},
+				Finish:      common.Position{File: 4, Rank: 7},
+				Promotion:   common.Queen,
+				IsPromotion: true,
+			},
+			wantErr: false,
+		},
+		{
+			// not the last rank
+			args: args{
+				text:       "e6=Q",
+				boardInFEN: "k7/8/8/4P3/8/8/8/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			// not a pawn
+			args: args{
+				text:       "Re8=Q",
+				boardInFEN: "k7/4R3/8/8/8/8/8/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{
+				text:       "e8=X",
+				boardInFEN: "k7/4P3/8/8/8/8/8/4K3",
+				color:      common.White,
+			},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		gotMove, gotErr :=
+			DecodeMove(data.args.text, storage, data.args.color)
+
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package san
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// EncodeMove ...
+//
+// It converts the move to Standard Algebraic Notation relative
+// to the specified storage.
+//
+// A promotion is converted as in DecodeMove() (e.g. "e8=Q").
+//
+// It doesn't add a suffix of a check or a checkmate ("+" or "#"),
+// so the result is in a subset of SAN without them; DecodeMove() accepts
+// moves both with and without these suffixes.
+//
+// It doesn't check that the move is correct.
+func EncodeMove(move common.Move, storage common.PieceStorage) string {
+	piece, ok := storage.Piece(move.Start)
+	if !ok {
+		return uci.EncodeMove(move)
+	}
+
+	var capture string
+	if _, ok := storage.Piece(move.Finish); ok {
+		capture = "x"
+	}
+
+	finish := uci.EncodePosition(move.Finish)
+	if piece.Kind() == common.Pawn {
+		var promotion string
+		if move.IsPromotion {
+			descriptor, _ := common.LookupKind(move.Promotion)
+			promotion = "=" + string(unicode.ToUpper(descriptor.Symbol))
+		}
+
+		if capture == "" && move.Start.File == move.Finish.File {
+			return finish + promotion
+		}
+
+		start := uci.EncodePosition(move.Start)
+		return start[:1] + "x" + finish + promotion
+	}
+
+	kind := strings.ToUpper(uci.EncodePiece(piece))
+	disambiguation := encodeDisambiguation(move, storage, piece)
+	return kind + disambiguation + capture + finish
+}
+
+func encodeDisambiguation(
+	move common.Move,
+	storage common.PieceStorage,
+	piece common.Piece,
+) string {
+	var hasRivals, hasFileRivals, hasRankRivals bool
+	for _, start := range rivalStarts(storage, piece, move.Finish) {
+		if start == move.Start {
+			continue
+		}
+
+		hasRivals = true
+		if start.File == move.Start.File {
+			hasFileRivals = true
+		}
+		if start.Rank == move.Start.Rank {
+			hasRankRivals = true
+		}
+	}
+
+	start := uci.EncodePosition(move.Start)
+	switch {
+	case !hasRivals:
+		return ""
+	case !hasFileRivals:
+		return start[:1]
+	case !hasRankRivals:
+		return start[1:]
+	default:
+		return start
+	}
+}
@@ -0,0 +1,165 @@
+package san
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestEncodeMove(test *testing.T) {
+	type args struct {
+		move       common.Move
+		boardInFEN string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 4, Rank: 1},
+					Finish: common.Position{File: 4, Rank: 2},
+				},
+				boardInFEN: "4k3/8/8/8/8/8/4P3/4K3",
+			},
+			want: "e3",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 4, Rank: 3},
+					Finish: common.Position{File: 3, Rank: 4},
+				},
+				boardInFEN: "4k3/8/8/3p4/4P3/8/8/4K3",
+			},
+			want: "exd5",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 1, Rank: 0},
+					Finish: common.Position{File: 2, Rank: 2},
+				},
+				boardInFEN: "4k3/8/8/8/8/8/8/1N2K3",
+			},
+			want: "Nc3",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 1, Rank: 0},
+					Finish: common.Position{File: 3, Rank: 1},
+				},
+				boardInFEN: "4k3/8/8/8/8/5N2/8/1N2K3",
+			},
+			want: "Nbd2",
+		},
+		{
+			args: args{
+				// the knight on e2 is pinned, so there is no ambiguity
+				move: common.Move{
+					Start:  common.Position{File: 1, Rank: 0},
+					Finish: common.Position{File: 2, Rank: 2},
+				},
+				boardInFEN: "4r1k1/8/8/8/8/8/4N3/1N2K3",
+			},
+			want: "Nc3",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 0, Rank: 0},
+					Finish: common.Position{File: 0, Rank: 2},
+				},
+				boardInFEN: "R3k3/8/8/8/8/8/8/R3K3",
+			},
+			want: "R1a3",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 0, Rank: 9},
+					Finish: common.Position{File: 0, Rank: 4},
+				},
+				boardInFEN: "R3k3/8/8/8/8/8/8/8/8/R3K3",
+			},
+			want: "R10a5",
+		},
+		{
+			args: args{
+				// the check isn't marked
+				move: common.Move{
+					Start:  common.Position{File: 0, Rank: 0},
+					Finish: common.Position{File: 0, Rank: 9},
+				},
+				boardInFEN: "4k3/8/8/8/8/8/8/8/8/R3K3",
+			},
+			want: "Ra10",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 7, Rank: 7},
+					Finish: common.Position{File: 4, Rank: 4},
+				},
+				boardInFEN: "1q2k2q/8/8/4P3/8/8/7q/4K3",
+			},
+			want: "Qh8xe5",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:       common.Position{File: 4, Rank: 6},
+					Finish:      common.Position{File: 4, Rank: 7},
+					Promotion:   common.Queen,
+					IsPromotion: true,
+				},
+				boardInFEN: "k7/4P3/8/8/8/8/8/4K3",
+			},
+			want: "e8=Q",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:       common.Position{File: 3, Rank: 6},
+					Finish:      common.Position{File: 4, Rank: 7},
+					Promotion:   common.Knight,
+					IsPromotion: true,
+				},
+				boardInFEN: "k3r3/3P4/8/8/8/8/8/4K3",
+			},
+			want: "dxe8=N",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 2, Rank: 2},
+					Finish: common.Position{File: 3, Rank: 3},
+				},
+				boardInFEN: "4k3/8/8/8/8/8/8/4K3",
+			},
+			want: "c3d4",
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := EncodeMove(data.args.move, storage)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
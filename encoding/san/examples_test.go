@@ -0,0 +1,32 @@
+package san_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/san"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func ExampleDecodeMove() {
+	const fen = "4k3/8/8/8/8/5N2/8/1N2K3"
+	storage, _ := uci.DecodePieceStorage(fen, pieces.NewPiece, boards.NewMapBoard)
+	move, _ := san.DecodeMove("Nbd2", storage, common.White)
+	fmt.Printf("%+v\n", move)
+
+	// Output: {Start:{File:1 Rank:0} Finish:{File:3 Rank:1} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}
+}
+
+func ExampleEncodeMove() {
+	const fen = "4k3/8/8/8/8/5N2/8/1N2K3"
+	storage, _ := uci.DecodePieceStorage(fen, pieces.NewPiece, boards.NewMapBoard)
+	move := san.EncodeMove(common.Move{
+		Start:  common.Position{File: 1, Rank: 0},
+		Finish: common.Position{File: 3, Rank: 1},
+	}, storage)
+	fmt.Printf("%v\n", move)
+
+	// Output: Nbd2
+}
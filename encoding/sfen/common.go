@@ -0,0 +1,44 @@
+// Package sfen implements SFEN, the Shogi Forsyth-Edwards Notation.
+//
+// A position consists of a board, a color to move ("b" for sente, i.e. white,
+// and "w" for gote, i.e. black), pieces in hands and a move number separated
+// by spaces (e.g. "lnsgkgsnl/1r5b1/ppppppppp/9/9/9/PPPPPPPPP/1B5R1/LNSGKGSNL
+// b - 1").
+//
+// On a board, promoted pieces are marked by the "+" symbol before a symbol
+// of an unpromoted kind (e.g. "+P" for a tokin). Files go from left to right
+// as in the generic FEN, so moves can be coded by the encoding/uci package.
+package sfen
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+)
+
+const (
+	promotionMark = '+'
+	emptyHands    = "-"
+)
+
+// kinds of pieces in hands in the order of their encoding
+func handKinds() []common.Kind {
+	return []common.Kind{
+		common.Rook,
+		common.Bishop,
+		shogi.GoldKind,
+		shogi.SilverKind,
+		common.Knight,
+		shogi.LanceKind,
+		common.Pawn,
+	}
+}
+
+func isHandKind(kind common.Kind) bool {
+	for _, handKind := range handKinds() {
+		if handKind == kind {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,197 @@
+package sfen
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+)
+
+// DecodeColor ...
+//
+// It decodes a color from the side to move field of SFEN.
+func DecodeColor(text string) (common.Color, error) {
+	switch text {
+	case "b":
+		return common.White, nil
+	case "w":
+		return common.Black, nil
+	default:
+		return 0, errors.New("unknown color")
+	}
+}
+
+// DecodeHands ...
+//
+// It decodes pieces in hands. Each kind is optionally prefixed by a count
+// (e.g. "2Pb"), uppercase symbols are for white. The "-" symbol means
+// empty hands.
+func DecodeHands(text string) (crazyhouse.Reserves, error) {
+	var hands crazyhouse.Reserves
+	if text == emptyHands {
+		return hands, nil
+	}
+	if text == "" {
+		return crazyhouse.Reserves{}, errors.New("empty hands")
+	}
+
+	symbols := []rune(text)
+	for index := 0; index < len(symbols); index++ {
+		countEnd := index
+		for countEnd < len(symbols) && unicode.IsDigit(symbols[countEnd]) {
+			countEnd++
+		}
+		if countEnd == len(symbols) {
+			return crazyhouse.Reserves{}, errors.New("count without a kind")
+		}
+
+		count := 1
+		if countEnd != index {
+			var err error
+			count, err = strconv.Atoi(string(symbols[index:countEnd]))
+			if err != nil || count == 0 {
+				return crazyhouse.Reserves{}, errors.New("incorrect count")
+			}
+		}
+
+		symbol := symbols[countEnd]
+		kind, ok := shogi.Kinds.LookupKindBySymbol(symbol)
+		if !ok || !isHandKind(kind) {
+			return crazyhouse.Reserves{}, fmt.Errorf("incorrect kind %q", symbol)
+		}
+
+		color := common.White
+		if unicode.IsLower(symbol) {
+			color = common.Black
+		}
+		for i := 0; i < count; i++ {
+			hands = hands.Add(color, kind)
+		}
+
+		index = countEnd
+	}
+
+	return hands, nil
+}
+
+// DecodePieceStorage ...
+//
+// It decodes a piece storage from the board field of SFEN.
+//
+// The piece factory should make Shogi pieces, see shogi.NewPieceFactory().
+func DecodePieceStorage(
+	text string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	var genericFEN strings.Builder
+	var isPromoted bool
+	for _, symbol := range text {
+		if symbol == promotionMark {
+			if isPromoted {
+				return nil, errors.New("double promotion mark")
+			}
+
+			isPromoted = true
+			continue
+		}
+		if !unicode.IsLetter(symbol) {
+			if isPromoted {
+				return nil, errors.New("promotion mark without a kind")
+			}
+
+			genericFEN.WriteRune(symbol)
+			continue
+		}
+
+		kind, err := decodeKind(unicode.ToLower(symbol), isPromoted)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect kind %q: %s", symbol, err)
+		}
+
+		descriptor, _ := shogi.Kinds.LookupKind(kind)
+		genericSymbol := descriptor.Symbol
+		if unicode.IsUpper(symbol) {
+			genericSymbol = unicode.ToUpper(genericSymbol)
+		}
+
+		genericFEN.WriteRune(genericSymbol)
+		isPromoted = false
+	}
+	if isPromoted {
+		return nil, errors.New("promotion mark without a kind")
+	}
+
+	return uci.Notation{Kinds: shogi.Kinds}.DecodePieceStorage(
+		genericFEN.String(),
+		pieceFactory,
+		pieceStorageFactory,
+	)
+}
+
+// DecodePosition ...
+//
+// It decodes a position from SFEN. The move number is optional
+// and it's 1 by default.
+//
+// The piece factory should make Shogi pieces, see shogi.NewPieceFactory().
+func DecodePosition(
+	text string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (storage shogi.PieceStorage, moveNumber int, err error) {
+	fields := strings.Fields(text)
+	if len(fields) != 3 && len(fields) != 4 {
+		return shogi.PieceStorage{}, 0, errors.New("incorrect field count")
+	}
+
+	board, err :=
+		DecodePieceStorage(fields[0], pieceFactory, pieceStorageFactory)
+	if err != nil {
+		return shogi.PieceStorage{}, 0, fmt.Errorf("incorrect board: %s", err)
+	}
+
+	color, err := DecodeColor(fields[1])
+	if err != nil {
+		return shogi.PieceStorage{}, 0, fmt.Errorf("incorrect color: %s", err)
+	}
+
+	hands, err := DecodeHands(fields[2])
+	if err != nil {
+		return shogi.PieceStorage{}, 0, fmt.Errorf("incorrect hands: %s", err)
+	}
+
+	moveNumber = 1
+	if len(fields) == 4 {
+		moveNumber, err = strconv.Atoi(fields[3])
+		if err != nil || moveNumber < 1 {
+			return shogi.PieceStorage{}, 0, errors.New("incorrect move number")
+		}
+	}
+
+	storage = shogi.NewPieceStorage(board, pieceFactory, color, hands)
+	return storage, moveNumber, nil
+}
+
+func decodeKind(symbol rune, isPromoted bool) (common.Kind, error) {
+	kind, ok := shogi.Kinds.LookupKindBySymbol(symbol)
+	if !ok || kind != common.King && !isHandKind(kind) {
+		return 0, errors.New("unknown kind")
+	}
+	if !isPromoted {
+		return kind, nil
+	}
+
+	promotion, ok := shogi.PromotedKind(kind)
+	if !ok {
+		return 0, errors.New("unpromotable kind")
+	}
+
+	return promotion, nil
+}
@@ -0,0 +1,312 @@
+package sfen
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+)
+
+var testNotation = uci.Notation{Kinds: shogi.Kinds}
+
+func equalHands(a crazyhouse.Reserves, b crazyhouse.Reserves) bool {
+	for _, color := range []common.Color{common.Black, common.White} {
+		kinds := a.Kinds(color)
+		if !reflect.DeepEqual(kinds, b.Kinds(color)) {
+			return false
+		}
+
+		for _, kind := range kinds {
+			if a.Count(color, kind) != b.Count(color, kind) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func TestDecodeColor(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args      args
+		wantColor common.Color
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			args:      args{"b"},
+			wantColor: common.White,
+			wantErr:   false,
+		},
+		{
+			args:      args{"w"},
+			wantColor: common.Black,
+			wantErr:   false,
+		},
+		{
+			args:      args{"x"},
+			wantColor: 0,
+			wantErr:   true,
+		},
+	} {
+		gotColor, gotErr := DecodeColor(data.args.text)
+
+		if gotColor != data.wantColor {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodeHands(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args      args
+		wantHands crazyhouse.Reserves
+		wantErr   bool
+	}
+
+	for _, data := range []data{
+		{
+			args:      args{"-"},
+			wantHands: crazyhouse.Reserves{},
+			wantErr:   false,
+		},
+		{
+			args: args{"RG2sp"},
+			wantHands: crazyhouse.Reserves{}.
+				Add(common.White, common.Rook).
+				Add(common.White, shogi.GoldKind).
+				Add(common.Black, shogi.SilverKind).
+				Add(common.Black, shogi.SilverKind).
+				Add(common.Black, common.Pawn),
+			wantErr: false,
+		},
+		{
+			args: args{"12P"},
+			wantHands: func() crazyhouse.Reserves {
+				var hands crazyhouse.Reserves
+				for i := 0; i < 12; i++ {
+					hands = hands.Add(common.White, common.Pawn)
+				}
+
+				return hands
+			}(),
+			wantErr: false,
+		},
+		{
+			args:      args{""},
+			wantHands: crazyhouse.Reserves{},
+			wantErr:   true,
+		},
+		{
+			args:      args{"2"},
+			wantHands: crazyhouse.Reserves{},
+			wantErr:   true,
+		},
+		{
+			args:      args{"0P"},
+			wantHands: crazyhouse.Reserves{},
+			wantErr:   true,
+		},
+		{
+			args:      args{"K"},
+			wantHands: crazyhouse.Reserves{},
+			wantErr:   true,
+		},
+		{
+			args:      args{"T"},
+			wantHands: crazyhouse.Reserves{},
+			wantErr:   true,
+		},
+	} {
+		gotHands, gotErr := DecodeHands(data.args.text)
+
+		if !equalHands(gotHands, data.wantHands) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args           args
+		wantGenericFEN string
+		wantErr        bool
+	}
+
+	for _, data := range []data{
+		{
+			args:           args{shogi.InitialFEN},
+			wantGenericFEN: shogi.InitialFEN,
+			wantErr:        false,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/1+P5+r1/1+B7/4K4"},
+			wantGenericFEN: "4k4/9/9/9/9/9/1T5u1/1W7/4K4",
+			wantErr:        false,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/1+G7/4K4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/1++P7/4K4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/1+7/4K4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/8+/4K4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/1T7/4K4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/1Q7/4K4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+	} {
+		gotStorage, gotErr := DecodePieceStorage(
+			data.args.text,
+			shogi.NewPiece,
+			boards.NewMapBoard,
+		)
+
+		var gotGenericFEN string
+		if gotStorage != nil {
+			gotGenericFEN = testNotation.EncodePieceStorage(gotStorage)
+		}
+		if gotGenericFEN != data.wantGenericFEN {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodePosition(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args           args
+		wantGenericFEN string
+		wantColor      common.Color
+		wantHands      crazyhouse.Reserves
+		wantMoveNumber int
+		wantErr        bool
+	}
+
+	for _, data := range []data{
+		{
+			args:           args{shogi.InitialFEN + " b - 1"},
+			wantGenericFEN: shogi.InitialFEN,
+			wantColor:      common.White,
+			wantHands:      crazyhouse.Reserves{},
+			wantMoveNumber: 1,
+			wantErr:        false,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/1+P7/4K4 w Bp 42"},
+			wantGenericFEN: "4k4/9/9/9/9/9/9/1T7/4K4",
+			wantColor:      common.Black,
+			wantHands: crazyhouse.Reserves{}.
+				Add(common.White, common.Bishop).
+				Add(common.Black, common.Pawn),
+			wantMoveNumber: 42,
+			wantErr:        false,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/9/4K4 b -"},
+			wantGenericFEN: "4k4/9/9/9/9/9/9/9/4K4",
+			wantColor:      common.White,
+			wantHands:      crazyhouse.Reserves{},
+			wantMoveNumber: 1,
+			wantErr:        false,
+		},
+		{
+			args:    args{"4k4/9/9/9/9/9/9/9/4K4 b"},
+			wantErr: true,
+		},
+		{
+			args:    args{"4k4/9/9/9/9/9/9/1Q7/4K4 b - 1"},
+			wantErr: true,
+		},
+		{
+			args:    args{"4k4/9/9/9/9/9/9/9/4K4 x - 1"},
+			wantErr: true,
+		},
+		{
+			args:    args{"4k4/9/9/9/9/9/9/9/4K4 b Q 1"},
+			wantErr: true,
+		},
+		{
+			args:    args{"4k4/9/9/9/9/9/9/9/4K4 b - 0"},
+			wantErr: true,
+		},
+	} {
+		gotStorage, gotMoveNumber, gotErr := DecodePosition(
+			data.args.text,
+			shogi.NewPiece,
+			boards.NewMapBoard,
+		)
+
+		var gotGenericFEN string
+		if gotStorage.PieceStorage != nil {
+			gotGenericFEN = testNotation.EncodePieceStorage(gotStorage)
+		}
+		if gotGenericFEN != data.wantGenericFEN {
+			test.Fail()
+		}
+		if gotStorage.Color() != data.wantColor {
+			test.Fail()
+		}
+		if !equalHands(gotStorage.Hands(), data.wantHands) {
+			test.Fail()
+		}
+		if gotMoveNumber != data.wantMoveNumber {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package sfen
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+)
+
+// EncodeColor ...
+//
+// It converts the color to the side to move field of SFEN.
+func EncodeColor(color common.Color) string {
+	if color == common.Black {
+		return "w"
+	}
+
+	return "b"
+}
+
+// EncodeHands ...
+//
+// It converts pieces in hands to SFEN (see DecodeHands()). White pieces
+// go first, kinds are ordered from a rook to a pawn.
+func EncodeHands(hands crazyhouse.Reserves) string {
+	var text strings.Builder
+	for _, color := range []common.Color{common.White, common.Black} {
+		for _, kind := range handKinds() {
+			count := hands.Count(color, kind)
+			if count == 0 {
+				continue
+			}
+			if count > 1 {
+				text.WriteString(strconv.Itoa(count))
+			}
+
+			descriptor, _ := shogi.Kinds.LookupKind(kind)
+			symbol := descriptor.Symbol
+			if color == common.White {
+				symbol = unicode.ToUpper(symbol)
+			}
+
+			text.WriteRune(symbol)
+		}
+	}
+	if text.Len() == 0 {
+		return emptyHands
+	}
+
+	return text.String()
+}
+
+// EncodePieceStorage ...
+//
+// It converts the piece storage to the board field of SFEN.
+func EncodePieceStorage(storage common.PieceStorage) string {
+	genericFEN := uci.Notation{Kinds: shogi.Kinds}.EncodePieceStorage(storage)
+
+	var text strings.Builder
+	for _, symbol := range genericFEN {
+		if !unicode.IsLetter(symbol) {
+			text.WriteRune(symbol)
+			continue
+		}
+
+		kind, ok := shogi.Kinds.LookupKindBySymbol(symbol)
+		demotion := shogi.DemotedKind(kind)
+		if !ok || demotion == kind {
+			text.WriteRune(symbol)
+			continue
+		}
+
+		descriptor, _ := shogi.Kinds.LookupKind(demotion)
+		demotionSymbol := descriptor.Symbol
+		if unicode.IsUpper(symbol) {
+			demotionSymbol = unicode.ToUpper(demotionSymbol)
+		}
+
+		text.WriteRune(promotionMark)
+		text.WriteRune(demotionSymbol)
+	}
+
+	return text.String()
+}
+
+// EncodePosition ...
+//
+// It converts the piece storage and the move number to SFEN.
+func EncodePosition(storage shogi.PieceStorage, moveNumber int) string {
+	return strings.Join([]string{
+		EncodePieceStorage(storage),
+		EncodeColor(storage.Color()),
+		EncodeHands(storage.Hands()),
+		strconv.Itoa(moveNumber),
+	}, " ")
+}
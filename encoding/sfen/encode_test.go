@@ -0,0 +1,128 @@
+package sfen
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+)
+
+func TestEncodeColor(test *testing.T) {
+	type args struct {
+		color common.Color
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.White},
+			want: "b",
+		},
+		{
+			args: args{common.Black},
+			want: "w",
+		},
+	} {
+		got := EncodeColor(data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeHands(test *testing.T) {
+	type args struct {
+		hands crazyhouse.Reserves
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{crazyhouse.Reserves{}},
+			want: "-",
+		},
+		{
+			args: args{
+				hands: crazyhouse.Reserves{}.
+					Add(common.Black, common.Pawn).
+					Add(common.Black, shogi.SilverKind).
+					Add(common.Black, shogi.SilverKind).
+					Add(common.White, shogi.GoldKind).
+					Add(common.White, common.Rook),
+			},
+			want: "RG2sp",
+		},
+	} {
+		got := EncodeHands(data.args.hands)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePieceStorage(test *testing.T) {
+	type args struct {
+		genericFEN string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{shogi.InitialFEN},
+			want: shogi.InitialFEN,
+		},
+		{
+			args: args{"4k4/9/9/9/9/9/1T5u1/1W7/4K4"},
+			want: "4k4/9/9/9/9/9/1+P5+r1/1+B7/4K4",
+		},
+	} {
+		storage, err := testNotation.DecodePieceStorage(
+			data.args.genericFEN,
+			shogi.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := EncodePieceStorage(storage)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePosition(test *testing.T) {
+	board, err := testNotation.DecodePieceStorage(
+		"4k4/9/9/9/9/9/9/1T7/4K4",
+		shogi.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	hands := crazyhouse.Reserves{}.
+		Add(common.White, common.Bishop).
+		Add(common.Black, common.Pawn)
+	storage := shogi.NewPieceStorage(board, shogi.NewPiece, common.Black, hands)
+	got := EncodePosition(storage, 42)
+
+	if got != "4k4/9/9/9/9/9/9/1+P7/4K4 w Bp 42" {
+		test.Fail()
+	}
+}
@@ -0,0 +1,33 @@
+package sfen_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/encoding/sfen"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+)
+
+func ExampleDecodePosition() {
+	storage, moveNumber, _ := sfen.DecodePosition(
+		"4k4/9/4P4/9/9/9/9/9/4K4 b 2G 7",
+		shogi.NewPiece,
+		boards.NewMapBoard,
+	)
+
+	generator := models.MoveGenerator{Rules: shogi.Rules{}}
+	for _, text := range []string{"e7e8t", "e9d9", "G@c8"} {
+		move, _ := uci.Notation{Kinds: shogi.Kinds}.DecodeMove(text)
+		storage = generator.ApplyMove(storage, move).(shogi.PieceStorage)
+		moveNumber++
+
+		fmt.Println(sfen.EncodePosition(storage, moveNumber))
+	}
+
+	// Output:
+	// 4k4/4+P4/9/9/9/9/9/9/4K4 w 2G 8
+	// 3k5/4+P4/9/9/9/9/9/9/4K4 b 2G 9
+	// 3k5/2G1+P4/9/9/9/9/9/9/4K4 w G 10
+}
@@ -18,13 +18,16 @@ type PieceStorageFactory func(
 
 const (
 	minFileSymbol = 'a'
+	dropSeparator = '@'
+	holeSymbol    = '*'
 )
 
 // DecodePosition ...
 //
 // It decodes a position from pure algebraic coordinate notation.
+// A rank can consist of several digits (e.g. "a10").
 func DecodePosition(text string) (position common.Position, err error) {
-	if len(text) != 2 {
+	if len(text) < 2 {
 		return common.Position{}, errors.New("incorrect length")
 	}
 
@@ -33,6 +36,9 @@ func DecodePosition(text string) (position common.Position, err error) {
 		return common.Position{}, errors.New("incorrect file")
 	}
 
+	if positionLength(text) != len(text) {
+		return common.Position{}, errors.New("incorrect rank")
+	}
 	rank, err := strconv.Atoi(text[1:])
 	if err != nil {
 		return common.Position{}, fmt.Errorf("incorrect rank: %s", err)
@@ -45,45 +51,90 @@ func DecodePosition(text string) (position common.Position, err error) {
 
 // DecodeMove ...
 //
-// It decodes a move from pure algebraic coordinate notation.
+// It decodes a move from pure algebraic coordinate notation
+// with built-in and registered kinds (see Notation.DecodeMove()).
 func DecodeMove(text string) (move common.Move, err error) {
-	if len(text) != 4 {
-		return common.Move{}, errors.New("incorrect length")
+	return Notation{}.DecodeMove(text)
+}
+
+// DecodeMove ...
+//
+// It decodes a move from pure algebraic coordinate notation.
+//
+// An optional last symbol is a kind of a promoted piece (e.g. "e7e8q").
+// A drop is represented by a kind of a dropped piece and its finish
+// separated by the "@" symbol (e.g. "P@e4").
+func (notation Notation) DecodeMove(text string) (move common.Move, err error) {
+	if len(text) > 1 && text[1] == dropSeparator {
+		return notation.decodeDrop(text)
 	}
 
-	start, err := DecodePosition(text[:2])
+	startLength := positionLength(text)
+	start, err := DecodePosition(text[:startLength])
 	if err != nil {
 		return common.Move{}, fmt.Errorf("incorrect start: %s", err)
 	}
+	text = text[startLength:]
 
-	finish, err := DecodePosition(text[2:])
+	finishLength := positionLength(text)
+	finish, err := DecodePosition(text[:finishLength])
 	if err != nil {
 		return common.Move{}, fmt.Errorf("incorrect finish: %s", err)
 	}
+	text = text[finishLength:]
 
 	move = common.Move{Start: start, Finish: finish}
+	switch len(text) {
+	case 0:
+	case 1:
+		kind, ok := notation.Kinds.LookupKindBySymbol(rune(text[0]))
+		if !ok {
+			return common.Move{}, errors.New("incorrect promotion")
+		}
+
+		move.Promotion, move.IsPromotion = kind, true
+	default:
+		return common.Move{}, errors.New("incorrect length")
+	}
+
+	return move, nil
+}
+
+func (notation Notation) decodeDrop(text string) (
+	move common.Move,
+	err error,
+) {
+	kind, ok := notation.Kinds.LookupKindBySymbol(rune(text[0]))
+	if !ok {
+		return common.Move{}, errors.New("incorrect drop")
+	}
+
+	finish, err := DecodePosition(text[2:])
+	if err != nil {
+		return common.Move{}, fmt.Errorf("incorrect finish: %s", err)
+	}
+
+	move = common.Move{Finish: finish, Drop: kind, IsDrop: true}
 	return move, nil
 }
 
 // DecodePiece ...
 //
-// It decodes a piece from FEN (only a kind and a color, not a position).
+// It decodes a piece from FEN with built-in and registered kinds
+// (see Notation.DecodePiece()).
 func DecodePiece(fen rune, factory common.PieceFactory) (common.Piece, error) {
-	var kind common.Kind
-	switch unicode.ToLower(fen) {
-	case 'k':
-		kind = common.King
-	case 'q':
-		kind = common.Queen
-	case 'r':
-		kind = common.Rook
-	case 'b':
-		kind = common.Bishop
-	case 'n':
-		kind = common.Knight
-	case 'p':
-		kind = common.Pawn
-	default:
+	return Notation{}.DecodePiece(fen, factory)
+}
+
+// DecodePiece ...
+//
+// It decodes a piece from FEN (only a kind and a color, not a position).
+func (notation Notation) DecodePiece(
+	fen rune,
+	factory common.PieceFactory,
+) (common.Piece, error) {
+	kind, ok := notation.Kinds.LookupKindBySymbol(fen)
+	if !ok {
 		return nil, errors.New("unknown kind")
 	}
 
@@ -95,56 +146,111 @@ func DecodePiece(fen rune, factory common.PieceFactory) (common.Piece, error) {
 	}
 
 	piece := factory(kind, color, common.Position{})
+	if piece == nil {
+		return nil, errors.New("unknown kind")
+	}
+
 	return piece, nil
 }
 
 // DecodePieceStorage ...
 //
-// It decodes a piece storage from FEN.
+// It decodes a piece storage from FEN with built-in and registered kinds
+// and without holes (see Notation.DecodePieceStorage()).
 func DecodePieceStorage(
 	fen string,
 	pieceFactory common.PieceFactory,
 	pieceStorageFactory PieceStorageFactory,
+) (common.PieceStorage, error) {
+	return Notation{}.DecodePieceStorage(fen, pieceFactory, pieceStorageFactory)
+}
+
+// DecodePieceStorage ...
+//
+// It decodes a piece storage from FEN.
+//
+// The "*" symbol marks a hole, i.e. a position, which isn't a cell
+// of the board. If there are holes, the created piece storage is wrapped
+// by the geometry wrapper of the notation with a mask of them
+// (see common.Mask); without the wrapper, holes are an error.
+func (notation Notation) DecodePieceStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory PieceStorageFactory,
 ) (common.PieceStorage, error) {
 	ranks := strings.Split(fen, "/")
 	reverse(ranks)
 
 	var pieces []common.Piece
+	var holes []common.Position
 	var width int
 	for index, rank := range ranks {
-		rankPieces, rankWidth, err := decodeRank(index, rank, pieceFactory)
+		rankPieces, rankHoles, rankWidth, err :=
+			notation.decodeRank(index, rank, pieceFactory)
 		if err != nil {
 			return nil, err
 		}
 
 		pieces = append(pieces, rankPieces...)
+		holes = append(holes, rankHoles...)
 		if width < rankWidth {
 			width = rankWidth
 		}
 	}
 
 	size := common.Size{Width: width, Height: len(ranks)}
+	if len(holes) != 0 && notation.GeometryWrapper == nil {
+		return nil, errors.New("holes aren't supported")
+	}
+
 	storage := pieceStorageFactory(size, pieces)
+	if len(holes) != 0 {
+		storage = notation.GeometryWrapper(storage, common.NewMask(holes))
+	}
+
 	return storage, nil
 }
 
-func decodeRank(index int, fen string, pieceFactory common.PieceFactory) (
+func (notation Notation) decodeRank(
+	index int,
+	fen string,
+	pieceFactory common.PieceFactory,
+) (
 	pieces []common.Piece,
+	holes []common.Position,
 	maxFile int,
 	err error,
 ) {
-	for _, symbol := range fen {
-		piece, err := DecodePiece(symbol, pieceFactory)
-		if err != nil {
-			shift, err := strconv.Atoi(string(symbol))
+	symbols := []rune(fen)
+	for symbolIndex := 0; symbolIndex < len(symbols); symbolIndex++ {
+		// a shift can consist of several digits (e.g. on a board 10x8)
+		shiftEnd := symbolIndex
+		for shiftEnd < len(symbols) && isDigit(symbols[shiftEnd]) {
+			shiftEnd++
+		}
+		if shiftEnd != symbolIndex {
+			shift, err := strconv.Atoi(string(symbols[symbolIndex:shiftEnd]))
 			if err != nil {
-				return nil, 0, err
+				return nil, nil, 0, err
 			}
 
 			maxFile += shift
+			symbolIndex = shiftEnd - 1
 			continue
 		}
 
+		if symbols[symbolIndex] == holeSymbol {
+			holes = append(holes, common.Position{File: maxFile, Rank: index})
+
+			maxFile++
+			continue
+		}
+
+		piece, err := notation.DecodePiece(symbols[symbolIndex], pieceFactory)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
 		placedPiece :=
 			piece.ApplyPosition(common.Position{File: maxFile, Rank: index})
 		pieces = append(pieces, placedPiece)
@@ -152,5 +258,23 @@ func decodeRank(index int, fen string, pieceFactory common.PieceFactory) (
 		maxFile++
 	}
 
-	return pieces, maxFile, nil
+	return pieces, holes, maxFile, nil
+}
+
+// it returns a length of a file symbol and following rank digits
+func positionLength(text string) int {
+	if len(text) == 0 {
+		return 0
+	}
+
+	length := 1
+	for length < len(text) && isDigit(rune(text[length])) {
+		length++
+	}
+
+	return length
+}
+
+func isDigit(symbol rune) bool {
+	return symbol >= '0' && symbol <= '9'
 }
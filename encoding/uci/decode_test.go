@@ -34,7 +34,15 @@ func TestDecodePosition(test *testing.T) {
 			wantErr:      true,
 		},
 		{
-			args:         args{"e23"},
+			args: args{"e23"},
+			wantPosition: common.Position{
+				File: 4,
+				Rank: 22,
+			},
+			wantErr: false,
+		},
+		{
+			args:         args{"e+2"},
 			wantPosition: common.Position{},
 			wantErr:      true,
 		},
@@ -87,13 +95,114 @@ func TestDecodeMove(test *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			args: args{"e7e8k"},
+			wantMove: common.Move{
+				Start: common.Position{
+					File: 4,
+					Rank: 6,
+				},
+				Finish: common.Position{
+					File: 4,
+					Rank: 7,
+				},
+				Promotion:   common.King,
+				IsPromotion: true,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"b2b1n"},
+			wantMove: common.Move{
+				Start: common.Position{
+					File: 1,
+					Rank: 1,
+				},
+				Finish: common.Position{
+					File: 1,
+					Rank: 0,
+				},
+				Promotion:   common.Knight,
+				IsPromotion: true,
+			},
+			wantErr: false,
+		},
 		{
 			args:     args{"e2e"},
 			wantMove: common.Move{},
 			wantErr:  true,
 		},
 		{
-			args:     args{"e2e42"},
+			args:     args{"e7e8x"},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{"P@e4"},
+			wantMove: common.Move{
+				Finish: common.Position{
+					File: 4,
+					Rank: 3,
+				},
+				Drop:   common.Pawn,
+				IsDrop: true,
+			},
+			wantErr: false,
+		},
+		{
+			args:     args{"X@e4"},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args:     args{"N@e\n"},
+			wantMove: common.Move{},
+			wantErr:  true,
+		},
+		{
+			args: args{"a9a10"},
+			wantMove: common.Move{
+				Start: common.Position{
+					File: 0,
+					Rank: 8,
+				},
+				Finish: common.Position{
+					File: 0,
+					Rank: 9,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"j9j10q"},
+			wantMove: common.Move{
+				Start: common.Position{
+					File: 9,
+					Rank: 8,
+				},
+				Finish: common.Position{
+					File: 9,
+					Rank: 9,
+				},
+				Promotion:   common.Queen,
+				IsPromotion: true,
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"P@e10"},
+			wantMove: common.Move{
+				Finish: common.Position{
+					File: 4,
+					Rank: 9,
+				},
+				Drop:   common.Pawn,
+				IsDrop: true,
+			},
+			wantErr: false,
+		},
+		{
+			args:     args{"e2e4qq"},
 			wantMove: common.Move{},
 			wantErr:  true,
 		},
@@ -181,7 +290,31 @@ func TestDecodePiece(test *testing.T) {
 			wantErr: false,
 		},
 		{
-			args:      args{'a'},
+			args: args{'A'},
+			wantPiece: pieces.NewArchbishop(
+				common.White,
+				common.Position{},
+			),
+			wantErr: false,
+		},
+		{
+			args: args{'c'},
+			wantPiece: pieces.NewChancellor(
+				common.Black,
+				common.Position{},
+			),
+			wantErr: false,
+		},
+		{
+			args: args{'Z'},
+			wantPiece: pieces.NewAmazon(
+				common.White,
+				common.Position{},
+			),
+			wantErr: false,
+		},
+		{
+			args:      args{'x'},
 			wantPiece: nil,
 			wantErr:   true,
 		},
@@ -306,14 +439,45 @@ func TestDecodePieceStorage(test *testing.T) {
 			),
 			wantErr: false,
 		},
+		{
+			args: args{"*K1*/4/p2*"},
+			wantStorage: boards.NewGeometryBoard(
+				boards.NewMapBoard(
+					common.Size{
+						Width:  4,
+						Height: 3,
+					},
+					[]common.Piece{
+						pieces.NewPawn(common.Black, common.Position{
+							File: 0,
+							Rank: 0,
+						}),
+						pieces.NewKing(common.White, common.Position{
+							File: 1,
+							Rank: 2,
+						}),
+					},
+				),
+				common.NewMask([]common.Position{
+					{File: 3, Rank: 0},
+					{File: 0, Rank: 2},
+					{File: 3, Rank: 2},
+				}),
+			),
+			wantErr: false,
+		},
 		{
 			args:        args{"2K3q/#/pp1R"},
 			wantStorage: nil,
 			wantErr:     true,
 		},
 	} {
-		gotStorage, gotErr :=
-			DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		notation := Notation{GeometryWrapper: boards.NewGeometryBoard}
+		gotStorage, gotErr := notation.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
 
 		if !reflect.DeepEqual(gotStorage, data.wantStorage) {
 			test.Fail()
@@ -326,6 +490,18 @@ func TestDecodePieceStorage(test *testing.T) {
 	}
 }
 
+func TestDecodePieceStorage_withoutGeometryWrapper(test *testing.T) {
+	storage, err :=
+		DecodePieceStorage("*K1*/4/p2*", pieces.NewPiece, boards.NewMapBoard)
+
+	if storage != nil {
+		test.Fail()
+	}
+	if err == nil {
+		test.Fail()
+	}
+}
+
 func TestDecodeRank(test *testing.T) {
 	type args struct {
 		index int
@@ -334,6 +510,7 @@ func TestDecodeRank(test *testing.T) {
 	type data struct {
 		args        args
 		wantPieces  []common.Piece
+		wantHoles   []common.Position
 		wantMaxFile int
 		wantErr     bool
 	}
@@ -430,6 +607,43 @@ func TestDecodeRank(test *testing.T) {
 			wantMaxFile: 11,
 			wantErr:     false,
 		},
+		{
+			args: args{
+				index: 7,
+				fen:   "2K10q",
+			},
+			wantPieces: []common.Piece{
+				pieces.NewKing(common.White, common.Position{
+					File: 2,
+					Rank: 7,
+				}),
+				pieces.NewQueen(common.Black, common.Position{
+					File: 13,
+					Rank: 7,
+				}),
+			},
+			wantMaxFile: 14,
+			wantErr:     false,
+		},
+		{
+			args: args{
+				index: 7,
+				fen:   "*K2**",
+			},
+			wantPieces: []common.Piece{
+				pieces.NewKing(common.White, common.Position{
+					File: 1,
+					Rank: 7,
+				}),
+			},
+			wantHoles: []common.Position{
+				{File: 0, Rank: 7},
+				{File: 4, Rank: 7},
+				{File: 5, Rank: 7},
+			},
+			wantMaxFile: 6,
+			wantErr:     false,
+		},
 		{
 			args: args{
 				index: 7,
@@ -440,13 +654,17 @@ func TestDecodeRank(test *testing.T) {
 			wantErr:     true,
 		},
 	} {
-		gotPieces, gotMaxFile, gotErr :=
-			decodeRank(data.args.index, data.args.fen, pieces.NewPiece)
+		gotPieces, gotHoles, gotMaxFile, gotErr :=
+			Notation{}.decodeRank(data.args.index, data.args.fen, pieces.NewPiece)
 
 		if !reflect.DeepEqual(gotPieces, data.wantPieces) {
 			test.Fail()
 		}
 
+		if !reflect.DeepEqual(gotHoles, data.wantHoles) {
+			test.Fail()
+		}
+
 		if gotMaxFile != data.wantMaxFile {
 			test.Fail()
 		}
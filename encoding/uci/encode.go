@@ -19,17 +19,49 @@ func EncodePosition(position common.Position) string {
 
 // EncodeMove ...
 //
-// It converts the move to pure algebraic coordinate notation.
+// It converts the move to pure algebraic coordinate notation
+// with built-in and registered kinds (see Notation.EncodeMove()).
 func EncodeMove(move common.Move) string {
+	return Notation{}.EncodeMove(move)
+}
+
+// EncodeMove ...
+//
+// It converts the move to pure algebraic coordinate notation.
+//
+// A kind of a promoted piece is added as a lowercase fifth symbol.
+// A drop is converted to an uppercase kind of a dropped piece and its finish
+// separated by the "@" symbol (e.g. "P@e4").
+func (notation Notation) EncodeMove(move common.Move) string {
+	if move.IsDrop {
+		descriptor, _ := notation.Kinds.LookupKind(move.Drop)
+		kind := string(unicode.ToUpper(descriptor.Symbol))
+		return kind + string(dropSeparator) + EncodePosition(move.Finish)
+	}
+
 	start := EncodePosition(move.Start)
 	finish := EncodePosition(move.Finish)
-	return start + finish
+	if !move.IsPromotion {
+		return start + finish
+	}
+
+	descriptor, _ := notation.Kinds.LookupKind(move.Promotion)
+	promotion := string(unicode.ToLower(descriptor.Symbol))
+	return start + finish + promotion
 }
 
 // EncodePiece ...
 //
-// It converts the piece to FEN (only a kind and a color, not a position).
+// It converts the piece to FEN with built-in and registered kinds
+// (see Notation.EncodePiece()).
 func EncodePiece(piece common.Piece) string {
+	return Notation{}.EncodePiece(piece)
+}
+
+// EncodePiece ...
+//
+// It converts the piece to FEN (only a kind and a color, not a position).
+func (notation Notation) EncodePiece(piece common.Piece) string {
 	var kindCase int
 	switch piece.Color() {
 	case common.Black:
@@ -38,30 +70,32 @@ func EncodePiece(piece common.Piece) string {
 		kindCase = unicode.UpperCase
 	}
 
-	var kindInFEN rune
-	switch piece.Kind() {
-	case common.King:
-		kindInFEN = 'k'
-	case common.Queen:
-		kindInFEN = 'q'
-	case common.Rook:
-		kindInFEN = 'r'
-	case common.Bishop:
-		kindInFEN = 'b'
-	case common.Knight:
-		kindInFEN = 'n'
-	case common.Pawn:
-		kindInFEN = 'p'
-	}
-
-	fen := unicode.To(kindCase, kindInFEN)
+	descriptor, _ := notation.Kinds.LookupKind(piece.Kind())
+	fen := unicode.To(kindCase, descriptor.Symbol)
 	return string(fen)
 }
 
 // EncodePieceStorage ...
 //
-// It converts the piece storage to FEN.
+// It converts the piece storage to FEN with built-in and registered kinds
+// (see Notation.EncodePieceStorage()).
 func EncodePieceStorage(storage common.PieceStorage) string {
+	return Notation{}.EncodePieceStorage(storage)
+}
+
+// EncodePieceStorage ...
+//
+// It converts the piece storage to FEN.
+//
+// If the board geometry is a mask (see common.Mask), its holes are converted
+// to the "*" symbol (see Notation.DecodePieceStorage()). Positions, which
+// aren't cells by other geometries (e.g. see common.Hexagonal), are converted
+// as empty ones.
+func (notation Notation) EncodePieceStorage(
+	storage common.PieceStorage,
+) string {
+	mask, hasMask := common.StorageGeometry(storage).(common.Mask)
+
 	var rank string
 	var shift int
 	resetShift := func() {
@@ -74,10 +108,14 @@ func EncodePieceStorage(storage common.PieceStorage) string {
 	ranks := make([]string, 0, storage.Size().Height)
 	storage.Size().
 		IteratePositions(func(position common.Position) error { // nolint: errcheck
-			if piece, ok := storage.Piece(position); ok {
+			if hasMask && !mask.HasPosition(storage.Size(), position) {
+				resetShift()
+
+				rank += string(holeSymbol)
+			} else if piece, ok := storage.Piece(position); ok {
 				resetShift()
 
-				rank += EncodePiece(piece)
+				rank += notation.EncodePiece(piece)
 			} else {
 				shift++
 			}
@@ -3,6 +3,7 @@ package uci
 import (
 	"testing"
 
+	"github.com/thewizardplusplus/go-chess-models/boards"
 	"github.com/thewizardplusplus/go-chess-models/common"
 	"github.com/thewizardplusplus/go-chess-models/pieces"
 )
@@ -66,6 +67,18 @@ func (storage MockPieceStorage) ApplyMove(
 	panic("not implemented")
 }
 
+func (storage MockPieceStorage) SetPiece(
+	piece common.Piece,
+) common.PieceStorage {
+	panic("not implemented")
+}
+
+func (storage MockPieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	panic("not implemented")
+}
+
 func (storage MockPieceStorage) CheckMove(move common.Move) error {
 	panic("not implemented")
 }
@@ -147,6 +160,53 @@ func TestEncodeMove(test *testing.T) {
 			},
 			want: "f7f5",
 		},
+		{
+			args: args{
+				move: common.Move{
+					Start: common.Position{
+						File: 4,
+						Rank: 6,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 7,
+					},
+					Promotion:   common.Queen,
+					IsPromotion: true,
+				},
+			},
+			want: "e7e8q",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start: common.Position{
+						File: 4,
+						Rank: 6,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 7,
+					},
+					Promotion:   common.King,
+					IsPromotion: true,
+				},
+			},
+			want: "e7e8k",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Finish: common.Position{
+						File: 4,
+						Rank: 3,
+					},
+					Drop:   common.Knight,
+					IsDrop: true,
+				},
+			},
+			want: "N@e4",
+		},
 	} {
 		got := EncodeMove(data.args.move)
 
@@ -220,6 +280,33 @@ func TestEncodePiece(test *testing.T) {
 			},
 			want: "p",
 		},
+		{
+			args: args{
+				piece: MockPiece{
+					kind:  common.Archbishop,
+					color: common.White,
+				},
+			},
+			want: "A",
+		},
+		{
+			args: args{
+				piece: MockPiece{
+					kind:  common.Chancellor,
+					color: common.Black,
+				},
+			},
+			want: "c",
+		},
+		{
+			args: args{
+				piece: MockPiece{
+					kind:  common.Amazon,
+					color: common.White,
+				},
+			},
+			want: "Z",
+		},
 	} {
 		got := EncodePiece(data.args.piece)
 
@@ -442,6 +529,57 @@ func TestEncodePieceStorage(test *testing.T) {
 			},
 			want: "K4/1qQ2/1r2R",
 		},
+		{
+			args: args{
+				storage: boards.NewGeometryBoard(
+					boards.NewMapBoard(
+						common.Size{
+							Width:  4,
+							Height: 3,
+						},
+						[]common.Piece{
+							pieces.NewPawn(common.Black, common.Position{
+								File: 0,
+								Rank: 0,
+							}),
+							pieces.NewKing(common.White, common.Position{
+								File: 1,
+								Rank: 2,
+							}),
+						},
+					),
+					common.NewMask([]common.Position{
+						{File: 3, Rank: 0},
+						{File: 0, Rank: 2},
+						{File: 3, Rank: 2},
+					}),
+				),
+			},
+			want: "*K1*/4/p2*",
+		},
+		{
+			args: args{
+				storage: boards.NewGeometryBoard(
+					boards.NewMapBoard(
+						common.Size{
+							Width:  4,
+							Height: 3,
+						},
+						[]common.Piece{
+							pieces.NewKing(common.White, common.Position{
+								File: 1,
+								Rank: 1,
+							}),
+						},
+					),
+					common.Box{
+						Min: common.Position{File: 1, Rank: 0},
+						Max: common.Position{File: 2, Rank: 2},
+					},
+				),
+			},
+			want: "4/1K2/4",
+		},
 	} {
 		got := EncodePieceStorage(data.args.storage)
 
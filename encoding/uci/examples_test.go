@@ -33,7 +33,22 @@ func ExampleDecodeMove() {
 	move, _ := uci.DecodeMove("d4c3")
 	fmt.Printf("%+v\n", move)
 
-	// Output: {Start:{File:3 Rank:3} Finish:{File:2 Rank:2}}
+	// Output: {Start:{File:3 Rank:3} Finish:{File:2 Rank:2} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}
+}
+
+func ExampleNotation() {
+	notation := uci.Notation{Kinds: common.KindTable{
+		common.KindCount: {Name: "silver", Symbol: 's', Value: 400},
+	}}
+	move, _ := notation.DecodeMove("a9a10s")
+	fmt.Println(notation.EncodeMove(move))
+
+	_, err := uci.DecodeMove("a9a10s")
+	fmt.Println(err)
+
+	// Output:
+	// a9a10s
+	// incorrect promotion
 }
 
 func ExampleEncodeMove() {
@@ -0,0 +1,28 @@
+package uci
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// GeometryWrapper ...
+//
+// It provides the piece storage with the geometry
+// (e.g. boards.NewGeometryBoard()).
+type GeometryWrapper func(
+	storage common.PieceStorage,
+	geometry common.Geometry,
+) common.PieceStorage
+
+// Notation ...
+//
+// It codes moves and FEN with symbols of kinds from the kind table
+// (see common.KindTable), e.g. of a variant. The zero value uses only
+// built-in and registered kinds and doesn't support holes in FEN,
+// as the package functions do.
+type Notation struct {
+	Kinds common.KindTable
+
+	// it's used for a board with holes (see Notation.DecodePieceStorage());
+	// nil means that holes aren't supported
+	GeometryWrapper GeometryWrapper
+}
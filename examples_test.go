@@ -36,9 +36,24 @@ func ExampleMoveGenerator_MovesForColor() {
 	}
 
 	// Output:
-	// {Start:{File:3 Rank:3} Finish:{File:1 Rank:2}}
-	// {Start:{File:3 Rank:3} Finish:{File:1 Rank:4}}
-	// {Start:{File:3 Rank:3} Finish:{File:2 Rank:1}}
-	// {Start:{File:3 Rank:3} Finish:{File:4 Rank:1}}
-	// {Start:{File:4 Rank:3} Finish:{File:4 Rank:4}}
+	// {Start:{File:3 Rank:3} Finish:{File:1 Rank:2} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}
+	// {Start:{File:3 Rank:3} Finish:{File:1 Rank:4} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}
+	// {Start:{File:3 Rank:3} Finish:{File:2 Rank:1} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}
+	// {Start:{File:3 Rank:3} Finish:{File:4 Rank:1} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}
+	// {Start:{File:4 Rank:3} Finish:{File:4 Rank:4} Promotion:0 IsPromotion:false Drop:0 IsDrop:false}
+}
+
+func ExampleMoveGenerator_Result() {
+	board := boards.NewMapBoard(common.Size{Width: 5, Height: 5}, []common.Piece{
+		pieces.NewKing(common.White, common.Position{File: 0, Rank: 0}),
+		pieces.NewRook(common.Black, common.Position{File: 0, Rank: 4}),
+		pieces.NewRook(common.Black, common.Position{File: 1, Rank: 4}),
+		pieces.NewKing(common.Black, common.Position{File: 4, Rank: 4}),
+	})
+
+	var generator models.MoveGenerator
+	result, _ := generator.Result(board, common.White)
+	fmt.Println(result == models.BlackWin)
+
+	// Output: true
 }
@@ -1,5 +1,3 @@
 module github.com/thewizardplusplus/go-chess-models
 
 go 1.20
-
-require github.com/thewizardplusplus/go-chess-cli v0.0.0-20200221200000-b8bd1ec7cd86
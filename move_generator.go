@@ -5,7 +5,11 @@ import (
 )
 
 // MoveGenerator ...
-type MoveGenerator struct{}
+//
+// It uses the orthodox rules if the Rules field is nil.
+type MoveGenerator struct {
+	Rules Rules
+}
 
 // MovesForColor ...
 //
@@ -13,11 +17,20 @@ type MoveGenerator struct{}
 //
 // It doesn't take into account possible checks and can generate such moves.
 //
+// It returns no moves if the game is over by the rules. Otherwise, it adds
+// drops if the rules implement the DropGenerator interface and filters moves
+// if the rules implement the MoveFilter interface.
+//
 // It returns an error only on a king capture.
 func (generator MoveGenerator) MovesForColor(
 	storage common.PieceStorage,
 	color common.Color,
 ) ([]common.Move, error) {
+	rules := generator.rules()
+	if rules.Termination(storage, color).IsFinished() {
+		return nil, nil
+	}
+
 	var moves []common.Move
 	for _, piece := range storage.Pieces() {
 		if piece.Color() != color {
@@ -33,6 +46,20 @@ func (generator MoveGenerator) MovesForColor(
 		moves = append(moves, positionMoves...)
 	}
 
+	if dropGenerator, ok := rules.(DropGenerator); ok {
+		for _, move := range dropGenerator.Drops(storage, color) {
+			if err := rules.CheckMove(storage, move); err != nil {
+				continue
+			}
+
+			moves = append(moves, move)
+		}
+	}
+
+	if filter, ok := rules.(MoveFilter); ok {
+		moves = filter.FilterMoves(storage, color, moves)
+	}
+
 	return moves, nil
 }
 
@@ -40,15 +67,23 @@ func (generator MoveGenerator) MovesForColor(
 //
 // It doesn't take into account possible checks and can generate such moves.
 //
+// If the rules implement the MoveExpander interface, it checks variants
+// of each move instead of the move itself.
+//
+// On a sparse piece storage, it checks only moves to positions reachable
+// by the piece (see the common.IterateFinishes() function).
+//
 // It returns an error only on a king capture.
 func (generator MoveGenerator) MovesForPosition(
 	storage common.PieceStorage,
 	position common.Position,
 ) ([]common.Move, error) {
+	rules := generator.rules()
+	expander, hasExpander := rules.(MoveExpander)
+
 	var moves []common.Move
-	if err := storage.Size().IteratePositions(func(finish common.Position) error {
-		move := common.Move{position, finish}
-		if err := storage.CheckMove(move); err != nil {
+	addMove := func(move common.Move) error {
+		if err := rules.CheckMove(storage, move); err != nil {
 			// if the move captures a king, break a generating
 			if err == common.ErrKingCapture {
 				return err
@@ -59,6 +94,27 @@ func (generator MoveGenerator) MovesForPosition(
 		}
 
 		moves = append(moves, move)
+		return nil
+	}
+	if err := common.IterateFinishes(storage, position, func(
+		finish common.Position,
+	) error {
+		move := common.Move{Start: position, Finish: finish}
+		if !hasExpander {
+			return addMove(move)
+		}
+
+		expandedMoves := expander.ExpandMove(storage, move)
+		if expandedMoves == nil {
+			return addMove(move)
+		}
+
+		for _, expandedMove := range expandedMoves {
+			if err := addMove(expandedMove); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -67,6 +123,113 @@ func (generator MoveGenerator) MovesForPosition(
 	return moves, nil
 }
 
+// LegalMovesForColor ...
+//
+// It doesn't guarantee an order of returned moves.
+//
+// Unlike MovesForColor(), it skips moves that leave a royal piece of the color
+// under attack.
+//
+// It returns an error only on a king capture.
+func (generator MoveGenerator) LegalMovesForColor(
+	storage common.PieceStorage,
+	color common.Color,
+) ([]common.Move, error) {
+	moves, err := generator.MovesForColor(storage, color)
+	if err != nil {
+		return nil, err
+	}
+
+	var legalMoves []common.Move
+	for _, move := range moves {
+		nextStorage := generator.ApplyMove(storage, move)
+		if generator.IsCheck(nextStorage, color) {
+			continue
+		}
+
+		legalMoves = append(legalMoves, move)
+	}
+
+	return legalMoves, nil
+}
+
+// ApplyMove ...
+//
+// It applies the move along with all its side effects by the rules.
+func (generator MoveGenerator) ApplyMove(
+	storage common.PieceStorage,
+	move common.Move,
+) common.PieceStorage {
+	return generator.rules().ApplyMove(storage, move)
+}
+
+// Result ...
+//
+// It returns a result of the game before a move of the color.
+//
+// It returns an error only on a king capture.
+func (generator MoveGenerator) Result(
+	storage common.PieceStorage,
+	color common.Color,
+) (Result, error) {
+	rules := generator.rules()
+	if result := rules.Termination(storage, color); result.IsFinished() {
+		return result, nil
+	}
+
+	moves, err := generator.LegalMovesForColor(storage, color)
+	if err != nil {
+		return Unfinished, err
+	}
+	if len(moves) != 0 {
+		return Unfinished, nil
+	}
+
+	isCheck := generator.IsCheck(storage, color)
+	return rules.NoMovesResult(color, isCheck), nil
+}
+
+// NextColor ...
+//
+// It returns a color that moves after the color. It uses the rules
+// if they implement the TurnOrder interface and common.Color.Negative()
+// otherwise.
+func (generator MoveGenerator) NextColor(
+	storage common.PieceStorage,
+	color common.Color,
+) common.Color {
+	if turnOrder, ok := generator.rules().(TurnOrder); ok {
+		return turnOrder.NextColor(storage, color)
+	}
+
+	return color.Negative()
+}
+
+// IsCheck ...
+//
+// It checks that a royal piece of the color is under attack. It uses
+// the rules if they implement the TurnOrder interface; otherwise, it checks
+// that moves of the opponent report a king capture.
+func (generator MoveGenerator) IsCheck(
+	storage common.PieceStorage,
+	color common.Color,
+) bool {
+	if turnOrder, ok := generator.rules().(TurnOrder); ok {
+		return turnOrder.IsCheck(storage, color)
+	}
+
+	_, err := generator.MovesForColor(storage, color.Negative())
+	return err == common.ErrKingCapture
+}
+
+func (generator MoveGenerator) rules() Rules {
+	if generator.Rules == nil {
+		return OrthodoxRules{}
+	}
+
+	return generator.Rules
+}
+
 // PerftMoveGenerator ...
 type PerftMoveGenerator interface {
 	MovesForColor(storage common.PieceStorage, color common.Color) (
@@ -75,6 +238,23 @@ type PerftMoveGenerator interface {
 	)
 }
 
+// PerftMoveApplier ...
+//
+// It's an optional interface of a PerftMoveGenerator. Without it, moves are
+// applied by a piece storage.
+type PerftMoveApplier interface {
+	ApplyMove(storage common.PieceStorage, move common.Move) common.PieceStorage
+}
+
+// PerftColorRotator ...
+//
+// It's an optional interface of a PerftMoveGenerator. It's used for games
+// of more than two players. Without it, colors alternate
+// by common.Color.Negative().
+type PerftColorRotator interface {
+	NextColor(storage common.PieceStorage, color common.Color) common.Color
+}
+
 // PerftHandler ...
 type PerftHandler func(move common.Move, count int, deep int)
 
@@ -99,8 +279,8 @@ func Perft(
 
 	var totalMoveCount int
 	for _, move := range moves {
-		nextStorage := storage.ApplyMove(move)
-		nextColor := color.Negative()
+		nextStorage := applyMove(generator, storage, move)
+		nextColor := nextColor(generator, nextStorage, color)
 		moveCount := Perft(
 			generator,
 			nextStorage,
@@ -117,3 +297,27 @@ func Perft(
 
 	return totalMoveCount
 }
+
+func applyMove(
+	generator PerftMoveGenerator,
+	storage common.PieceStorage,
+	move common.Move,
+) common.PieceStorage {
+	if applier, ok := generator.(PerftMoveApplier); ok {
+		return applier.ApplyMove(storage, move)
+	}
+
+	return storage.ApplyMove(move)
+}
+
+func nextColor(
+	generator PerftMoveGenerator,
+	storage common.PieceStorage,
+	color common.Color,
+) common.Color {
+	if rotator, ok := generator.(PerftColorRotator); ok {
+		return rotator.NextColor(storage, color)
+	}
+
+	return color.Negative()
+}
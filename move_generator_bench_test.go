@@ -40,6 +40,10 @@ func BenchmarkPerft(benchmark *testing.B) {
 			name:    "SliceBoard",
 			factory: boards.NewSliceBoard,
 		},
+		{
+			name:    "SparseBoard",
+			factory: boards.NewSparseBoard,
+		},
 		{
 			name: "BitBoard",
 			factory: func(
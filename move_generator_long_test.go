@@ -49,6 +49,10 @@ func TestPerft(test *testing.T) {
 			name:    "SliceBoard",
 			factory: boards.NewSliceBoard,
 		},
+		{
+			name:    "SparseBoard",
+			factory: boards.NewSparseBoard,
+		},
 		{
 			name: "BitBoard",
 			factory: func(
@@ -3,9 +3,13 @@ package chessmodels
 import (
 	"errors"
 	"reflect"
+	"sort"
 	"testing"
 
+	"github.com/thewizardplusplus/go-chess-models/boards"
 	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
 )
 
 type MockPiece struct {
@@ -51,7 +55,8 @@ func (piece MockPiece) CheckMove(
 type MockBasePieceStorage struct {
 	size common.Size
 
-	piece func(position common.Position) (piece common.Piece, ok bool)
+	piece     func(position common.Position) (piece common.Piece, ok bool)
+	applyMove func(move common.Move) common.PieceStorage
 }
 
 func (storage MockBasePieceStorage) Size() common.Size {
@@ -71,6 +76,22 @@ func (storage MockBasePieceStorage) Piece(position common.Position) (
 
 func (storage MockBasePieceStorage) ApplyMove(
 	move common.Move,
+) common.PieceStorage {
+	if storage.applyMove == nil {
+		panic("not implemented")
+	}
+
+	return storage.applyMove(move)
+}
+
+func (storage MockBasePieceStorage) SetPiece(
+	piece common.Piece,
+) common.PieceStorage {
+	panic("not implemented")
+}
+
+func (storage MockBasePieceStorage) RemovePiece(
+	position common.Position,
 ) common.PieceStorage {
 	panic("not implemented")
 }
@@ -454,3 +475,1090 @@ func TestMoveCheckerMovesForPosition(test *testing.T) {
 		}
 	}
 }
+
+type MockRules struct {
+	checkMove func(storage common.PieceStorage, move common.Move) error
+	applyMove func(
+		storage common.PieceStorage,
+		move common.Move,
+	) common.PieceStorage
+	termination   func(storage common.PieceStorage, color common.Color) Result
+	noMovesResult func(color common.Color, isCheck bool) Result
+}
+
+func (rules MockRules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	if rules.checkMove == nil {
+		panic("not implemented")
+	}
+
+	return rules.checkMove(storage, move)
+}
+
+func (rules MockRules) ApplyMove(
+	storage common.PieceStorage,
+	move common.Move,
+) common.PieceStorage {
+	if rules.applyMove == nil {
+		panic("not implemented")
+	}
+
+	return rules.applyMove(storage, move)
+}
+
+func (rules MockRules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) Result {
+	if rules.termination == nil {
+		panic("not implemented")
+	}
+
+	return rules.termination(storage, color)
+}
+
+func (rules MockRules) NoMovesResult(
+	color common.Color,
+	isCheck bool,
+) Result {
+	if rules.noMovesResult == nil {
+		panic("not implemented")
+	}
+
+	return rules.noMovesResult(color, isCheck)
+}
+
+func TestMoveGeneratorMovesForColor_withRules(test *testing.T) {
+	type fields struct {
+		termination func(storage common.PieceStorage, color common.Color) Result
+	}
+	type data struct {
+		fields    fields
+		wantMoves []common.Move
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				termination: func(
+					storage common.PieceStorage,
+					color common.Color,
+				) Result {
+					return Unfinished
+				},
+			},
+			wantMoves: []common.Move{
+				{
+					Start:  common.Position{File: 0, Rank: 0},
+					Finish: common.Position{File: 1, Rank: 1},
+				},
+			},
+		},
+		{
+			fields: fields{
+				termination: func(
+					storage common.PieceStorage,
+					color common.Color,
+				) Result {
+					return WhiteWin
+				},
+			},
+			wantMoves: nil,
+		},
+	} {
+		storage := MockPieceStorage{
+			MockBasePieceStorage: MockBasePieceStorage{
+				size: common.Size{Width: 2, Height: 2},
+			},
+			MockPieceGroupGetter: MockPieceGroupGetter{
+				pieces: []common.Piece{
+					MockPiece{
+						color:    common.White,
+						position: common.Position{File: 0, Rank: 0},
+					},
+				},
+			},
+		}
+		generator := MoveGenerator{
+			Rules: MockRules{
+				checkMove: func(
+					storage common.PieceStorage,
+					move common.Move,
+				) error {
+					if move.Finish != (common.Position{File: 1, Rank: 1}) {
+						return common.ErrIllegalMove
+					}
+
+					return nil
+				},
+				termination: data.fields.termination,
+			},
+		}
+		gotMoves, gotErr := generator.MovesForColor(storage, common.White)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveGeneratorLegalMovesForColor(test *testing.T) {
+	type args struct {
+		storage common.PieceStorage
+		color   common.Color
+	}
+	type data struct {
+		args      args
+		wantMoves []common.Move
+		wantErr   error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage: boards.NewMapBoard(
+					common.Size{Width: 3, Height: 3},
+					[]common.Piece{
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 1, Rank: 2},
+						),
+					},
+				),
+				color: common.White,
+			},
+			wantMoves: []common.Move{
+				{
+					Start:  common.Position{File: 0, Rank: 0},
+					Finish: common.Position{File: 0, Rank: 1},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				storage: boards.NewMapBoard(
+					common.Size{Width: 3, Height: 3},
+					[]common.Piece{
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 1, Rank: 2},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 0, Rank: 2},
+						),
+					},
+				),
+				color: common.White,
+			},
+			wantMoves: nil,
+			wantErr:   nil,
+		},
+		{
+			args: args{
+				storage: boards.NewMapBoard(
+					common.Size{Width: 3, Height: 3},
+					[]common.Piece{
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 0, Rank: 2},
+						),
+					},
+				),
+				color: common.Black,
+			},
+			wantMoves: nil,
+			wantErr:   common.ErrKingCapture,
+		},
+	} {
+		var generator MoveGenerator
+		gotMoves, gotErr :=
+			generator.LegalMovesForColor(data.args.storage, data.args.color)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveGeneratorApplyMove(test *testing.T) {
+	var gotStorage common.PieceStorage
+	var gotMove common.Move
+	generator := MoveGenerator{
+		Rules: MockRules{
+			applyMove: func(
+				storage common.PieceStorage,
+				move common.Move,
+			) common.PieceStorage {
+				gotStorage, gotMove = storage, move
+				return MockPieceStorage{
+					MockBasePieceStorage: MockBasePieceStorage{
+						size: common.Size{Width: 3, Height: 3},
+					},
+				}
+			},
+		},
+	}
+	storage := MockPieceStorage{
+		MockBasePieceStorage: MockBasePieceStorage{
+			size: common.Size{Width: 2, Height: 2},
+		},
+	}
+	move := common.Move{
+		Start:  common.Position{File: 0, Rank: 0},
+		Finish: common.Position{File: 1, Rank: 1},
+	}
+	got := generator.ApplyMove(storage, move)
+
+	want := MockPieceStorage{
+		MockBasePieceStorage: MockBasePieceStorage{
+			size: common.Size{Width: 3, Height: 3},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(gotStorage, storage) {
+		test.Fail()
+	}
+	if gotMove != move {
+		test.Fail()
+	}
+}
+
+func TestMoveGeneratorResult(test *testing.T) {
+	type fields struct {
+		rules Rules
+	}
+	type args struct {
+		storage common.PieceStorage
+		color   common.Color
+	}
+	type data struct {
+		fields     fields
+		args       args
+		wantResult Result
+		wantErr    error
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{
+				rules: nil,
+			},
+			args: args{
+				storage: boards.NewMapBoard(
+					common.Size{Width: 3, Height: 3},
+					[]common.Piece{
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 1, Rank: 2},
+						),
+					},
+				),
+				color: common.White,
+			},
+			wantResult: Unfinished,
+			wantErr:    nil,
+		},
+		{
+			fields: fields{
+				rules: nil,
+			},
+			args: args{
+				storage: boards.NewMapBoard(
+					common.Size{Width: 3, Height: 3},
+					[]common.Piece{
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 1, Rank: 2},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 0, Rank: 2},
+						),
+					},
+				),
+				color: common.White,
+			},
+			wantResult: BlackWin,
+			wantErr:    nil,
+		},
+		{
+			fields: fields{
+				rules: nil,
+			},
+			args: args{
+				storage: boards.NewMapBoard(
+					common.Size{Width: 3, Height: 3},
+					[]common.Piece{
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 1, Rank: 2},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 2, Rank: 1},
+						),
+					},
+				),
+				color: common.White,
+			},
+			wantResult: Draw,
+			wantErr:    nil,
+		},
+		{
+			fields: fields{
+				rules: nil,
+			},
+			args: args{
+				storage: boards.NewMapBoard(
+					common.Size{Width: 3, Height: 3},
+					[]common.Piece{
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 0, Rank: 2},
+						),
+					},
+				),
+				color: common.Black,
+			},
+			wantResult: Unfinished,
+			wantErr:    common.ErrKingCapture,
+		},
+		{
+			fields: fields{
+				rules: MockRules{
+					termination: func(
+						storage common.PieceStorage,
+						color common.Color,
+					) Result {
+						return WhiteWin
+					},
+				},
+			},
+			args: args{
+				storage: boards.NewMapBoard(
+					common.Size{Width: 3, Height: 3},
+					[]common.Piece{
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewRook(
+							common.Black,
+							common.Position{File: 1, Rank: 2},
+						),
+					},
+				),
+				color: common.Black,
+			},
+			wantResult: WhiteWin,
+			wantErr:    nil,
+		},
+	} {
+		generator := MoveGenerator{Rules: data.fields.rules}
+		gotResult, gotErr := generator.Result(data.args.storage, data.args.color)
+
+		if gotResult != data.wantResult {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestPerft_withMoveApplier(test *testing.T) {
+	storage := boards.NewMapBoard(
+		common.Size{Width: 3, Height: 3},
+		[]common.Piece{
+			pieces.NewKing(common.White, common.Position{File: 0, Rank: 0}),
+			pieces.NewKing(common.Black, common.Position{File: 2, Rank: 2}),
+		},
+	)
+
+	var appliedMoveCount int
+	generator := MoveGenerator{
+		Rules: MockRules{
+			checkMove: OrthodoxRules{}.CheckMove,
+			applyMove: func(
+				storage common.PieceStorage,
+				move common.Move,
+			) common.PieceStorage {
+				appliedMoveCount++
+				return storage.ApplyMove(move)
+			},
+			termination: OrthodoxRules{}.Termination,
+		},
+	}
+	got := Perft(generator, storage, common.White, 2, nil)
+
+	// the white king has 3 moves, but after Kb2 it can be captured;
+	// after Kb1 and Ka2, the black king has 3 moves, but only one of them
+	// doesn't come adjacent to the white king
+	if got != 2 {
+		test.Fail()
+	}
+	if appliedMoveCount != 3+3+3 {
+		test.Fail()
+	}
+}
+
+type MockExtendedRules struct {
+	MockRules
+
+	expandMove  func(storage common.PieceStorage, move common.Move) []common.Move
+	filterMoves func(
+		storage common.PieceStorage,
+		color common.Color,
+		moves []common.Move,
+	) []common.Move
+}
+
+func (rules MockExtendedRules) ExpandMove(
+	storage common.PieceStorage,
+	move common.Move,
+) []common.Move {
+	if rules.expandMove == nil {
+		panic("not implemented")
+	}
+
+	return rules.expandMove(storage, move)
+}
+
+func (rules MockExtendedRules) FilterMoves(
+	storage common.PieceStorage,
+	color common.Color,
+	moves []common.Move,
+) []common.Move {
+	if rules.filterMoves == nil {
+		panic("not implemented")
+	}
+
+	return rules.filterMoves(storage, color, moves)
+}
+
+func TestMoveGeneratorMovesForColor_withExtendedRules(test *testing.T) {
+	storage := MockPieceStorage{
+		MockBasePieceStorage: MockBasePieceStorage{
+			size: common.Size{Width: 2, Height: 2},
+		},
+		MockPieceGroupGetter: MockPieceGroupGetter{
+			pieces: []common.Piece{
+				MockPiece{
+					color:    common.White,
+					position: common.Position{File: 0, Rank: 0},
+				},
+			},
+		},
+	}
+	generator := MoveGenerator{
+		Rules: MockExtendedRules{
+			MockRules: MockRules{
+				checkMove: func(
+					storage common.PieceStorage,
+					move common.Move,
+				) error {
+					if move.Finish.Rank != 1 || move.Promotion == common.Rook {
+						return common.ErrIllegalMove
+					}
+
+					return nil
+				},
+				termination: func(
+					storage common.PieceStorage,
+					color common.Color,
+				) Result {
+					return Unfinished
+				},
+			},
+			expandMove: func(
+				storage common.PieceStorage,
+				move common.Move,
+			) []common.Move {
+				if move.Finish.File != 0 {
+					return nil
+				}
+
+				var moves []common.Move
+				for _, kind := range []common.Kind{common.Queen, common.Rook} {
+					move.Promotion, move.IsPromotion = kind, true
+					moves = append(moves, move)
+				}
+
+				return moves
+			},
+			filterMoves: func(
+				storage common.PieceStorage,
+				color common.Color,
+				moves []common.Move,
+			) []common.Move {
+				return append(moves, common.Move{})
+			},
+		},
+	}
+	gotMoves, gotErr := generator.MovesForColor(storage, common.White)
+
+	wantMoves := []common.Move{
+		{
+			Start:       common.Position{File: 0, Rank: 0},
+			Finish:      common.Position{File: 0, Rank: 1},
+			Promotion:   common.Queen,
+			IsPromotion: true,
+		},
+		{
+			Start:  common.Position{File: 0, Rank: 0},
+			Finish: common.Position{File: 1, Rank: 1},
+		},
+		{},
+	}
+	if !reflect.DeepEqual(gotMoves, wantMoves) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
+
+type MockDropRules struct {
+	MockRules
+
+	drops func(storage common.PieceStorage, color common.Color) []common.Move
+}
+
+func (rules MockDropRules) Drops(
+	storage common.PieceStorage,
+	color common.Color,
+) []common.Move {
+	if rules.drops == nil {
+		panic("not implemented")
+	}
+
+	return rules.drops(storage, color)
+}
+
+func TestMoveGeneratorMovesForColor_withDrops(test *testing.T) {
+	storage := MockPieceStorage{
+		MockBasePieceStorage: MockBasePieceStorage{
+			size: common.Size{Width: 2, Height: 2},
+		},
+		MockPieceGroupGetter: MockPieceGroupGetter{
+			pieces: []common.Piece{
+				MockPiece{
+					color:    common.White,
+					position: common.Position{File: 0, Rank: 0},
+				},
+			},
+		},
+	}
+	generator := MoveGenerator{
+		Rules: MockDropRules{
+			MockRules: MockRules{
+				checkMove: func(
+					storage common.PieceStorage,
+					move common.Move,
+				) error {
+					if move.Finish != (common.Position{File: 1, Rank: 1}) {
+						return common.ErrIllegalMove
+					}
+
+					return nil
+				},
+				termination: func(
+					storage common.PieceStorage,
+					color common.Color,
+				) Result {
+					return Unfinished
+				},
+			},
+			drops: func(
+				storage common.PieceStorage,
+				color common.Color,
+			) []common.Move {
+				var moves []common.Move
+				for _, finish := range storage.Size().Positions() {
+					moves = append(moves, common.Move{
+						Finish: finish,
+						Drop:   common.Knight,
+						IsDrop: true,
+					})
+				}
+
+				return moves
+			},
+		},
+	}
+	gotMoves, gotErr := generator.MovesForColor(storage, common.White)
+
+	wantMoves := []common.Move{
+		{
+			Start:  common.Position{File: 0, Rank: 0},
+			Finish: common.Position{File: 1, Rank: 1},
+		},
+		{
+			Finish: common.Position{File: 1, Rank: 1},
+			Drop:   common.Knight,
+			IsDrop: true,
+		},
+	}
+	if !reflect.DeepEqual(gotMoves, wantMoves) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
+
+func TestMoveGeneratorMovesForColor_withHoles(test *testing.T) {
+	for _, pieceStorageFactory := range []uci.PieceStorageFactory{
+		boards.NewMapBoard,
+		boards.NewSliceBoard,
+		func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+			return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+		},
+	} {
+		notation := uci.Notation{GeometryWrapper: boards.NewGeometryBoard}
+		storage, err := notation.DecodePieceStorage(
+			"**1**/5/1*R2/5/**N**",
+			pieces.NewPiece,
+			pieceStorageFactory,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var generator MoveGenerator
+		gotMoves, gotErr := generator.MovesForColor(storage, common.White)
+
+		var gotFinishes []string
+		for _, move := range gotMoves {
+			gotFinishes = append(gotFinishes, uci.EncodeMove(move))
+		}
+
+		// neither the knight nor the rook can go to the hole b3,
+		// and the rook also can't pass it
+		wantFinishes := []string{
+			"c1a2",
+			"c1e2",
+			"c1d3",
+			"c3c2",
+			"c3d3",
+			"c3e3",
+			"c3c4",
+			"c3c5",
+		}
+		if !reflect.DeepEqual(gotFinishes, wantFinishes) {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveGeneratorMovesForColor_withTopology(test *testing.T) {
+	for _, pieceStorageFactory := range []uci.PieceStorageFactory{
+		boards.NewMapBoard,
+		boards.NewSliceBoard,
+		func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+			return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			"5/5/Rp3/5/4N",
+			pieces.NewPiece,
+			pieceStorageFactory,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+		storage = boards.NewGeometryBoard(storage, common.Cylinder{})
+
+		var generator MoveGenerator
+		gotMoves, gotErr := generator.MovesForColor(storage, common.White)
+
+		var gotFinishes []string
+		for _, move := range gotMoves {
+			gotFinishes = append(gotFinishes, uci.EncodeMove(move))
+		}
+
+		// the rook reaches the files c, d and e across the left edge,
+		// and the knight reaches the file b across the right edge
+		wantFinishes := []string{
+			"e1b2",
+			"e1c2",
+			"e1d3",
+			"a3a1",
+			"a3a2",
+			"a3b3",
+			"a3c3",
+			"a3d3",
+			"a3e3",
+			"a3a4",
+			"a3a5",
+		}
+		if !reflect.DeepEqual(gotFinishes, wantFinishes) {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+	}
+}
+
+type MockTurnOrderRules struct {
+	MockRules
+
+	nextColor func(storage common.PieceStorage, color common.Color) common.Color
+	isCheck   func(storage common.PieceStorage, color common.Color) bool
+}
+
+func (rules MockTurnOrderRules) NextColor(
+	storage common.PieceStorage,
+	color common.Color,
+) common.Color {
+	if rules.nextColor == nil {
+		panic("not implemented")
+	}
+
+	return rules.nextColor(storage, color)
+}
+
+func (rules MockTurnOrderRules) IsCheck(
+	storage common.PieceStorage,
+	color common.Color,
+) bool {
+	if rules.isCheck == nil {
+		panic("not implemented")
+	}
+
+	return rules.isCheck(storage, color)
+}
+
+func TestMoveGeneratorNextColor(test *testing.T) {
+	type fields struct {
+		rules Rules
+	}
+	type args struct {
+		color common.Color
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   common.Color
+	}
+
+	storage := boards.NewMapBoard(common.Size{Width: 3, Height: 3}, nil)
+	for _, data := range []data{
+		{
+			fields: fields{rules: nil},
+			args:   args{common.White},
+			want:   common.Black,
+		},
+		{
+			fields: fields{
+				rules: MockTurnOrderRules{
+					nextColor: func(
+						gotStorage common.PieceStorage,
+						color common.Color,
+					) common.Color {
+						if !reflect.DeepEqual(gotStorage, storage) {
+							test.Fail()
+						}
+						if color != common.Red {
+							test.Fail()
+						}
+
+						return common.Green
+					},
+				},
+			},
+			args: args{common.Red},
+			want: common.Green,
+		},
+	} {
+		generator := MoveGenerator{Rules: data.fields.rules}
+		got := generator.NextColor(storage, data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveGeneratorIsCheck(test *testing.T) {
+	type fields struct {
+		rules Rules
+	}
+	type args struct {
+		fen string
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{rules: nil},
+			args:   args{"2k/3/K1r"},
+			want:   true,
+		},
+		{
+			fields: fields{rules: nil},
+			args:   args{"2k/2r/K2"},
+			want:   false,
+		},
+		{
+			fields: fields{
+				rules: MockTurnOrderRules{
+					isCheck: func(
+						storage common.PieceStorage,
+						color common.Color,
+					) bool {
+						return color == common.White
+					},
+				},
+			},
+			args: args{"2k/2r/K2"},
+			want: true,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := MoveGenerator{Rules: data.fields.rules}
+		got := generator.IsCheck(storage, common.White)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveGeneratorLegalMovesForColor_withTurnOrder(test *testing.T) {
+	storage := boards.NewMapBoard(
+		common.Size{Width: 3, Height: 3},
+		[]common.Piece{
+			pieces.NewKing(common.White, common.Position{File: 0, Rank: 0}),
+		},
+	)
+
+	generator := MoveGenerator{
+		Rules: MockTurnOrderRules{
+			MockRules: MockRules{
+				checkMove:   OrthodoxRules{}.CheckMove,
+				applyMove:   OrthodoxRules{}.ApplyMove,
+				termination: OrthodoxRules{}.Termination,
+			},
+			isCheck: func(storage common.PieceStorage, color common.Color) bool {
+				_, ok := storage.Piece(common.Position{File: 1, Rank: 1})
+				return ok
+			},
+		},
+	}
+	gotMoves, gotErr := generator.LegalMovesForColor(storage, common.White)
+
+	var gotFinishes []string
+	for _, move := range gotMoves {
+		gotFinishes = append(gotFinishes, uci.EncodeMove(move))
+	}
+
+	wantFinishes := []string{"a1b1", "a1a2"}
+	if !reflect.DeepEqual(gotFinishes, wantFinishes) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
+
+func TestPerft_withColorRotator(test *testing.T) {
+	storage := boards.NewMapBoard(
+		common.Size{Width: 3, Height: 3},
+		[]common.Piece{
+			pieces.NewKing(common.White, common.Position{File: 0, Rank: 0}),
+		},
+	)
+
+	generator := MoveGenerator{
+		Rules: MockTurnOrderRules{
+			MockRules: MockRules{
+				checkMove:   OrthodoxRules{}.CheckMove,
+				applyMove:   OrthodoxRules{}.ApplyMove,
+				termination: OrthodoxRules{}.Termination,
+			},
+			nextColor: func(
+				storage common.PieceStorage,
+				color common.Color,
+			) common.Color {
+				return common.White
+			},
+		},
+	}
+	got := Perft(generator, storage, common.White, 2, nil)
+
+	// the white king moves twice in a row: after Ka2 and Kb1, it has 5 moves,
+	// and after Kb2, it has 8 moves
+	if got != 5+5+8 {
+		test.Fail()
+	}
+}
+
+func TestMoveGeneratorMovesForColor_withSparseBoard(test *testing.T) {
+	for _, boardInFEN := range []string{
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R",
+		"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8",
+	} {
+		var movesByStorage [][]string
+		for _, pieceStorageFactory := range []uci.PieceStorageFactory{
+			boards.NewMapBoard,
+			boards.NewSparseBoard,
+		} {
+			storage, err := uci.DecodePieceStorage(
+				boardInFEN,
+				pieces.NewPiece,
+				pieceStorageFactory,
+			)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			var generator MoveGenerator
+			moves, err := generator.MovesForColor(storage, common.White)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			var encodedMoves []string
+			for _, move := range moves {
+				encodedMoves = append(encodedMoves, uci.EncodeMove(move))
+			}
+			sort.Strings(encodedMoves)
+
+			movesByStorage = append(movesByStorage, encodedMoves)
+		}
+
+		if !reflect.DeepEqual(movesByStorage[0], movesByStorage[1]) {
+			test.Log(boardInFEN)
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveGeneratorMovesForColor_withHugeSparseBoard(test *testing.T) {
+	type args struct {
+		storage common.PieceStorage
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage: boards.NewSparseBoard(
+					common.Size{Width: 1000, Height: 1000},
+					[]common.Piece{
+						pieces.NewRook(
+							common.White,
+							common.Position{File: 0, Rank: 0},
+						),
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 500, Rank: 500},
+						),
+						pieces.NewKing(
+							common.Black,
+							common.Position{File: 999, Rank: 999},
+						),
+					},
+				),
+			},
+			// the rook moves along the whole file and the whole rank
+			want: 999 + 999 + 8,
+		},
+		{
+			args: args{
+				storage: boards.NewSparseBoardWithHorizon(
+					common.Size{Width: 1 << 30, Height: 1 << 30},
+					[]common.Piece{
+						pieces.NewRook(
+							common.White,
+							common.Position{File: 1000, Rank: 1000},
+						),
+						pieces.NewKing(
+							common.White,
+							common.Position{File: 1005, Rank: 1000},
+						),
+						pieces.NewKing(
+							common.Black,
+							common.Position{File: 1003, Rank: 1010},
+						),
+					},
+					3,
+				),
+			},
+			// the horizon includes files 997-1008 and ranks 997-1013
+			want: 13 + 3 + 3 + 4 + 8,
+		},
+	} {
+		var generator MoveGenerator
+		moves, err := generator.MovesForColor(data.args.storage, common.White)
+
+		if len(moves) != data.want {
+			test.Log(len(moves))
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
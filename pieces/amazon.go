@@ -0,0 +1,39 @@
+package pieces
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Amazon ...
+//
+// It combines moves of a queen and a knight.
+type Amazon struct{ Base }
+
+// NewAmazon ...
+func NewAmazon(color common.Color, position common.Position) Amazon {
+	base := NewBase(common.Amazon, color, position)
+	return Amazon{base}
+}
+
+// ApplyPosition ...
+func (piece Amazon) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Amazon{base}
+}
+
+// CheckMove ...
+func (piece Amazon) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	okForQueen := Queen(piece).CheckMove(move, storage)
+	okForKnight := Knight(piece).CheckMove(move, storage)
+	return okForQueen || okForKnight
+}
+
+// Reach ...
+func (piece Amazon) Reach(storage common.PieceStorage) []common.Position {
+	queenReach := Queen(piece).Reach(storage)
+	knightReach := Knight(piece).Reach(storage)
+	return append(queenReach, knightReach...)
+}
@@ -0,0 +1,39 @@
+package pieces
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Archbishop ...
+//
+// It combines moves of a bishop and a knight.
+type Archbishop struct{ Base }
+
+// NewArchbishop ...
+func NewArchbishop(color common.Color, position common.Position) Archbishop {
+	base := NewBase(common.Archbishop, color, position)
+	return Archbishop{base}
+}
+
+// ApplyPosition ...
+func (piece Archbishop) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Archbishop{base}
+}
+
+// CheckMove ...
+func (piece Archbishop) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	okForBishop := Bishop(piece).CheckMove(move, storage)
+	okForKnight := Knight(piece).CheckMove(move, storage)
+	return okForBishop || okForKnight
+}
+
+// Reach ...
+func (piece Archbishop) Reach(storage common.PieceStorage) []common.Position {
+	bishopReach := Bishop(piece).Reach(storage)
+	knightReach := Knight(piece).Reach(storage)
+	return append(bishopReach, knightReach...)
+}
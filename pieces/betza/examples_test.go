@@ -0,0 +1,38 @@
+package betza_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/pieces/betza"
+)
+
+func ExampleNewPieceFactory() {
+	// the amazon kind is reused for a shogi silver general
+	factory := betza.NewPieceFactory(
+		map[common.Kind]betza.Movement{common.Amazon: betza.MustParse("FfW")},
+		pieces.NewPiece,
+	)
+	storage, _ :=
+		uci.DecodePieceStorage("5/5/2Z2/5/5", factory, boards.NewMapBoard)
+
+	var generator models.MoveGenerator
+	moves, _ := generator.MovesForPosition(storage, common.Position{
+		File: 2,
+		Rank: 2,
+	})
+	for _, move := range moves {
+		fmt.Println(uci.EncodeMove(move))
+	}
+
+	// Output:
+	// c3b2
+	// c3d2
+	// c3b4
+	// c3c4
+	// c3d4
+}
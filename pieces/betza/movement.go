@@ -0,0 +1,292 @@
+// Package betza implements pieces, which moves are described
+// in the Betza notation with some XBetza extensions.
+//
+// A description is a sequence of components. Each component consists
+// of optional modifiers (lowercase letters) and an atom (an uppercase letter)
+// with an optional range.
+//
+// Atoms (leapers):
+//
+//	W - (1, 0), wazir;
+//	F - (1, 1), ferz;
+//	D - (2, 0), dabbaba;
+//	N - (2, 1), knight;
+//	A - (2, 2), alfil;
+//	H - (3, 0), threeleaper;
+//	C - (3, 1), camel;
+//	Z - (3, 2), zebra;
+//	G - (3, 3), tripper.
+//
+// Shorthands: K = WF, R = WW, B = FF, Q = WWFF.
+//
+// Ranges: a doubled atom (e.g. WW) or the 0 suffix (e.g. W0) makes
+// an unlimited rider; a numeric suffix (e.g. W3) makes a rider limited
+// by the specified number of steps.
+//
+// Modifiers:
+//
+//	m - move only (without a capture);
+//	c - capture only;
+//	f, b, l, r - forward, backward, left and right directions;
+//	v, s - vertical and sideways directions (i.e. the ones where
+//	       the rank or the file step dominates correspondingly);
+//	ff, bb, ll, rr - doubled directions are narrowed to the dominating ones;
+//	fl, fr, bl, br (or lf, rf, lb, rb) - combined directions;
+//	n - a non-jumping (lame) leaper, it's blocked by any piece on its path
+//	    (the path goes orthogonally first and then diagonally);
+//	p - a hopper, the rider should jump over exactly one piece.
+//
+// Directions are relative to a piece color, i.e. forward for white
+// is an increasing of a rank and for black is a decreasing of one.
+// A component without directions moves in all of them.
+//
+// Examples: WfF (a wazir and a forward ferz), NB (an archbishop),
+// mfWcfF (a pawn without a double move), mRcpR (a xiangqi cannon).
+package betza
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+type direction struct {
+	file int
+	rank int
+}
+
+type component struct {
+	directions []direction
+	maxSteps   int // 0 means unlimited
+	canMove    bool
+	canCapture bool
+	isLame     bool
+	isHopper   bool
+}
+
+// Movement ...
+type Movement struct {
+	notation   string
+	components []component
+}
+
+// String ...
+//
+// It returns the notation of the movement.
+func (movement Movement) String() string {
+	return movement.notation
+}
+
+// CheckMove ...
+//
+// It checks the move of a piece of the specified color by the movement.
+// It follows restrictions of the common.Piece.CheckMove() method.
+func (movement Movement) CheckMove(
+	color common.Color,
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	sign := colorSign(color)
+	delta := direction{
+		file: (move.Finish.File - move.Start.File) * sign,
+		rank: (move.Finish.Rank - move.Start.Rank) * sign,
+	}
+
+	_, isCapture := storage.Piece(move.Finish)
+	for _, component := range movement.components {
+		if isCapture && !component.canCapture ||
+			!isCapture && !component.canMove {
+			continue
+		}
+
+		if component.checkMove(color, move.Start, delta, storage) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reach ...
+//
+// It returns positions, which a piece of the specified color can reach
+// from the start by the movement (see the common.Reacher interface).
+func (movement Movement) Reach(
+	color common.Color,
+	start common.Position,
+	storage common.PieceStorage,
+) []common.Position {
+	var positions []common.Position
+	for _, component := range movement.components {
+		positions = append(positions, component.reach(color, start, storage)...)
+	}
+
+	return positions
+}
+
+func (component component) checkMove(
+	color common.Color,
+	start common.Position,
+	delta direction,
+	storage common.PieceStorage,
+) bool {
+	for _, step := range component.directions {
+		count, ok := stepCount(delta, step)
+		if !ok ||
+			(component.maxSteps != 0 && count > component.maxSteps) {
+			continue
+		}
+
+		var path []direction
+		if component.isLame {
+			path = lamePath(step)
+		} else {
+			for i := 1; i < count; i++ {
+				path = append(path, direction{step.file * i, step.rank * i})
+			}
+		}
+
+		wantedObstacles := 0
+		if component.isHopper {
+			wantedObstacles = 1
+		}
+		obstacles, ok := countObstacles(color, start, path, storage)
+		if ok && obstacles == wantedObstacles {
+			return true
+		}
+	}
+
+	return false
+}
+
+// leapers are treated as riders limited by one step; paths of lame leapers
+// are checked by the checkMove() method
+func (component component) reach(
+	color common.Color,
+	start common.Position,
+	storage common.PieceStorage,
+) []common.Position {
+	sign := colorSign(color)
+
+	var positions []common.Position
+	for _, step := range component.directions {
+		steps := []common.Position{{File: step.file * sign, Rank: step.rank * sign}}
+		line := common.Rides(storage, start, steps, component.maxSteps)
+		positions = append(positions, line...)
+		if !component.isHopper || len(line) == 0 {
+			continue
+		}
+
+		// a hopper continues the line after the first obstacle
+		obstacle := line[len(line)-1]
+		if _, ok := storage.Piece(obstacle); ok {
+			line = common.Rides(storage, obstacle, steps, component.maxSteps)
+			positions = append(positions, line...)
+		}
+	}
+
+	return positions
+}
+
+func colorSign(color common.Color) int {
+	if color == common.Black {
+		return -1
+	}
+
+	return 1
+}
+
+// it returns a positive number of steps that gives the delta
+func stepCount(delta direction, step direction) (count int, ok bool) {
+	switch {
+	case step.file != 0:
+		if delta.file%step.file != 0 {
+			return 0, false
+		}
+
+		count = delta.file / step.file
+	case delta.file != 0:
+		return 0, false
+	default:
+		if delta.rank%step.rank != 0 {
+			return 0, false
+		}
+
+		count = delta.rank / step.rank
+	}
+
+	ok = count > 0 &&
+		delta.file == step.file*count &&
+		delta.rank == step.rank*count
+	return count, ok
+}
+
+// it returns intermediate points of the leap, which goes orthogonally first
+// and then diagonally
+func lamePath(step direction) []direction {
+	fileSteps, rankSteps := abs(step.file), abs(step.rank)
+	fileSign, rankSign := sign(step.file), sign(step.rank)
+
+	var path []direction
+	var current direction
+	for current != step {
+		switch {
+		case fileSteps-abs(current.file) > rankSteps-abs(current.rank):
+			current.file += fileSign
+		case rankSteps-abs(current.rank) > fileSteps-abs(current.file):
+			current.rank += rankSign
+		default:
+			current.file += fileSign
+			current.rank += rankSign
+		}
+
+		if current != step {
+			path = append(path, current)
+		}
+	}
+
+	return path
+}
+
+// it returns false if the path passes a position, which isn't a cell
+// of the board
+func countObstacles(
+	color common.Color,
+	start common.Position,
+	path []direction,
+	storage common.PieceStorage,
+) (count int, ok bool) {
+	sign := colorSign(color)
+
+	for _, point := range path {
+		position := common.Position{
+			File: start.File + point.file*sign,
+			Rank: start.Rank + point.rank*sign,
+		}
+		if !common.HasPosition(storage, position) {
+			return 0, false
+		}
+		if _, ok := storage.Piece(position); ok {
+			count++
+		}
+	}
+
+	return count, true
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
+
+func sign(value int) int {
+	switch {
+	case value > 0:
+		return 1
+	case value < 0:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,310 @@
+package betza
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestMovementCheckMove_withOrthodoxPieces(test *testing.T) {
+	type args struct {
+		notation string
+		kind     common.Kind
+	}
+	type data struct {
+		args args
+	}
+
+	for _, data := range []data{
+		{args: args{"K", common.King}},
+		{args: args{"Q", common.Queen}},
+		{args: args{"R", common.Rook}},
+		{args: args{"B", common.Bishop}},
+		{args: args{"N", common.Knight}},
+		{args: args{"BN", common.Archbishop}},
+		{args: args{"RN", common.Chancellor}},
+		{args: args{"QN", common.Amazon}},
+	} {
+		movement := MustParse(data.args.notation)
+		for _, boardInFEN := range []string{
+			"8/8/8/8/8/8/8/8",
+			"8/1p3P2/8/1P4p1/8/3p4/2P1p3/8",
+			"8/8/8/8/2pPp3/2P1P3/2pPp3/8",
+		} {
+			storage, err :=
+				uci.DecodePieceStorage(boardInFEN, pieces.NewPiece, boards.NewMapBoard)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			for _, start := range []common.Position{
+				{File: 3, Rank: 2},
+				{File: 0, Rank: 0},
+				{File: 7, Rank: 4},
+			} {
+				if _, ok := storage.Piece(start); ok {
+					continue
+				}
+
+				for _, color := range []common.Color{common.Black, common.White} {
+					piece := pieces.NewPiece(data.args.kind, color, start)
+					storage.Size().IteratePositions(func(finish common.Position) error {
+						move := common.Move{Start: start, Finish: finish}
+						if start != finish && movement.CheckMove(color, move, storage) !=
+							piece.CheckMove(move, storage) {
+							test.Fail()
+						}
+
+						return nil
+					})
+				}
+			}
+		}
+	}
+}
+
+func TestMovementCheckMove(test *testing.T) {
+	type args struct {
+		notation   string
+		boardInFEN string
+		color      common.Color
+		position   common.Position
+	}
+	type data struct {
+		args         args
+		wantFinishes []string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				notation:   "mfWcfF",
+				boardInFEN: "5/5/1p1p1/2P2/5",
+				color:      common.White,
+				position:   common.Position{File: 2, Rank: 1},
+			},
+			wantFinishes: []string{"b3", "c3", "d3"},
+		},
+		{
+			args: args{
+				notation:   "mfWcfF",
+				boardInFEN: "5/5/1p1p1/2P2/5",
+				color:      common.Black,
+				position:   common.Position{File: 1, Rank: 2},
+			},
+			wantFinishes: []string{"b2", "c2"},
+		},
+		{
+			args: args{
+				notation:   "mfWcfF",
+				boardInFEN: "5/5/2p2/2P2/5",
+				color:      common.White,
+				position:   common.Position{File: 2, Rank: 1},
+			},
+			wantFinishes: nil,
+		},
+		{
+			args: args{
+				notation:   "nN",
+				boardInFEN: "5/5/2N2/2P2/5",
+				color:      common.White,
+				position:   common.Position{File: 2, Rank: 2},
+			},
+			wantFinishes: []string{"a2", "e2", "a4", "e4", "b5", "d5"},
+		},
+		{
+			args: args{
+				notation:   "nA",
+				boardInFEN: "5/3p1/2N2/5/5",
+				color:      common.White,
+				position:   common.Position{File: 2, Rank: 2},
+			},
+			wantFinishes: []string{"a1", "e1", "a5"},
+		},
+		{
+			args: args{
+				notation:   "mRcpR",
+				boardInFEN: "2p2/2p2/2N1p/5/P1P2",
+				color:      common.White,
+				position:   common.Position{File: 2, Rank: 2},
+			},
+			wantFinishes: []string{"c2", "a3", "b3", "d3", "c5"},
+		},
+		{
+			args: args{
+				notation:   "W2",
+				boardInFEN: "5/5/2N2/5/5",
+				color:      common.White,
+				position:   common.Position{File: 0, Rank: 0},
+			},
+			wantFinishes: []string{"b1", "c1", "a2", "a3"},
+		},
+		{
+			args: args{
+				notation:   "flF0",
+				boardInFEN: "5/5/5/5/5",
+				color:      common.White,
+				position:   common.Position{File: 4, Rank: 0},
+			},
+			wantFinishes: []string{"d2", "c3", "b4", "a5"},
+		},
+		{
+			args: args{
+				notation:   "flF0",
+				boardInFEN: "5/5/5/5/5",
+				color:      common.Black,
+				position:   common.Position{File: 4, Rank: 4},
+			},
+			wantFinishes: nil,
+		},
+		{
+			args: args{
+				notation:   "flF0",
+				boardInFEN: "5/5/5/5/5",
+				color:      common.Black,
+				position:   common.Position{File: 0, Rank: 4},
+			},
+			wantFinishes: []string{"e1", "d2", "c3", "b4"},
+		},
+		{
+			args: args{
+				notation:   "sN",
+				boardInFEN: "5/5/5/5/5",
+				color:      common.White,
+				position:   common.Position{File: 2, Rank: 2},
+			},
+			wantFinishes: []string{"a2", "e2", "a4", "e4"},
+		},
+		{
+			args: args{
+				notation:   "R",
+				boardInFEN: "5/5/1*3/5/5",
+				color:      common.White,
+				position:   common.Position{File: 2, Rank: 2},
+			},
+			// the hole itself is rejected by common.CheckMove()
+			wantFinishes: []string{"c1", "c2", "b3", "d3", "e3", "c4", "c5"},
+		},
+		{
+			args: args{
+				notation:   "nN",
+				boardInFEN: "5/5/2*2/5/5",
+				color:      common.White,
+				position:   common.Position{File: 2, Rank: 1},
+			},
+			wantFinishes: []string{"a1", "e1", "a3", "e3"},
+		},
+	} {
+		movement := MustParse(data.args.notation)
+
+		notation := uci.Notation{GeometryWrapper: boards.NewGeometryBoard}
+		storage, err := notation.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var gotFinishes []string
+		storage.Size().IteratePositions(func(position common.Position) error {
+			move := common.Move{Start: data.args.position, Finish: position}
+			if position != data.args.position &&
+				movement.CheckMove(data.args.color, move, storage) {
+				gotFinishes = append(gotFinishes, uci.EncodePosition(position))
+			}
+
+			return nil
+		})
+
+		if !reflect.DeepEqual(gotFinishes, data.wantFinishes) {
+			test.Fail()
+		}
+	}
+}
+
+func TestMovementReach(test *testing.T) {
+	for _, notation := range []string{
+		"K",
+		"Q",
+		"NN",
+		"C",
+		"Z",
+		"G",
+		"W3",
+		"WfF",
+		"nN",
+		"mfWcfF",
+		"mRcpR",
+		"pB",
+	} {
+		movement := MustParse(notation)
+		for _, boardInFEN := range []string{
+			"8/8/8/8/8/8/8/8",
+			"8/1p3P2/8/1P4p1/8/3p4/2P1p3/8",
+			"8/8/8/8/2pPp3/2P1P3/2pPp3/8",
+		} {
+			storage, err :=
+				uci.DecodePieceStorage(boardInFEN, pieces.NewPiece, boards.NewMapBoard)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			for _, start := range []common.Position{
+				{File: 3, Rank: 2},
+				{File: 0, Rank: 0},
+				{File: 7, Rank: 4},
+			} {
+				for _, color := range []common.Color{common.Black, common.White} {
+					reachedPositions := make(map[common.Position]bool)
+					for _, position := range movement.Reach(color, start, storage) {
+						reachedPositions[position] = true
+					}
+
+					// all finishes of moves should be reachable
+					storage.Size().IteratePositions(func(finish common.Position) error {
+						move := common.Move{Start: start, Finish: finish}
+						if start != finish &&
+							movement.CheckMove(color, move, storage) &&
+							!reachedPositions[finish] {
+							test.Log(notation, boardInFEN, move)
+							test.Fail()
+						}
+
+						return nil
+					})
+				}
+			}
+		}
+	}
+}
+
+func TestMovementReach_withHopper(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"8/8/8/8/8/8/8/1p1p1p2",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	// the line ends on the second obstacle
+	start := common.Position{File: 0, Rank: 0}
+	got := MustParse("scpR").Reach(common.White, start, storage)
+
+	want := []common.Position{
+		{File: 1, Rank: 0},
+		{File: 2, Rank: 0},
+		{File: 3, Rank: 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Log(got)
+		test.Fail()
+	}
+}
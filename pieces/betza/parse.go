@@ -0,0 +1,296 @@
+package betza
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+var (
+	atoms = map[rune]direction{
+		'W': {1, 0},
+		'F': {1, 1},
+		'D': {2, 0},
+		'N': {2, 1},
+		'A': {2, 2},
+		'H': {3, 0},
+		'C': {3, 1},
+		'Z': {3, 2},
+		'G': {3, 3},
+	}
+	shorthands = map[rune]struct {
+		atoms    []rune
+		maxSteps int
+	}{
+		'K': {[]rune{'W', 'F'}, 1},
+		'R': {[]rune{'W'}, 0},
+		'B': {[]rune{'F'}, 0},
+		'Q': {[]rune{'W', 'F'}, 0},
+	}
+)
+
+type directionFilter func(step direction) bool
+
+// Parse ...
+//
+// It parses the movement description in the Betza notation
+// (see the package description).
+func Parse(notation string) (Movement, error) {
+	if notation == "" {
+		return Movement{}, errors.New("empty notation")
+	}
+
+	var components []component
+	var componentIndex int
+	symbols := []rune(notation)
+	for index := 0; index < len(symbols); {
+		modifiersStart := index
+		for index < len(symbols) && unicode.IsLower(symbols[index]) {
+			index++
+		}
+		if index == len(symbols) {
+			return Movement{}, errors.New("no atom after modifiers")
+		}
+
+		atom := symbols[index]
+		index++
+
+		rangeStart := index
+		for index < len(symbols) && unicode.IsDigit(symbols[index]) {
+			index++
+		}
+
+		var isDoubled bool
+		if rangeStart == index && index < len(symbols) && symbols[index] == atom {
+			isDoubled = true
+			index++
+		}
+
+		atomComponents, err := parseComponent(
+			string(symbols[modifiersStart:rangeStart-1]),
+			atom,
+			string(symbols[rangeStart:index]),
+			isDoubled,
+		)
+		if err != nil {
+			return Movement{}, fmt.Errorf(
+				"incorrect component #%d: %s",
+				componentIndex,
+				err,
+			)
+		}
+
+		components = append(components, atomComponents...)
+		componentIndex++
+	}
+
+	return Movement{notation: notation, components: components}, nil
+}
+
+// MustParse ...
+//
+// It's the same as the Parse() function, but it panics on an error.
+func MustParse(notation string) Movement {
+	movement, err := Parse(notation)
+	if err != nil {
+		panic(fmt.Sprintf("unable to parse %q: %s", notation, err))
+	}
+
+	return movement
+}
+
+func parseComponent(
+	modifiers string,
+	atom rune,
+	rangeText string,
+	isDoubled bool,
+) ([]component, error) {
+	var atomSteps []direction
+	maxSteps := 1
+	if step, ok := atoms[atom]; ok {
+		atomSteps = append(atomSteps, step)
+	} else if shorthand, ok := shorthands[atom]; ok {
+		if isDoubled {
+			return nil, errors.New("doubled shorthand")
+		}
+
+		for _, atom := range shorthand.atoms {
+			atomSteps = append(atomSteps, atoms[atom])
+		}
+		maxSteps = shorthand.maxSteps
+	} else {
+		return nil, fmt.Errorf("unknown atom %q", atom)
+	}
+
+	switch {
+	case isDoubled:
+		maxSteps = 0
+	case rangeText != "":
+		var err error
+		maxSteps, err = strconv.Atoi(rangeText)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect range: %s", err)
+		}
+	}
+
+	prototype := component{maxSteps: maxSteps}
+	filters, err := parseModifiers(modifiers, &prototype)
+	if err != nil {
+		return nil, err
+	}
+	if prototype.isHopper && prototype.maxSteps == 1 {
+		return nil, errors.New("hopper modifier is supported only for riders")
+	}
+	if prototype.isLame && prototype.maxSteps != 1 {
+		return nil, errors.New("lame modifier is supported only for leapers")
+	}
+
+	var components []component
+	for _, atomStep := range atomSteps {
+		component := prototype
+		component.directions = filterDirections(symmetries(atomStep), filters)
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+func parseModifiers(
+	modifiers string,
+	prototype *component,
+) ([]directionFilter, error) {
+	var directions []rune
+	for _, modifier := range modifiers {
+		switch modifier {
+		case 'm':
+			prototype.canMove = true
+		case 'c':
+			prototype.canCapture = true
+		case 'n':
+			prototype.isLame = true
+		case 'p':
+			prototype.isHopper = true
+		case 'f', 'b', 'l', 'r', 'v', 's':
+			directions = append(directions, modifier)
+		default:
+			return nil, fmt.Errorf("unknown modifier %q", modifier)
+		}
+	}
+	if !prototype.canMove && !prototype.canCapture {
+		prototype.canMove, prototype.canCapture = true, true
+	}
+
+	var filters []directionFilter
+	for index := 0; index < len(directions); index++ {
+		current := directions[index]
+		filter := singleDirectionFilter(current)
+
+		if index+1 < len(directions) {
+			next := directions[index+1]
+			switch {
+			case next == current && current != 'v' && current != 's':
+				filter = doubledDirectionFilter(current)
+				index++
+			case isVertical(current) && isHorizontal(next) ||
+				isHorizontal(current) && isVertical(next):
+				filter = combinedDirectionFilter(current, next)
+				index++
+			}
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func singleDirectionFilter(symbol rune) directionFilter {
+	return func(step direction) bool {
+		switch symbol {
+		case 'f':
+			return step.rank > 0
+		case 'b':
+			return step.rank < 0
+		case 'l':
+			return step.file < 0
+		case 'r':
+			return step.file > 0
+		case 'v':
+			return abs(step.rank) > abs(step.file)
+		case 's':
+			return abs(step.file) > abs(step.rank)
+		default:
+			return false
+		}
+	}
+}
+
+func doubledDirectionFilter(symbol rune) directionFilter {
+	single := singleDirectionFilter(symbol)
+	dominating := singleDirectionFilter('v')
+	if isHorizontal(symbol) {
+		dominating = singleDirectionFilter('s')
+	}
+
+	return func(step direction) bool {
+		return single(step) && dominating(step)
+	}
+}
+
+func combinedDirectionFilter(first rune, second rune) directionFilter {
+	firstFilter := singleDirectionFilter(first)
+	secondFilter := singleDirectionFilter(second)
+	return func(step direction) bool {
+		return firstFilter(step) && secondFilter(step)
+	}
+}
+
+func isVertical(symbol rune) bool {
+	return symbol == 'f' || symbol == 'b'
+}
+
+func isHorizontal(symbol rune) bool {
+	return symbol == 'l' || symbol == 'r'
+}
+
+func symmetries(step direction) []direction {
+	var directions []direction
+	visited := make(map[direction]struct{})
+	for _, base := range []direction{step, {step.rank, step.file}} {
+		for _, fileSign := range []int{1, -1} {
+			for _, rankSign := range []int{1, -1} {
+				symmetry := direction{base.file * fileSign, base.rank * rankSign}
+				if _, ok := visited[symmetry]; ok {
+					continue
+				}
+
+				visited[symmetry] = struct{}{}
+				directions = append(directions, symmetry)
+			}
+		}
+	}
+
+	return directions
+}
+
+func filterDirections(
+	directions []direction,
+	filters []directionFilter,
+) []direction {
+	if len(filters) == 0 {
+		return directions
+	}
+
+	var filteredDirections []direction
+	for _, direction := range directions {
+		for _, filter := range filters {
+			if filter(direction) {
+				filteredDirections = append(filteredDirections, direction)
+				break
+			}
+		}
+	}
+
+	return filteredDirections
+}
@@ -0,0 +1,212 @@
+package betza
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(test *testing.T) {
+	type args struct {
+		notation string
+	}
+	type data struct {
+		args         args
+		wantMovement Movement
+		wantErr      bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"W"},
+			wantMovement: Movement{
+				notation: "W",
+				components: []component{
+					{
+						directions: []direction{{1, 0}, {-1, 0}, {0, 1}, {0, -1}},
+						maxSteps:   1,
+						canMove:    true,
+						canCapture: true,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"mfWcfF"},
+			wantMovement: Movement{
+				notation: "mfWcfF",
+				components: []component{
+					{
+						directions: []direction{{0, 1}},
+						maxSteps:   1,
+						canMove:    true,
+					},
+					{
+						directions: []direction{{1, 1}, {-1, 1}},
+						maxSteps:   1,
+						canCapture: true,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"ffNsW3"},
+			wantMovement: Movement{
+				notation: "ffNsW3",
+				components: []component{
+					{
+						directions: []direction{{1, 2}, {-1, 2}},
+						maxSteps:   1,
+						canMove:    true,
+						canCapture: true,
+					},
+					{
+						directions: []direction{{1, 0}, {-1, 0}},
+						maxSteps:   3,
+						canMove:    true,
+						canCapture: true,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"frNNblF0"},
+			wantMovement: Movement{
+				notation: "frNNblF0",
+				components: []component{
+					{
+						directions: []direction{{2, 1}, {1, 2}},
+						maxSteps:   0,
+						canMove:    true,
+						canCapture: true,
+					},
+					{
+						directions: []direction{{-1, -1}},
+						maxSteps:   0,
+						canMove:    true,
+						canCapture: true,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"nNmRcpR"},
+			wantMovement: Movement{
+				notation: "nNmRcpR",
+				components: []component{
+					{
+						directions: []direction{
+							{2, 1}, {2, -1}, {-2, 1}, {-2, -1},
+							{1, 2}, {1, -2}, {-1, 2}, {-1, -2},
+						},
+						maxSteps:   1,
+						canMove:    true,
+						canCapture: true,
+						isLame:     true,
+					},
+					{
+						directions: []direction{{1, 0}, {-1, 0}, {0, 1}, {0, -1}},
+						maxSteps:   0,
+						canMove:    true,
+					},
+					{
+						directions: []direction{{1, 0}, {-1, 0}, {0, 1}, {0, -1}},
+						maxSteps:   0,
+						canCapture: true,
+						isHopper:   true,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{"vK"},
+			wantMovement: Movement{
+				notation: "vK",
+				components: []component{
+					{
+						directions: []direction{{0, 1}, {0, -1}},
+						maxSteps:   1,
+						canMove:    true,
+						canCapture: true,
+					},
+					{
+						directions: nil,
+						maxSteps:   1,
+						canMove:    true,
+						canCapture: true,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			args:         args{""},
+			wantMovement: Movement{},
+			wantErr:      true,
+		},
+		{
+			args:         args{"Wf"},
+			wantMovement: Movement{},
+			wantErr:      true,
+		},
+		{
+			args:         args{"X"},
+			wantMovement: Movement{},
+			wantErr:      true,
+		},
+		{
+			args:         args{"xW"},
+			wantMovement: Movement{},
+			wantErr:      true,
+		},
+		{
+			args:         args{"RR"},
+			wantMovement: Movement{},
+			wantErr:      true,
+		},
+		{
+			args:         args{"pN"},
+			wantMovement: Movement{},
+			wantErr:      true,
+		},
+		{
+			args:         args{"nR"},
+			wantMovement: Movement{},
+			wantErr:      true,
+		},
+		{
+			args:         args{"W99999999999999999999"},
+			wantMovement: Movement{},
+			wantErr:      true,
+		},
+	} {
+		gotMovement, gotErr := Parse(data.args.notation)
+
+		if !reflect.DeepEqual(gotMovement, data.wantMovement) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestMustParse(test *testing.T) {
+	movement := MustParse("WF")
+	if movement.String() != "WF" {
+		test.Fail()
+	}
+
+	defer func() {
+		if recover() == nil {
+			test.Fail()
+		}
+	}()
+	MustParse("WX")
+}
@@ -0,0 +1,70 @@
+package betza
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Piece ...
+type Piece struct {
+	pieces.Base
+
+	movement Movement
+}
+
+// NewPiece ...
+func NewPiece(
+	kind common.Kind,
+	color common.Color,
+	position common.Position,
+	movement Movement,
+) Piece {
+	base := pieces.NewBase(kind, color, position)
+	return Piece{base, movement}
+}
+
+// NewPieceFactory ...
+//
+// It returns a factory, which makes pieces of the specified kinds
+// with corresponding movements and uses the fallback factory for others.
+func NewPieceFactory(
+	movements map[common.Kind]Movement,
+	fallback common.PieceFactory,
+) common.PieceFactory {
+	return func(
+		kind common.Kind,
+		color common.Color,
+		position common.Position,
+	) common.Piece {
+		movement, ok := movements[kind]
+		if !ok {
+			return fallback(kind, color, position)
+		}
+
+		return NewPiece(kind, color, position, movement)
+	}
+}
+
+// Movement ...
+func (piece Piece) Movement() Movement {
+	return piece.movement
+}
+
+// ApplyPosition ...
+func (piece Piece) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Piece{base, piece.movement}
+}
+
+// CheckMove ...
+func (piece Piece) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return piece.movement.CheckMove(piece.Color(), move, storage)
+}
+
+// Reach ...
+func (piece Piece) Reach(storage common.PieceStorage) []common.Position {
+	return piece.movement.Reach(piece.Color(), piece.Position(), storage)
+}
@@ -0,0 +1,115 @@
+package betza
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewPiece(test *testing.T) {
+	movement := MustParse("WfF")
+	piece := NewPiece(common.Amazon, common.White, common.Position{
+		File: 2,
+		Rank: 3,
+	}, movement)
+
+	expectedPiece := Piece{
+		Base: pieces.NewBase(common.Amazon, common.White, common.Position{
+			File: 2,
+			Rank: 3,
+		}),
+		movement: movement,
+	}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(piece.Movement(), movement) {
+		test.Fail()
+	}
+}
+
+func TestNewPieceFactory(test *testing.T) {
+	movement := MustParse("WfF")
+	factory := NewPieceFactory(
+		map[common.Kind]Movement{common.Amazon: movement},
+		pieces.NewPiece,
+	)
+
+	gotPiece := factory(common.Amazon, common.Black, common.Position{
+		File: 2,
+		Rank: 3,
+	})
+
+	expectedPiece := NewPiece(common.Amazon, common.Black, common.Position{
+		File: 2,
+		Rank: 3,
+	}, movement)
+	if !reflect.DeepEqual(gotPiece, expectedPiece) {
+		test.Fail()
+	}
+
+	gotFallbackPiece := factory(common.Rook, common.Black, common.Position{
+		File: 2,
+		Rank: 3,
+	})
+
+	expectedFallbackPiece := pieces.NewRook(common.Black, common.Position{
+		File: 2,
+		Rank: 3,
+	})
+	if !reflect.DeepEqual(gotFallbackPiece, expectedFallbackPiece) {
+		test.Fail()
+	}
+}
+
+func TestPieceApplyPosition(test *testing.T) {
+	movement := MustParse("WfF")
+	piece := NewPiece(common.Amazon, common.White, common.Position{
+		File: 2,
+		Rank: 3,
+	}, movement)
+	nextPiece := piece.ApplyPosition(common.Position{
+		File: 4,
+		Rank: 2,
+	})
+
+	expectedPiece := NewPiece(common.Amazon, common.White, common.Position{
+		File: 2,
+		Rank: 3,
+	}, movement)
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+
+	expectedNextPiece := NewPiece(common.Amazon, common.White, common.Position{
+		File: 4,
+		Rank: 2,
+	}, movement)
+	if !reflect.DeepEqual(nextPiece, expectedNextPiece) {
+		test.Fail()
+	}
+}
+
+func TestPieceReach(test *testing.T) {
+	storage := boards.NewMapBoard(common.Size{Width: 3, Height: 3}, nil)
+	piece := NewPiece(
+		common.Pawn,
+		common.Black,
+		common.Position{File: 1, Rank: 2},
+		MustParse("mfWcfF"),
+	)
+	got := piece.Reach(storage)
+
+	want := []common.Position{
+		{File: 1, Rank: 1},
+		{File: 0, Rank: 1},
+		{File: 2, Rank: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Log(got)
+		test.Fail()
+	}
+}
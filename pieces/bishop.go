@@ -54,3 +54,8 @@ func (piece Bishop) CheckMove(
 		}
 	})
 }
+
+// Reach ...
+func (piece Bishop) Reach(storage common.PieceStorage) []common.Position {
+	return common.Rides(storage, piece.position, diagonalSteps, 0)
+}
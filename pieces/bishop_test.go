@@ -328,3 +328,16 @@ func TestBishopCheckMove(test *testing.T) {
 		}
 	}
 }
+
+func TestBishopReach(test *testing.T) {
+	got := reach(
+		NewBishop(common.White, common.Position{File: 2, Rank: 2}),
+		common.Size{Width: 5, Height: 5},
+		NewPawn(common.Black, common.Position{File: 3, Rank: 1}),
+	)
+
+	want := []string{"d4", "e5", "d2", "b2", "a1", "b4", "a5"}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,39 @@
+package pieces
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Chancellor ...
+//
+// It combines moves of a rook and a knight.
+type Chancellor struct{ Base }
+
+// NewChancellor ...
+func NewChancellor(color common.Color, position common.Position) Chancellor {
+	base := NewBase(common.Chancellor, color, position)
+	return Chancellor{base}
+}
+
+// ApplyPosition ...
+func (piece Chancellor) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Chancellor{base}
+}
+
+// CheckMove ...
+func (piece Chancellor) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	okForRook := Rook(piece).CheckMove(move, storage)
+	okForKnight := Knight(piece).CheckMove(move, storage)
+	return okForRook || okForKnight
+}
+
+// Reach ...
+func (piece Chancellor) Reach(storage common.PieceStorage) []common.Position {
+	rookReach := Rook(piece).Reach(storage)
+	knightReach := Knight(piece).Reach(storage)
+	return append(rookReach, knightReach...)
+}
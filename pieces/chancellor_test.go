@@ -0,0 +1,569 @@
+package pieces
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+func TestNewChancellor(test *testing.T) {
+	piece := NewChancellor(common.White, common.Position{
+		File: 2,
+		Rank: 3,
+	})
+
+	expectedPiece := Chancellor{
+		Base: Base{
+			kind:  common.Chancellor,
+			color: common.White,
+			position: common.Position{
+				File: 2,
+				Rank: 3,
+			},
+		},
+	}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+}
+
+func TestChancellorApplyPosition(test *testing.T) {
+	piece := NewChancellor(common.White, common.Position{
+		File: 2,
+		Rank: 3,
+	})
+	nextPiece := piece.ApplyPosition(common.Position{
+		File: 4,
+		Rank: 2,
+	})
+
+	expectedPiece := Chancellor{
+		Base: Base{
+			kind:  common.Chancellor,
+			color: common.White,
+			position: common.Position{
+				File: 2,
+				Rank: 3,
+			},
+		},
+	}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+
+	expectedNextPiece := Chancellor{
+		Base: Base{
+			kind:  common.Chancellor,
+			color: common.White,
+			position: common.Position{
+				File: 4,
+				Rank: 2,
+			},
+		},
+	}
+	if !reflect.DeepEqual(nextPiece, expectedNextPiece) {
+		test.Fail()
+	}
+}
+
+func TestChancellorCheckMove(test *testing.T) {
+	type args struct {
+		boardInFEN string
+		position   common.Position
+	}
+	type data struct {
+		args      args
+		wantMoves []common.Move
+		wantErr   error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				boardInFEN: "5/5/2C2/5/5",
+				position: common.Position{
+					File: 2,
+					Rank: 2,
+				},
+			},
+			wantMoves: []common.Move{
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 0,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 0,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 0,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 0,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 0,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 0,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 4,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 4,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 4,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "5/5/1pC2/1pp2/5",
+				position: common.Position{
+					File: 2,
+					Rank: 2,
+				},
+			},
+			wantMoves: []common.Move{
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 0,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 0,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 0,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 0,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 4,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 4,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 4,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "5/5/1PC2/1PP2/5",
+				position: common.Position{
+					File: 2,
+					Rank: 2,
+				},
+			},
+			wantMoves: []common.Move{
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 0,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 0,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 0,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 0,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 4,
+						Rank: 3,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 4,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 4,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 3,
+						Rank: 4,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.boardInFEN, NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fail()
+			continue
+		}
+
+		var generator models.MoveGenerator
+		gotMoves, gotErr := generator.MovesForPosition(storage, data.args.position)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotErr, data.wantErr) {
+			test.Fail()
+		}
+	}
+}
+
+func TestChancellorReach(test *testing.T) {
+	piece := NewChancellor(common.White, common.Position{File: 0, Rank: 0})
+	got := reach(piece, common.Size{Width: 3, Height: 3})
+
+	want := []string{"a2", "a3", "b1", "c1", "b3", "c2"}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
@@ -4,6 +4,31 @@ import (
 	"github.com/thewizardplusplus/go-chess-models/common"
 )
 
+var (
+	orthogonalSteps = []common.Position{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+	diagonalSteps   = []common.Position{{1, 1}, {1, -1}, {-1, -1}, {-1, 1}}
+	kingSteps       = []common.Position{
+		{0, 1},
+		{1, 1},
+		{1, 0},
+		{1, -1},
+		{0, -1},
+		{-1, -1},
+		{-1, 0},
+		{-1, 1},
+	}
+	knightSteps = []common.Position{
+		{1, 2},
+		{2, 1},
+		{2, -1},
+		{1, -2},
+		{-1, -2},
+		{-2, -1},
+		{-2, 1},
+		{-1, 2},
+	}
+)
+
 func min(a int, b int) int {
 	if a < b {
 		return a
@@ -29,6 +54,8 @@ func steps(a int, b int) int {
 	return steps
 }
 
+// it checks that there is an obstacle (a piece or a position, which isn't
+// a cell of the board) between the values
 func search(
 	storage common.PieceStorage,
 	a int,
@@ -38,6 +65,9 @@ func search(
 	start, finish := min(a, b), max(a, b)
 	for i := start + 1; i < finish; i++ {
 		position := makePosition(i)
+		if !common.HasPosition(storage, position) {
+			return true
+		}
 		if _, ok := storage.Piece(position); ok {
 			return true
 		}
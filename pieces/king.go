@@ -29,3 +29,8 @@ func (piece King) CheckMove(
 	rankSteps := steps(start.Rank, finish.Rank)
 	return fileSteps <= 1 && rankSteps <= 1
 }
+
+// Reach ...
+func (piece King) Reach(storage common.PieceStorage) []common.Position {
+	return common.Leaps(storage, piece.position, kingSteps)
+}
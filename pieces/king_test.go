@@ -173,3 +173,13 @@ func TestKingCheckMove(test *testing.T) {
 		test.Fail()
 	}
 }
+
+func TestKingReach(test *testing.T) {
+	piece := NewKing(common.White, common.Position{File: 0, Rank: 0})
+	got := reach(piece, common.Size{Width: 3, Height: 3})
+
+	want := []string{"a2", "b2", "b1"}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
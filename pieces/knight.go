@@ -29,3 +29,8 @@ func (piece Knight) CheckMove(
 	rankSteps := steps(start.Rank, finish.Rank)
 	return (fileSteps == 1 && rankSteps == 2) || (fileSteps == 2 && rankSteps == 1)
 }
+
+// Reach ...
+func (piece Knight) Reach(storage common.PieceStorage) []common.Position {
+	return common.Leaps(storage, piece.position, knightSteps)
+}
@@ -173,3 +173,13 @@ func TestKnightCheckMove(test *testing.T) {
 		test.Fail()
 	}
 }
+
+func TestKnightReach(test *testing.T) {
+	piece := NewKnight(common.White, common.Position{File: 0, Rank: 0})
+	got := reach(piece, common.Size{Width: 3, Height: 3})
+
+	want := []string{"b3", "c2"}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
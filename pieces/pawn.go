@@ -20,33 +20,52 @@ func (piece Pawn) ApplyPosition(position common.Position) common.Piece {
 }
 
 // CheckMove ...
+//
+// A pawn moves in the forward direction of its color
+// (see common.Color.Forward()).
 func (piece Pawn) CheckMove(
 	move common.Move,
 	storage common.PieceStorage,
 ) bool {
 	start, finish := move.Start, move.Finish
-	fileSteps := steps(start.File, finish.File)
+	step := common.Position{
+		File: finish.File - start.File,
+		Rank: finish.Rank - start.Rank,
+	}
 	if _, ok := storage.Piece(finish); !ok {
-		if fileSteps != 0 {
-			return false
-		}
-	} else {
-		if fileSteps != 1 {
-			return false
-		}
+		return step == piece.color.Forward()
 	}
 
-	rankSteps := finish.Rank - start.Rank
-	switch piece.color {
-	case common.Black:
-		if rankSteps != -1 {
-			return false
-		}
-	case common.White:
-		if rankSteps != 1 {
-			return false
+	for _, captureStep := range pawnCaptureSteps(piece.color) {
+		if step == captureStep {
+			return true
 		}
 	}
 
-	return true
+	return false
+}
+
+// Reach ...
+func (piece Pawn) Reach(storage common.PieceStorage) []common.Position {
+	captureSteps := pawnCaptureSteps(piece.color)
+	steps := []common.Position{
+		captureSteps[0],
+		piece.color.Forward(),
+		captureSteps[1],
+	}
+	return common.Leaps(storage, piece.position, steps)
+}
+
+// the sideways direction is perpendicular to the forward one
+func pawnCaptureSteps(color common.Color) []common.Position {
+	forward := color.Forward()
+	side := common.Position{
+		File: steps(forward.Rank, 0),
+		Rank: steps(forward.File, 0),
+	}
+
+	return []common.Position{
+		{File: forward.File - side.File, Rank: forward.Rank - side.Rank},
+		{File: forward.File + side.File, Rank: forward.Rank + side.Rank},
+	}
 }
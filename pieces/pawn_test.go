@@ -209,3 +209,43 @@ func TestPawnCheckMove(test *testing.T) {
 		}
 	}
 }
+
+func TestPawnReach(test *testing.T) {
+	type args struct {
+		piece Pawn
+	}
+	type data struct {
+		args args
+		want []string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{NewPawn(common.White, common.Position{File: 1, Rank: 1})},
+			want: []string{"a3", "b3", "c3"},
+		},
+		{
+			args: args{NewPawn(common.Black, common.Position{File: 1, Rank: 1})},
+			want: []string{"a1", "b1", "c1"},
+		},
+		{
+			args: args{NewPawn(common.Blue, common.Position{File: 1, Rank: 1})},
+			want: []string{"c1", "c2", "c3"},
+		},
+		{
+			args: args{NewPawn(common.Green, common.Position{File: 1, Rank: 1})},
+			want: []string{"a1", "a2", "a3"},
+		},
+		{
+			args: args{NewPawn(common.White, common.Position{File: 0, Rank: 2})},
+			want: nil,
+		},
+	} {
+		got := reach(data.args.piece, common.Size{Width: 3, Height: 3})
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Log(got)
+			test.Fail()
+		}
+	}
+}
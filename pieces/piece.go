@@ -24,6 +24,17 @@ func NewPiece(
 		piece = NewKnight(color, position)
 	case common.Pawn:
 		piece = NewPawn(color, position)
+	case common.Archbishop:
+		piece = NewArchbishop(color, position)
+	case common.Chancellor:
+		piece = NewChancellor(color, position)
+	case common.Amazon:
+		piece = NewAmazon(color, position)
+	default:
+		descriptor, ok := common.LookupKind(kind)
+		if ok && descriptor.Constructor != nil {
+			piece = descriptor.Constructor(color, position)
+		}
 	}
 
 	return piece
@@ -4,9 +4,27 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/thewizardplusplus/go-chess-models/boards"
 	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
 )
 
+// it returns positions reachable by the piece on a board with other pieces
+func reach(
+	piece common.Reacher,
+	size common.Size,
+	otherPieces ...common.Piece,
+) []string {
+	storage := boards.NewMapBoard(size, otherPieces)
+
+	var positions []string
+	for _, position := range piece.Reach(storage) {
+		positions = append(positions, uci.EncodePosition(position))
+	}
+
+	return positions
+}
+
 func TestNewPiece(test *testing.T) {
 	type args struct {
 		kind     common.Kind
@@ -103,6 +121,48 @@ func TestNewPiece(test *testing.T) {
 				Rank: 2,
 			}),
 		},
+		{
+			args: args{
+				kind:  common.Archbishop,
+				color: common.White,
+				position: common.Position{
+					File: 2,
+					Rank: 3,
+				},
+			},
+			want: NewArchbishop(common.White, common.Position{
+				File: 2,
+				Rank: 3,
+			}),
+		},
+		{
+			args: args{
+				kind:  common.Chancellor,
+				color: common.Black,
+				position: common.Position{
+					File: 4,
+					Rank: 2,
+				},
+			},
+			want: NewChancellor(common.Black, common.Position{
+				File: 4,
+				Rank: 2,
+			}),
+		},
+		{
+			args: args{
+				kind:  common.Amazon,
+				color: common.White,
+				position: common.Position{
+					File: 1,
+					Rank: 0,
+				},
+			},
+			want: NewAmazon(common.White, common.Position{
+				File: 1,
+				Rank: 0,
+			}),
+		},
 	} {
 		got := NewPiece(data.args.kind, data.args.color, data.args.position)
 
@@ -111,3 +171,37 @@ func TestNewPiece(test *testing.T) {
 		}
 	}
 }
+
+func TestNewPiece_withRegisteredKind(test *testing.T) {
+	kind, err := common.RegisterKind(common.KindDescriptor{
+		Name:   "pieces-test-kind",
+		Symbol: 'ж',
+		Value:  250,
+		Constructor: func(
+			color common.Color,
+			position common.Position,
+		) common.Piece {
+			return NewKnight(color, position)
+		},
+	})
+	if err != nil {
+		test.FailNow()
+	}
+
+	got := NewPiece(kind, common.White, common.Position{
+		File: 2,
+		Rank: 3,
+	})
+
+	want := NewKnight(common.White, common.Position{
+		File: 2,
+		Rank: 3,
+	})
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+
+	if NewPiece(kind+1, common.White, common.Position{}) != nil {
+		test.Fail()
+	}
+}
@@ -28,3 +28,10 @@ func (piece Queen) CheckMove(
 	okForBishop := Bishop(piece).CheckMove(move, storage)
 	return okForRook || okForBishop
 }
+
+// Reach ...
+func (piece Queen) Reach(storage common.PieceStorage) []common.Position {
+	rookReach := Rook(piece).Reach(storage)
+	bishopReach := Bishop(piece).Reach(storage)
+	return append(rookReach, bishopReach...)
+}
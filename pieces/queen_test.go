@@ -415,3 +415,13 @@ func TestQueenCheckMove(test *testing.T) {
 		}
 	}
 }
+
+func TestQueenReach(test *testing.T) {
+	piece := NewQueen(common.White, common.Position{File: 0, Rank: 0})
+	got := reach(piece, common.Size{Width: 3, Height: 3})
+
+	want := []string{"a2", "a3", "b1", "c1", "b2", "c3"}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
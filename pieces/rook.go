@@ -53,3 +53,8 @@ func (piece Rook) CheckMove(
 
 	return !search(storage, a, b, makePosition)
 }
+
+// Reach ...
+func (piece Rook) Reach(storage common.PieceStorage) []common.Position {
+	return common.Rides(storage, piece.position, orthogonalSteps, 0)
+}
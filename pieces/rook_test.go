@@ -246,9 +246,65 @@ func TestRookCheckMove(test *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			args: args{
+				boardInFEN: "5/2*2/2R*1/5/5",
+				position: common.Position{
+					File: 2,
+					Rank: 2,
+				},
+			},
+			wantMoves: []common.Move{
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 0,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 2,
+						Rank: 1,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 0,
+						Rank: 2,
+					},
+				},
+				{
+					Start: common.Position{
+						File: 2,
+						Rank: 2,
+					},
+					Finish: common.Position{
+						File: 1,
+						Rank: 2,
+					},
+				},
+			},
+			wantErr: nil,
+		},
 	} {
-		storage, err :=
-			uci.DecodePieceStorage(data.args.boardInFEN, NewPiece, boards.NewMapBoard)
+		notation := uci.Notation{GeometryWrapper: boards.NewGeometryBoard}
+		storage, err := notation.DecodePieceStorage(
+			data.args.boardInFEN,
+			NewPiece,
+			boards.NewMapBoard,
+		)
 		if err != nil {
 			test.Fail()
 			continue
@@ -265,3 +321,17 @@ func TestRookCheckMove(test *testing.T) {
 		}
 	}
 }
+
+func TestRookReach(test *testing.T) {
+	got := reach(
+		NewRook(common.White, common.Position{File: 2, Rank: 2}),
+		common.Size{Width: 5, Height: 5},
+		NewPawn(common.Black, common.Position{File: 1, Rank: 2}),
+		NewPawn(common.White, common.Position{File: 2, Rank: 0}),
+	)
+
+	want := []string{"c4", "c5", "d3", "e3", "c2", "c1", "b3"}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,196 @@
+package chessmodels
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Result ...
+//
+// It describes a result of a game.
+type Result int
+
+// ...
+const (
+	Unfinished Result = iota
+	Draw
+	BlackWin
+	WhiteWin
+
+	// results of four-player chess
+	RedWin
+	BlueWin
+	YellowWin
+	GreenWin
+	RedAndYellowWin
+	BlueAndGreenWin
+)
+
+// Win ...
+//
+// It returns a win of the color.
+func Win(color common.Color) Result {
+	switch color {
+	case common.Black:
+		return BlackWin
+	case common.Red:
+		return RedWin
+	case common.Blue:
+		return BlueWin
+	case common.Yellow:
+		return YellowWin
+	case common.Green:
+		return GreenWin
+	}
+
+	return WhiteWin
+}
+
+// TeamWin ...
+//
+// It returns a win of the team of the color in four-player chess, where
+// opposite players are partners. For other colors, it's the same as Win().
+func TeamWin(color common.Color) Result {
+	switch color {
+	case common.Red, common.Yellow:
+		return RedAndYellowWin
+	case common.Blue, common.Green:
+		return BlueAndGreenWin
+	}
+
+	return Win(color)
+}
+
+// IsFinished ...
+func (result Result) IsFinished() bool {
+	return result != Unfinished
+}
+
+// Rules ...
+//
+// It describes a rule set of a chess variant.
+type Rules interface {
+	// CheckMove ...
+	//
+	// It shouldn't check for a check before or after the move. It should return
+	// common.ErrKingCapture only if the move captures a royal piece.
+	CheckMove(storage common.PieceStorage, move common.Move) error
+
+	// ApplyMove ...
+	//
+	// It applies the move along with all its side effects.
+	ApplyMove(storage common.PieceStorage, move common.Move) common.PieceStorage
+
+	// Termination ...
+	//
+	// It checks whether the game is over before a move of the color regardless
+	// of its available moves (e.g. by a variant-specific goal). It should
+	// return Unfinished if the game goes on.
+	Termination(storage common.PieceStorage, color common.Color) Result
+
+	// NoMovesResult ...
+	//
+	// It returns a result of the game where the color has no legal moves.
+	// The flag signals that a royal piece of the color is under attack.
+	NoMovesResult(color common.Color, isCheck bool) Result
+}
+
+// MoveExpander ...
+//
+// It's an optional interface of Rules. It's used for moves that can't be
+// identified only by their start and finish (e.g. promotions).
+type MoveExpander interface {
+	// ExpandMove ...
+	//
+	// It returns variants of the move. It should return nil if the move
+	// has no variants and should be used as is.
+	ExpandMove(storage common.PieceStorage, move common.Move) []common.Move
+}
+
+// MoveFilter ...
+//
+// It's an optional interface of Rules. It's used for restrictions that
+// depend on all moves of a color (e.g. mandatory captures).
+type MoveFilter interface {
+	FilterMoves(
+		storage common.PieceStorage,
+		color common.Color,
+		moves []common.Move,
+	) []common.Move
+}
+
+// DropGenerator ...
+//
+// It's an optional interface of Rules. It's used for moves that don't start
+// on the board (e.g. drops of pieces from a hand).
+type DropGenerator interface {
+	Drops(storage common.PieceStorage, color common.Color) []common.Move
+}
+
+// TurnOrder ...
+//
+// It's an optional interface of Rules. It's used for games of more than two
+// players (e.g. four-player chess). Without it, colors alternate
+// by common.Color.Negative() and a color is in check if the other one
+// can capture its royal piece.
+type TurnOrder interface {
+	// NextColor ...
+	//
+	// It returns a color that moves after the color (e.g. skipping
+	// eliminated players).
+	NextColor(storage common.PieceStorage, color common.Color) common.Color
+
+	// IsCheck ...
+	//
+	// It checks that a royal piece of the color is under attack
+	// of any of its opponents.
+	IsCheck(storage common.PieceStorage, color common.Color) bool
+}
+
+// OrthodoxRules ...
+//
+// It implements the rules of orthodox chess. They are used by default.
+type OrthodoxRules struct{}
+
+// CheckMove ...
+//
+// It uses the piece storage, i.e. common.CheckMove() usually.
+func (rules OrthodoxRules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	return storage.CheckMove(move)
+}
+
+// ApplyMove ...
+//
+// It uses the piece storage.
+func (rules OrthodoxRules) ApplyMove(
+	storage common.PieceStorage,
+	move common.Move,
+) common.PieceStorage {
+	return storage.ApplyMove(move)
+}
+
+// Termination ...
+//
+// The orthodox game is over only when a player has no legal moves.
+func (rules OrthodoxRules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) Result {
+	return Unfinished
+}
+
+// NoMovesResult ...
+//
+// It returns a win of the opponent on a checkmate and a draw on a stalemate.
+func (rules OrthodoxRules) NoMovesResult(
+	color common.Color,
+	isCheck bool,
+) Result {
+	if !isCheck {
+		return Draw
+	}
+
+	return Win(color.Negative())
+}
@@ -0,0 +1,231 @@
+package chessmodels
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestWin(test *testing.T) {
+	type args struct {
+		color common.Color
+	}
+	type data struct {
+		args args
+		want Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.Black},
+			want: BlackWin,
+		},
+		{
+			args: args{common.White},
+			want: WhiteWin,
+		},
+		{
+			args: args{common.Red},
+			want: RedWin,
+		},
+		{
+			args: args{common.Green},
+			want: GreenWin,
+		},
+	} {
+		got := Win(data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestTeamWin(test *testing.T) {
+	type args struct {
+		color common.Color
+	}
+	type data struct {
+		args args
+		want Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.Red},
+			want: RedAndYellowWin,
+		},
+		{
+			args: args{common.Yellow},
+			want: RedAndYellowWin,
+		},
+		{
+			args: args{common.Blue},
+			want: BlueAndGreenWin,
+		},
+		{
+			args: args{common.Green},
+			want: BlueAndGreenWin,
+		},
+		{
+			args: args{common.White},
+			want: WhiteWin,
+		},
+	} {
+		got := TeamWin(data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestResultIsFinished(test *testing.T) {
+	type data struct {
+		result Result
+		want   bool
+	}
+
+	for _, data := range []data{
+		{
+			result: Unfinished,
+			want:   false,
+		},
+		{
+			result: Draw,
+			want:   true,
+		},
+		{
+			result: BlackWin,
+			want:   true,
+		},
+		{
+			result: WhiteWin,
+			want:   true,
+		},
+	} {
+		got := data.result.IsFinished()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestOrthodoxRulesCheckMove(test *testing.T) {
+	storage := MockPieceStorage{
+		MockMoveChecker: MockMoveChecker{
+			checkMove: func(move common.Move) error {
+				if move.Finish != (common.Position{File: 2, Rank: 3}) {
+					return common.ErrIllegalMove
+				}
+
+				return nil
+			},
+		},
+	}
+
+	var rules OrthodoxRules
+	gotOne := rules.CheckMove(storage, common.Move{
+		Start:  common.Position{File: 0, Rank: 0},
+		Finish: common.Position{File: 2, Rank: 3},
+	})
+	gotTwo := rules.CheckMove(storage, common.Move{
+		Start:  common.Position{File: 0, Rank: 0},
+		Finish: common.Position{File: 3, Rank: 2},
+	})
+
+	if gotOne != nil {
+		test.Fail()
+	}
+	if gotTwo != common.ErrIllegalMove {
+		test.Fail()
+	}
+}
+
+func TestOrthodoxRulesApplyMove(test *testing.T) {
+	var gotMove common.Move
+	storage := MockPieceStorage{
+		MockBasePieceStorage: MockBasePieceStorage{
+			applyMove: func(move common.Move) common.PieceStorage {
+				gotMove = move
+				return MockPieceStorage{
+					MockBasePieceStorage: MockBasePieceStorage{
+						size: common.Size{Width: 5, Height: 5},
+					},
+				}
+			},
+		},
+	}
+	move := common.Move{
+		Start:  common.Position{File: 0, Rank: 0},
+		Finish: common.Position{File: 2, Rank: 3},
+	}
+
+	var rules OrthodoxRules
+	got := rules.ApplyMove(storage, move)
+
+	want := MockPieceStorage{
+		MockBasePieceStorage: MockBasePieceStorage{
+			size: common.Size{Width: 5, Height: 5},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+	if gotMove != move {
+		test.Fail()
+	}
+}
+
+func TestOrthodoxRulesTermination(test *testing.T) {
+	var rules OrthodoxRules
+	got := rules.Termination(MockPieceStorage{}, common.White)
+
+	if got != Unfinished {
+		test.Fail()
+	}
+}
+
+func TestOrthodoxRulesNoMovesResult(test *testing.T) {
+	type args struct {
+		color   common.Color
+		isCheck bool
+	}
+	type data struct {
+		args args
+		want Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				color:   common.White,
+				isCheck: false,
+			},
+			want: Draw,
+		},
+		{
+			args: args{
+				color:   common.White,
+				isCheck: true,
+			},
+			want: BlackWin,
+		},
+		{
+			args: args{
+				color:   common.Black,
+				isCheck: true,
+			},
+			want: WhiteWin,
+		},
+	} {
+		var rules OrthodoxRules
+		got := rules.NoMovesResult(data.args.color, data.args.isCheck)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
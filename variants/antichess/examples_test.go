@@ -0,0 +1,28 @@
+package antichess_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/antichess"
+)
+
+func ExampleRules() {
+	storage, _ := uci.DecodePieceStorage(
+		"4k3/8/8/8/8/8/3p4/4K2R",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+
+	generator := models.MoveGenerator{Rules: antichess.Rules{}}
+	moves, _ := generator.LegalMovesForColor(storage, common.White)
+	for _, move := range moves {
+		fmt.Println(uci.EncodeMove(move))
+	}
+
+	// Output: e1d2
+}
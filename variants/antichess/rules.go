@@ -0,0 +1,94 @@
+// Package antichess implements the rules of Antichess (losing chess).
+//
+// Captures are mandatory. A king is an ordinary piece, which can be captured
+// and which a pawn can be promoted to. A player who loses all pieces or is
+// stalemated wins. Otherwise, the game follows the orthodox rules including
+// a pawn double step and an en passant capture, but without castling
+// (see the classic package).
+package antichess
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+// Rules ...
+//
+// It implements the models.Rules, models.MoveExpander
+// and models.MoveFilter interfaces. The special moves are enabled
+// by the embedded classic.Rules; castling should be disabled and a king
+// should be among promotions.
+type Rules struct {
+	classic.Rules
+}
+
+// CheckMove ...
+//
+// It never returns common.ErrKingCapture.
+//
+// It doesn't check that captures are mandatory, see FilterMoves().
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	err := rules.Rules.CheckMove(storage, move)
+	if err != common.ErrKingCapture {
+		return err
+	}
+
+	// the king capture is an ordinary one, but the embedded rules
+	// don't check its promotion
+	isPromotion :=
+		len(rules.Promotions) != 0 && common.IsPawnPromotion(storage, move)
+	return common.Promotions{Kinds: rules.Promotions}.
+		CheckMove(move, isPromotion)
+}
+
+// Termination ...
+//
+// It returns a win of the color if the color has no pieces.
+func (rules Rules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) models.Result {
+	for _, piece := range storage.Pieces() {
+		if piece.Color() == color {
+			return models.Unfinished
+		}
+	}
+
+	return models.Win(color)
+}
+
+// NoMovesResult ...
+//
+// It returns a win of the stalemated color.
+func (rules Rules) NoMovesResult(
+	color common.Color,
+	isCheck bool,
+) models.Result {
+	return models.Win(color)
+}
+
+// FilterMoves ...
+//
+// It leaves only captures (including ones en passant) if there are any.
+func (rules Rules) FilterMoves(
+	storage common.PieceStorage,
+	color common.Color,
+	moves []common.Move,
+) []common.Move {
+	var captures []common.Move
+	for _, move := range moves {
+		position := classic.CapturedPosition(storage, move)
+		if _, ok := storage.Piece(position); ok {
+			captures = append(captures, move)
+		}
+	}
+	if len(captures) == 0 {
+		return moves
+	}
+
+	return captures
+}
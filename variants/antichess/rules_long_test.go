@@ -0,0 +1,54 @@
+//go:build long
+// +build long
+
+package antichess
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+func TestPerft_long(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		deep  int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	// see the perft results of python-chess (examples/perft/antichess.perft)
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR - -",
+				color: common.White,
+				deep:  4,
+			},
+			want: 153299,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewSliceBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: antichessRules}
+		got := models.Perft(generator, storage, data.args.color, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,496 @@
+package antichess
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+var antichessRules = Rules{
+	Rules: classic.Rules{
+		PawnDoubleStep: true,
+		EnPassant:      true,
+		Promotions: []common.Kind{
+			common.King,
+			common.Queen,
+			common.Rook,
+			common.Bishop,
+			common.Knight,
+		},
+	},
+}
+
+func TestRulesCheckMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/8/R3K3 - -",
+				move: "a1a5",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/8/R3K3 - -",
+				move: "a1b2",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/3k4/4K3 - -",
+				move: "e1d2",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "8/4P3/8/8/8/8/8/8 - -",
+				move: "e7e8",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "8/4P3/8/8/8/8/8/8 - -",
+				move: "e7e8k",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "8/4P3/8/8/8/8/8/8 - -",
+				move: "e7e8p",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/4P3/8/8 - -",
+				move: "e3e4q",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/4p3/3N4 - -",
+				move: "e2d1n",
+			},
+			want: nil,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := antichessRules.CheckMove(storage, move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesApplyMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/3k4/4K3 - -",
+				move: "e1d2",
+			},
+			want: "8/8/8/8/8/8/3K4/8",
+		},
+		{
+			args: args{
+				fen:  "5n2/4P3/8/8/8/8/8/8 - -",
+				move: "e7f8k",
+			},
+			want: "5K2/8/8/8/8/8/8/8",
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/4p3/8 - -",
+				move: "e2e1r",
+			},
+			want: "8/8/8/8/8/8/8/4r3",
+		},
+	} {
+		for _, factory := range []uci.PieceStorageFactory{
+			boards.NewMapBoard,
+			boards.NewSliceBoard,
+			func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+				return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+			},
+		} {
+			storage, err :=
+				classic.DecodePieceStorage(data.args.fen, pieces.NewPiece, factory)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			move, err := uci.DecodeMove(data.args.move)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			got := antichessRules.ApplyMove(storage, move)
+
+			if uci.EncodePieceStorage(got) != data.want {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestRulesTermination(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args args
+		want models.Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "8/8/8/8/8/8/1p6/4K3",
+				color: common.White,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "8/8/8/8/8/8/1p6/8",
+				color: common.White,
+			},
+			want: models.WhiteWin,
+		},
+		{
+			args: args{
+				fen:   "8/8/8/8/8/8/8/4K3",
+				color: common.Black,
+			},
+			want: models.BlackWin,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var rules Rules
+		got := rules.Termination(storage, data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesNoMovesResult(test *testing.T) {
+	var rules Rules
+	gotOne := rules.NoMovesResult(common.White, false)
+	gotTwo := rules.NoMovesResult(common.Black, true)
+
+	if gotOne != models.WhiteWin {
+		test.Fail()
+	}
+	if gotTwo != models.BlackWin {
+		test.Fail()
+	}
+}
+
+func TestRulesExpandMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want []string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "8/4P3/8/8/8/8/8/8 - -",
+				move: "e7e8",
+			},
+			want: []string{"e7e8k", "e7e8q", "e7e8r", "e7e8b", "e7e8n"},
+		},
+		{
+			args: args{
+				fen:  "8/4P3/8/8/8/8/8/8 - -",
+				move: "e7e8q",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "8/4P3/8/8/8/8/8/8 - -",
+				move: "e7e6",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "8/4R3/8/8/8/8/8/8 - -",
+				move: "e7e8",
+			},
+			want: nil,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var got []string
+		for _, expandedMove := range antichessRules.ExpandMove(storage, move) {
+			got = append(got, uci.EncodeMove(expandedMove))
+		}
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesFilterMoves(test *testing.T) {
+	type args struct {
+		fen   string
+		moves []string
+	}
+	type data struct {
+		args args
+		want []string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "8/8/8/8/8/1p6/8/R7 - -",
+				moves: []string{"a1a2", "a1b1", "a1c1"},
+			},
+			want: []string{"a1a2", "a1b1", "a1c1"},
+		},
+		{
+			args: args{
+				fen:   "8/8/8/8/8/1p6/P7/R7 - -",
+				moves: []string{"a1b1", "a2a3", "a2b3"},
+			},
+			want: []string{"a2b3"},
+		},
+		{
+			args: args{
+				fen:   "8/8/8/3Pp3/8/8/8/8 - e6",
+				moves: []string{"d5d6", "d5e6"},
+			},
+			want: []string{"d5e6"},
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var moves []common.Move
+		for _, text := range data.args.moves {
+			move, err := uci.DecodeMove(text)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			moves = append(moves, move)
+		}
+
+		var rules Rules
+		var got []string
+		for _, move := range rules.FilterMoves(storage, common.White, moves) {
+			got = append(got, uci.EncodeMove(move))
+		}
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withMoveGenerator(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args       args
+		wantMoves  []string
+		wantResult models.Result
+	}
+
+	for _, data := range []data{
+		// captures are mandatory, including ones of a king
+		{
+			args: args{
+				fen:   "8/8/8/8/8/8/3k4/4K2R - -",
+				color: common.White,
+			},
+			wantMoves:  []string{"e1d2"},
+			wantResult: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "3r4/4P3/8/8/8/8/8/8 - -",
+				color: common.White,
+			},
+			wantMoves: []string{
+				"e7d8b",
+				"e7d8k",
+				"e7d8n",
+				"e7d8q",
+				"e7d8r",
+			},
+			wantResult: models.Unfinished,
+		},
+		// a stalemated player wins
+		{
+			args: args{
+				fen:   "8/8/8/8/8/p7/P7/8 - -",
+				color: common.White,
+			},
+			wantMoves:  nil,
+			wantResult: models.WhiteWin,
+		},
+		{
+			args: args{
+				fen:   "8/8/8/8/8/8/P7/8 - -",
+				color: common.Black,
+			},
+			wantMoves:  nil,
+			wantResult: models.BlackWin,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: antichessRules}
+		moves, err := generator.LegalMovesForColor(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var gotMoves []string
+		for _, move := range moves {
+			gotMoves = append(gotMoves, uci.EncodeMove(move))
+		}
+		sort.Strings(gotMoves)
+
+		gotResult, err := generator.Result(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotResult != data.wantResult {
+			test.Fail()
+		}
+	}
+}
+
+func TestPerft(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		deep  int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	// see the perft results of python-chess (examples/perft/antichess.perft)
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR - -",
+				color: common.White,
+				deep:  3,
+			},
+			want: 8067,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewSliceBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: antichessRules}
+		got := models.Perft(generator, storage, data.args.color, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
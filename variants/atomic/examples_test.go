@@ -0,0 +1,28 @@
+package atomic_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/atomic"
+)
+
+func ExampleRules_ApplyMove() {
+	storage, _ := uci.DecodePieceStorage(
+		"4k3/8/8/2pbr3/3n4/2P5/8/3RK3",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+
+	var rules atomic.Rules
+	nextStorage := rules.ApplyMove(storage, common.Move{
+		Start:  common.Position{File: 3, Rank: 0},
+		Finish: common.Position{File: 3, Rank: 3},
+	})
+	fmt.Println(uci.EncodePieceStorage(nextStorage))
+
+	// Output: 4k3/8/8/2p5/8/2P5/8/4K3
+}
@@ -0,0 +1,135 @@
+// Package atomic implements the rules of Atomic chess.
+//
+// A capture explodes the capturing piece, the captured one and all non-pawn
+// pieces on adjacent positions. Kings can't capture. A player who explodes
+// the enemy king wins. Otherwise, the game follows the orthodox rules
+// including their special moves (see the classic package).
+package atomic
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+// Rules ...
+//
+// It implements the models.Rules and models.MoveExpander interfaces.
+// Checkmate and stalemate are resolved as in orthodox chess. The special moves
+// are enabled by the embedded classic.Rules.
+type Rules struct {
+	classic.Rules
+}
+
+// CheckMove ...
+//
+// It returns common.ErrKingCapture if the move captures the enemy king
+// and keeps the own one.
+//
+// An explosion of the enemy king by a capture of an adjacent piece isn't
+// a king capture, so it doesn't make a previous move illegal; it just wins
+// (see Termination()). Adjacent kings are legal, because capturing one
+// of them explodes both.
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	if err := rules.Rules.CheckMove(storage, move); err != nil &&
+		err != common.ErrKingCapture {
+		return err
+	}
+
+	target, ok := storage.Piece(classic.CapturedPosition(storage, move))
+	if !ok {
+		return nil
+	}
+
+	piece, _ := storage.Piece(move.Start)
+	if piece.Kind() == common.King {
+		return common.ErrIllegalMove
+	}
+
+	for _, exploded := range Explosion(storage, move) {
+		if exploded.Kind() == common.King && exploded.Color() == piece.Color() {
+			return common.ErrIllegalMove
+		}
+	}
+	if target.Kind() == common.King {
+		return common.ErrKingCapture
+	}
+
+	return nil
+}
+
+// ApplyMove ...
+//
+// It removes all exploded pieces after a capture.
+func (rules Rules) ApplyMove(
+	storage common.PieceStorage,
+	move common.Move,
+) common.PieceStorage {
+	explosion := Explosion(storage, move)
+
+	storage = storage.ApplyMove(move)
+	for _, exploded := range explosion {
+		storage = storage.RemovePiece(exploded.Position())
+	}
+
+	return storage
+}
+
+// Termination ...
+//
+// It returns a win of the opponent if the color has no king.
+func (rules Rules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) models.Result {
+	for _, piece := range storage.Pieces() {
+		if piece.Kind() == common.King && piece.Color() == color {
+			return models.Unfinished
+		}
+	}
+
+	return models.Win(color.Negative())
+}
+
+// Explosion ...
+//
+// It returns pieces exploded by the move (with their positions before it).
+// They are the capturing piece, the captured one (e.g. a pawn captured
+// en passant) and non-pawn pieces on positions adjacent to the finish
+// of the move. The result is empty if the move isn't a capture.
+func Explosion(
+	storage common.PieceStorage,
+	move common.Move,
+) []common.Piece {
+	target, ok := storage.Piece(classic.CapturedPosition(storage, move))
+	if !ok {
+		return nil
+	}
+
+	piece, _ := storage.Piece(move.Start)
+	exploded := []common.Piece{piece.ApplyPosition(move.Finish), target}
+	for fileShift := -1; fileShift <= 1; fileShift++ {
+		for rankShift := -1; rankShift <= 1; rankShift++ {
+			position := common.Position{
+				File: move.Finish.File + fileShift,
+				Rank: move.Finish.Rank + rankShift,
+			}
+			if position == move.Finish || position == move.Start ||
+				!storage.Size().HasPosition(position) {
+				continue
+			}
+
+			neighbour, ok := storage.Piece(position)
+			if !ok || neighbour.Kind() == common.Pawn {
+				continue
+			}
+
+			exploded = append(exploded, neighbour)
+		}
+	}
+
+	return exploded
+}
@@ -0,0 +1,71 @@
+//go:build long
+// +build long
+
+package atomic
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+func TestPerft_long(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		deep  int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	// see the perft results of python-chess (examples/perft/atomic.perft)
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR KQkq -",
+				color: common.White,
+				deep:  4,
+			},
+			want: 197326,
+		},
+		{
+			args: args{
+				fen: "rn2kb1r/1pp1p2p/p2q1pp1/3P4/2P3b1/4PN2/PP3PPP/R2QKB1R " +
+					"KQkq -",
+				color: common.Black,
+				deep:  3,
+			},
+			want: 45237,
+		},
+		{
+			args: args{
+				fen:   "rn1qkb1r/p5pp/2p5/3p4/N3P3/5P2/PPP4P/R1BQK3 Qkq -",
+				color: common.White,
+				deep:  3,
+			},
+			want: 23353,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewSliceBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: atomicRules}
+		got := models.Perft(generator, storage, data.args.color, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
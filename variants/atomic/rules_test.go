@@ -0,0 +1,462 @@
+package atomic
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+var atomicRules = Rules{
+	Rules: classic.Rules{
+		PawnDoubleStep: true,
+		EnPassant:      true,
+		Castling:       true,
+		Promotions: []common.Kind{
+			common.Queen,
+			common.Rook,
+			common.Bishop,
+			common.Knight,
+		},
+	},
+}
+
+func TestRulesCheckMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/8/R3K3",
+				move: "a1a5",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/8/R3K3",
+				move: "a1b2",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/n7/8/8/8/R3K3",
+				move: "a1a5",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/3n4/4K3",
+				move: "e1d2",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/3nK3/3R4",
+				move: "d1d2",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/3nk3/4K3/3R4",
+				move: "d1d3",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/3nk3/8/3RK3",
+				move: "d1d3",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/3k4/8/3RK3",
+				move: "d1d3",
+			},
+			want: common.ErrKingCapture,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var rules Rules
+		got := rules.CheckMove(storage, move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesApplyMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "4k3/8/8/2pbr3/3n4/2P5/8/3RK3",
+				move: "d1d2",
+			},
+			want: "4k3/8/8/2pbr3/3n4/2P5/3R4/4K3",
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/2pbr3/3n4/2P5/8/3RK3",
+				move: "d1d4",
+			},
+			want: "4k3/8/8/2p5/8/2P5/8/4K3",
+		},
+		{
+			args: args{
+				fen:  "r3k3/8/8/8/8/8/8/R3K3",
+				move: "a1a8",
+			},
+			want: "4k3/8/8/8/8/8/8/4K3",
+		},
+	} {
+		for _, factory := range []uci.PieceStorageFactory{
+			boards.NewMapBoard,
+			boards.NewSliceBoard,
+			func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+				return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+			},
+		} {
+			storage, err :=
+				uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, factory)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			move, err := uci.DecodeMove(data.args.move)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			var rules Rules
+			got := rules.ApplyMove(storage, move)
+
+			if uci.EncodePieceStorage(got) != data.want {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestRulesApplyMove_withEnPassant(test *testing.T) {
+	storage, err := classic.DecodePieceStorage(
+		"4k3/5n2/8/3Pp3/8/8/8/4K3 - e6",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	move, err := uci.DecodeMove("d5e6")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if err := atomicRules.CheckMove(storage, move); err != nil {
+		test.Fatal(err)
+	}
+
+	got, ok := atomicRules.ApplyMove(storage, move).(classic.PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+
+	want := "4k3/8/8/8/8/8/8/4K3 - -"
+	if classic.EncodePieceStorage(got, classic.XFEN) != want {
+		test.Fail()
+	}
+}
+
+func TestRulesTermination(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args args
+		want models.Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/4K3",
+				color: common.White,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/4K3",
+				color: common.Black,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "8/8/8/8/8/8/8/4K3",
+				color: common.Black,
+			},
+			want: models.WhiteWin,
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/8",
+				color: common.White,
+			},
+			want: models.BlackWin,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var rules Rules
+		got := rules.Termination(storage, data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withMoveGenerator(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args       args
+		wantMoves  []string
+		wantResult models.Result
+	}
+
+	for _, data := range []data{
+		// adjacent kings can't be checked
+		{
+			args: args{
+				fen:   "8/8/8/8/8/4k3/r3K3/8",
+				color: common.White,
+			},
+			wantMoves: []string{
+				"e2d1",
+				"e2d2",
+				"e2d3",
+				"e2e1",
+				"e2f1",
+				"e2f2",
+				"e2f3",
+			},
+			wantResult: models.Unfinished,
+		},
+		// a capture next to the own king isn't a way out of a check
+		{
+			args: args{
+				fen:   "7k/8/8/8/8/8/R2r4/3K4",
+				color: common.White,
+			},
+			wantMoves: []string{
+				"d1c1",
+				"d1e1",
+			},
+			wantResult: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "8/8/8/8/8/8/8/3RK2r",
+				color: common.Black,
+			},
+			wantMoves:  nil,
+			wantResult: models.WhiteWin,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		moves, err := generator.LegalMovesForColor(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var gotMoves []string
+		for _, move := range moves {
+			gotMoves = append(gotMoves, uci.EncodeMove(move))
+		}
+		sort.Strings(gotMoves)
+
+		gotResult, err := generator.Result(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotResult != data.wantResult {
+			test.Fail()
+		}
+	}
+}
+
+func TestExplosion(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want []common.Piece
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "4k3/8/8/2pbr3/3n4/2P5/8/3RK3",
+				move: "d1d2",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/2pbr3/3n4/2P5/8/3RK3",
+				move: "d1d4",
+			},
+			want: []common.Piece{
+				pieces.NewRook(common.White, common.Position{File: 3, Rank: 3}),
+				pieces.NewKnight(common.Black, common.Position{File: 3, Rank: 3}),
+				pieces.NewBishop(common.Black, common.Position{File: 3, Rank: 4}),
+				pieces.NewRook(common.Black, common.Position{File: 4, Rank: 4}),
+			},
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/1k6/nRK5",
+				move: "b1a1",
+			},
+			want: []common.Piece{
+				pieces.NewRook(common.White, common.Position{File: 0, Rank: 0}),
+				pieces.NewKnight(common.Black, common.Position{File: 0, Rank: 0}),
+				pieces.NewKing(common.Black, common.Position{File: 1, Rank: 1}),
+			},
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := Explosion(storage, move)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestPerft(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		deep  int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	// see the perft results of python-chess (examples/perft/atomic.perft)
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR KQkq -",
+				color: common.White,
+				deep:  2,
+			},
+			want: 400,
+		},
+		{
+			args: args{
+				fen: "rn2kb1r/1pp1p2p/p2q1pp1/3P4/2P3b1/4PN2/PP3PPP/R2QKB1R " +
+					"KQkq -",
+				color: common.Black,
+				deep:  2,
+			},
+			want: 1238,
+		},
+		{
+			args: args{
+				fen:   "rn1qkb1r/p5pp/2p5/3p4/N3P3/5P2/PPP4P/R1BQK3 Qkq -",
+				color: common.White,
+				deep:  2,
+			},
+			want: 833,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewSliceBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: atomicRules}
+		got := models.Perft(generator, storage, data.args.color, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,416 @@
+package variants
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/antichess"
+	"github.com/thewizardplusplus/go-chess-models/variants/atomic"
+	"github.com/thewizardplusplus/go-chess-models/variants/chess960"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+	"github.com/thewizardplusplus/go-chess-models/variants/fourplayer"
+	"github.com/thewizardplusplus/go-chess-models/variants/glinski"
+	"github.com/thewizardplusplus/go-chess-models/variants/kingofthehill"
+	"github.com/thewizardplusplus/go-chess-models/variants/makruk"
+	"github.com/thewizardplusplus/go-chess-models/variants/racingkings"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+	"github.com/thewizardplusplus/go-chess-models/variants/threecheck"
+	"github.com/thewizardplusplus/go-chess-models/variants/xiangqi"
+)
+
+var (
+	orthodoxPromotions = []common.Kind{
+		common.Queen,
+		common.Rook,
+		common.Bishop,
+		common.Knight,
+	}
+	orthodoxFeatures = Features{
+		PawnDoubleStep: true,
+		EnPassant:      true,
+		Castling:       true,
+		Promotions:     orthodoxPromotions,
+	}
+	antichessFeatures = Features{
+		PawnDoubleStep: true,
+		EnPassant:      true,
+		Promotions: []common.Kind{
+			common.King,
+			common.Queen,
+			common.Rook,
+			common.Bishop,
+			common.Knight,
+		},
+	}
+	catalog = []Variant{
+		{
+			Name:        "standard",
+			Description: "orthodox chess",
+			InitialFEN:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			Features:    orthodoxFeatures,
+		},
+		{
+			Name:        "chess960",
+			Description: "Chess960 (Fischer random chess) with castling onto a rook",
+			// it's the standard starting position, see chess960.NewPieceStorage()
+			// for other ones
+			InitialFEN:     "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			Features:       orthodoxFeatures,
+			Rules:          chess960.Rules{},
+			StorageWrapper: wrapClassicStorage,
+		},
+		{
+			Name:        "gardner",
+			Description: "Gardner's minichess on a board 5x5",
+			InitialFEN:  "rnbqk/ppppp/5/PPPPP/RNBQK",
+			Features: Features{
+				Promotions: orthodoxPromotions,
+			},
+		},
+		{
+			Name:        "los-alamos",
+			Description: "Los Alamos chess on a board 6x6 without bishops",
+			InitialFEN:  "rnqknr/pppppp/6/6/PPPPPP/RNQKNR",
+			Features: Features{
+				Promotions: []common.Kind{common.Queen, common.Rook, common.Knight},
+			},
+		},
+		{
+			Name:        "microchess",
+			Description: "Glimne's microchess on a board 4x5",
+			InitialFEN:  "knbr/p3/4/3P/RBNK",
+			// its castling isn't supported, because a king and a rook finish
+			// on other files than in orthodox chess
+			Features: Features{
+				PawnDoubleStep: true,
+				Promotions: []common.Kind{
+					common.Rook,
+					common.Bishop,
+					common.Knight,
+				},
+			},
+		},
+		{
+			Name:        "silverman",
+			Description: "Silverman's minichess on a board 4x5",
+			InitialFEN:  "rqkr/pppp/4/PPPP/RQKR",
+			Features: Features{
+				Promotions: []common.Kind{common.Queen, common.Rook},
+			},
+		},
+		{
+			Name:        "capablanca",
+			Description: "Capablanca chess on a board 10x8",
+			InitialFEN: "rnabqkbcnr/pppppppppp/10/10/10/10/" +
+				"PPPPPPPPPP/RNABQKBCNR",
+			Features: Features{
+				PawnDoubleStep: true,
+				EnPassant:      true,
+				Castling:       true,
+				Promotions: append(
+					append([]common.Kind(nil), orthodoxPromotions...),
+					common.Archbishop,
+					common.Chancellor,
+				),
+			},
+		},
+		{
+			Name:        "gothic",
+			Description: "Gothic chess on a board 10x8",
+			InitialFEN: "rnbqckabnr/pppppppppp/10/10/10/10/" +
+				"PPPPPPPPPP/RNBQCKABNR",
+			Features: Features{
+				PawnDoubleStep: true,
+				EnPassant:      true,
+				Castling:       true,
+				Promotions: append(
+					append([]common.Kind(nil), orthodoxPromotions...),
+					common.Archbishop,
+					common.Chancellor,
+				),
+			},
+		},
+		{
+			Name:        "atomic",
+			Description: "Atomic chess, where captures explode adjacent pieces",
+			InitialFEN:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			Features:    orthodoxFeatures,
+			Rules: atomic.Rules{
+				Rules: orthodoxFeatures.ClassicRules(),
+			},
+			StorageWrapper: wrapClassicStorage,
+		},
+		{
+			Name:        "antichess",
+			Description: "Antichess, where a player who loses all pieces wins",
+			InitialFEN:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			Features:    antichessFeatures,
+			Rules: antichess.Rules{
+				Rules: antichessFeatures.ClassicRules(),
+			},
+			StorageWrapper: wrapClassicStorage,
+		},
+		{
+			Name:        "crazyhouse",
+			Description: "Crazyhouse, where captured pieces can be dropped back",
+			InitialFEN:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			Features:    orthodoxFeatures,
+			Rules: crazyhouse.Rules{
+				Rules: orthodoxFeatures.ClassicRules(),
+			},
+			StorageWrapper: wrapCrazyhouseStorage,
+		},
+		{
+			Name:        "three-check",
+			Description: "Three-check chess, where the third check wins",
+			// it's in the Three-check FEN, see threecheck.DecodePieceStorage()
+			InitialFEN: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR +0+0",
+			Features:   orthodoxFeatures,
+			Rules: threecheck.Rules{
+				Rules: orthodoxFeatures.ClassicRules(),
+			},
+			StorageWrapper:      wrapThreeCheckStorage,
+			PieceStorageDecoder: decodeThreeCheckStorage,
+			PieceStorageEncoder: threecheck.EncodePieceStorage,
+		},
+		{
+			Name:        "king-of-the-hill",
+			Description: "King of the Hill, where a king reaching the center wins",
+			InitialFEN:  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			Features:    orthodoxFeatures,
+			Rules: kingofthehill.Rules{
+				Rules: orthodoxFeatures.ClassicRules(),
+			},
+			StorageWrapper: wrapClassicStorage,
+		},
+		{
+			Name:        "racing-kings",
+			Description: "Racing Kings, where a king reaching the last rank wins",
+			InitialFEN:  racingkings.InitialFEN,
+			Rules:       racingkings.Rules{},
+		},
+		{
+			Name:                "xiangqi",
+			Description:         "Xiangqi (Chinese chess) on a board 9x10",
+			InitialFEN:          xiangqi.InitialFEN,
+			Kinds:               xiangqi.Kinds,
+			Rules:               xiangqi.Rules{},
+			PieceFactoryWrapper: xiangqi.NewPieceFactory,
+			PieceStorageDecoder: xiangqi.DecodePieceStorage,
+			PieceStorageEncoder: xiangqi.EncodePieceStorage,
+		},
+		{
+			Name:                "shogi",
+			Description:         "Shogi (Japanese chess) on a board 9x9",
+			InitialFEN:          shogi.InitialFEN,
+			Kinds:               shogi.Kinds,
+			Rules:               shogi.Rules{},
+			StorageWrapper:      wrapShogiStorage,
+			PieceFactoryWrapper: shogi.NewPieceFactory,
+		},
+		{
+			Name:        "makruk",
+			Description: "Makruk (Thai chess) with the counting rules",
+			InitialFEN:  makruk.InitialFEN,
+			Kinds:       makruk.Kinds,
+			Features: Features{
+				Promotions: []common.Kind{makruk.MetKind},
+			},
+			Rules:               makruk.Rules{},
+			StorageWrapper:      wrapMakrukStorage,
+			PieceFactoryWrapper: makruk.NewPieceFactory,
+			PieceStorageDecoder: makruk.DecodePieceStorage,
+			PieceStorageEncoder: makruk.EncodePieceStorage,
+		},
+		{
+			Name:        "glinski",
+			Description: "Glinski's hexagonal chess on a board of 91 cells",
+			// it uses axial coordinates of cells on a board 11x11,
+			// see glinski.DecodePosition() for Glinski's notation
+			InitialFEN: glinski.InitialFEN,
+			Features: Features{
+				Promotions: orthodoxPromotions,
+			},
+			Rules:               glinski.Rules{},
+			StorageWrapper:      wrapGlinskiStorage,
+			PieceFactoryWrapper: glinski.NewPieceFactory,
+			PieceStorageDecoder: glinski.DecodePieceStorage,
+			PieceStorageEncoder: glinski.EncodePieceStorage,
+		},
+		{
+			Name:           "cylinder",
+			Description:    "cylinder chess, where the files a and h are adjacent",
+			InitialFEN:     "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			Features:       orthodoxFeatures,
+			StorageWrapper: wrapCylinderStorage,
+		},
+		{
+			Name:        "torus",
+			Description: "toroidal chess, where both files and ranks are wrapped",
+			// kings on the orthodox position are adjacent across the edge,
+			// so armies are moved to the ranks 2 and 7
+			InitialFEN: "8/rnbqkbnr/pppppppp/8/8/PPPPPPPP/RNBQKBNR/8",
+			Features: Features{
+				Promotions: orthodoxPromotions,
+			},
+			StorageWrapper: wrapTorusStorage,
+		},
+		{
+			Name:        "four-player",
+			Description: "free-for-all four-player chess on the cross board 14x14",
+			// it's in the four-player FEN, see fourplayer.DecodePieceStorage()
+			InitialFEN: fourplayer.InitialFEN,
+			Colors:     fourplayer.Colors,
+			Features: Features{
+				Promotions: orthodoxPromotions,
+			},
+			Rules:               fourplayer.Rules{},
+			StorageWrapper:      wrapFourPlayerStorage,
+			PieceFactoryWrapper: fourplayer.NewPieceFactory,
+			PieceStorageDecoder: decodeFourPlayerStorage,
+			PieceStorageEncoder: fourplayer.EncodePieceStorage,
+		},
+	}
+)
+
+func wrapClassicStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	rights := classic.InitialCastlingRights(storage)
+	return classic.NewPieceStorage(storage, pieceFactory, rights)
+}
+
+func wrapCrazyhouseStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	storage = wrapClassicStorage(storage, pieceFactory, color)
+
+	reserves := crazyhouse.Reserves{}
+	return crazyhouse.NewPieceStorage(storage, pieceFactory, color, reserves)
+}
+
+func wrapThreeCheckStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	var checks threecheck.Checks
+	if threeCheckStorage, ok := storage.(threecheck.PieceStorage); ok {
+		storage, checks = threeCheckStorage.PieceStorage, threeCheckStorage.Checks()
+	}
+
+	storage = wrapClassicStorage(storage, pieceFactory, color)
+	return threecheck.NewPieceStorage(storage, checks)
+}
+
+func decodeThreeCheckStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	storage, err :=
+		threecheck.DecodePieceStorage(fen, pieceFactory, pieceStorageFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	// its counts of checks are kept by wrapThreeCheckStorage()
+	return storage, nil
+}
+
+func wrapShogiStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	hands := crazyhouse.Reserves{}
+	return shogi.NewPieceStorage(storage, pieceFactory, color, hands)
+}
+
+func wrapMakrukStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	return makruk.NewPieceStorage(storage, pieceFactory, makruk.Counting{})
+}
+
+func wrapGlinskiStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	return glinski.WrapPieceStorage(storage)
+}
+
+func wrapCylinderStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	storage = boards.NewGeometryBoard(storage, common.Cylinder{})
+	return wrapClassicStorage(storage, pieceFactory, color)
+}
+
+func wrapTorusStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	return boards.NewGeometryBoard(storage, common.Torus{})
+}
+
+func wrapFourPlayerStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	return fourplayer.WrapPieceStorage(storage)
+}
+
+func decodeFourPlayerStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	storage, err :=
+		fourplayer.DecodePieceStorage(fen, pieceFactory, pieceStorageFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	// it's wrapped again by wrapFourPlayerStorage()
+	return storage.PieceStorage, nil
+}
+
+// Lookup ...
+func Lookup(name string) (variant Variant, ok bool) {
+	for _, variant := range catalog {
+		if variant.Name == name {
+			// the catalog shouldn't be changed via the returned variant
+			variant.Features.Promotions =
+				append([]common.Kind(nil), variant.Features.Promotions...)
+			variant.Colors = append([]common.Color(nil), variant.Colors...)
+			return variant, true
+		}
+	}
+
+	return Variant{}, false
+}
+
+// Names ...
+//
+// It returns names of all variants in the catalog.
+func Names() []string {
+	names := make([]string, 0, len(catalog))
+	for _, variant := range catalog {
+		names = append(names, variant.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,97 @@
+package variants
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestLookup(test *testing.T) {
+	type args struct {
+		name string
+	}
+	type data struct {
+		args        args
+		wantVariant Variant
+		wantOk      bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"los-alamos"},
+			wantVariant: Variant{
+				Name:        "los-alamos",
+				Description: "Los Alamos chess on a board 6x6 without bishops",
+				InitialFEN:  "rnqknr/pppppp/6/6/PPPPPP/RNQKNR",
+				Features: Features{
+					Promotions: []common.Kind{common.Queen, common.Rook, common.Knight},
+				},
+			},
+			wantOk: true,
+		},
+		{
+			args:        args{"unknown"},
+			wantVariant: Variant{},
+			wantOk:      false,
+		},
+	} {
+		gotVariant, gotOk := Lookup(data.args.name)
+
+		if !reflect.DeepEqual(gotVariant, data.wantVariant) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestLookup_withChange(test *testing.T) {
+	variant, _ := Lookup("standard")
+	variant.Features.Promotions[0] = common.Pawn
+
+	variant, _ = Lookup("standard")
+	if variant.Features.Promotions[0] != common.Queen {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("four-player")
+	variant.Colors[0] = common.White
+
+	variant, _ = Lookup("four-player")
+	if variant.Colors[0] != common.Red {
+		test.Fail()
+	}
+}
+
+func TestNames(test *testing.T) {
+	got := Names()
+
+	want := []string{
+		"standard",
+		"chess960",
+		"gardner",
+		"los-alamos",
+		"microchess",
+		"silverman",
+		"capablanca",
+		"gothic",
+		"atomic",
+		"antichess",
+		"crazyhouse",
+		"three-check",
+		"king-of-the-hill",
+		"racing-kings",
+		"xiangqi",
+		"shogi",
+		"makruk",
+		"glinski",
+		"cylinder",
+		"torus",
+		"four-player",
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
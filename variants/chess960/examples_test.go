@@ -0,0 +1,17 @@
+package chess960_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/chess960"
+)
+
+func ExampleNewPieceStorage() {
+	storage, _ := chess960.NewPieceStorage(0, pieces.NewPiece, boards.NewMapBoard)
+	fmt.Println(uci.EncodePieceStorage(storage))
+
+	// Output: bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/BBQNNRKR
+}
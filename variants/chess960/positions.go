@@ -0,0 +1,125 @@
+// Package chess960 implements starting positions and rules of Fischer Random
+// Chess (Chess960).
+package chess960
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// ...
+const (
+	PositionCount    = 960
+	StandardPosition = 518 // it corresponds to orthodox chess
+)
+
+var (
+	boardSize = common.Size{Width: 8, Height: 8}
+
+	// placements of two knights on five free squares
+	knightPlacements = [10][2]int{
+		{0, 1}, {0, 2}, {0, 3}, {0, 4}, {1, 2},
+		{1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4},
+	}
+)
+
+// BackRank ...
+//
+// It returns kinds of pieces on a back rank (from the a-file to the h-file)
+// by the index of the position in the Scharnagl numbering.
+func BackRank(index int) ([]common.Kind, error) {
+	if index < 0 || index >= PositionCount {
+		return nil, errors.New("incorrect index")
+	}
+
+	backRank := make([]common.Kind, boardSize.Width)
+	isFree := make([]bool, boardSize.Width)
+	for file := range isFree {
+		isFree[file] = true
+	}
+	place := func(file int, kind common.Kind) {
+		backRank[file] = kind
+		isFree[file] = false
+	}
+	// it places the piece on the specified free square (counting from zero)
+	placeOnFree := func(freeIndex int, kind common.Kind) {
+		for file := range isFree {
+			if !isFree[file] {
+				continue
+			}
+
+			if freeIndex == 0 {
+				place(file, kind)
+				return
+			}
+
+			freeIndex--
+		}
+	}
+
+	index, lightBishop := index/4, index%4
+	place(2*lightBishop+1, common.Bishop)
+
+	index, darkBishop := index/4, index%4
+	place(2*darkBishop, common.Bishop)
+
+	index, queen := index/6, index%6
+	placeOnFree(queen, common.Queen)
+
+	// the second knight is placed first, so the index of the first one
+	// is still correct
+	knights := knightPlacements[index]
+	placeOnFree(knights[1], common.Knight)
+	placeOnFree(knights[0], common.Knight)
+
+	for _, kind := range []common.Kind{common.Rook, common.King, common.Rook} {
+		placeOnFree(0, kind)
+	}
+
+	return backRank, nil
+}
+
+// NewPieceStorage ...
+//
+// It creates a starting position by the index of the one
+// in the Scharnagl numbering.
+func NewPieceStorage(
+	index int,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	backRank, err := BackRank(index)
+	if err != nil {
+		return nil, err
+	}
+
+	var pieces []common.Piece
+	for file, kind := range backRank {
+		for _, rank := range []struct {
+			color common.Color
+			index int
+			kind  common.Kind
+		}{
+			{common.White, 0, kind},
+			{common.White, 1, common.Pawn},
+			{common.Black, boardSize.Height - 2, common.Pawn},
+			{common.Black, boardSize.Height - 1, kind},
+		} {
+			position := common.Position{File: file, Rank: rank.index}
+			pieces = append(pieces, pieceFactory(rank.kind, rank.color, position))
+		}
+	}
+
+	storage := pieceStorageFactory(boardSize, pieces)
+	return storage, nil
+}
+
+// RandomIndex ...
+//
+// It returns an index of a random starting position.
+func RandomIndex(random *rand.Rand) int {
+	return random.Intn(PositionCount)
+}
@@ -0,0 +1,162 @@
+package chess960
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestBackRank(test *testing.T) {
+	type args struct {
+		index int
+	}
+	type data struct {
+		args         args
+		wantBackRank string
+		wantErr      bool
+	}
+
+	for _, data := range []data{
+		{
+			args:         args{0},
+			wantBackRank: "BBQNNRKR",
+			wantErr:      false,
+		},
+		{
+			args:         args{StandardPosition},
+			wantBackRank: "RNBQKBNR",
+			wantErr:      false,
+		},
+		{
+			args:         args{959},
+			wantBackRank: "RKRNNQBB",
+			wantErr:      false,
+		},
+		{
+			args:         args{-1},
+			wantBackRank: "",
+			wantErr:      true,
+		},
+		{
+			args:         args{PositionCount},
+			wantBackRank: "",
+			wantErr:      true,
+		},
+	} {
+		backRank, gotErr := BackRank(data.args.index)
+
+		var gotBackRank string
+		for _, kind := range backRank {
+			piece := pieces.NewPiece(kind, common.White, common.Position{})
+			gotBackRank += uci.EncodePiece(piece)
+		}
+		if gotBackRank != data.wantBackRank {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestBackRank_allPositions(test *testing.T) {
+	backRanks := make(map[string]struct{})
+	for index := 0; index < PositionCount; index++ {
+		backRank, err := BackRank(index)
+		if err != nil {
+			test.FailNow()
+		}
+
+		var bishopFileParities, kingFile, rookFiles []int
+		var text string
+		for file, kind := range backRank {
+			switch kind {
+			case common.Bishop:
+				bishopFileParities = append(bishopFileParities, file%2)
+			case common.King:
+				kingFile = append(kingFile, file)
+			case common.Rook:
+				rookFiles = append(rookFiles, file)
+			}
+
+			text += string(rune('0' + kind))
+		}
+
+		if !reflect.DeepEqual(bishopFileParities, []int{0, 1}) &&
+			!reflect.DeepEqual(bishopFileParities, []int{1, 0}) {
+			test.Fail()
+		}
+		if len(kingFile) != 1 || len(rookFiles) != 2 ||
+			kingFile[0] < rookFiles[0] || kingFile[0] > rookFiles[1] {
+			test.Fail()
+		}
+
+		backRanks[text] = struct{}{}
+	}
+
+	if len(backRanks) != PositionCount {
+		test.Fail()
+	}
+}
+
+func TestNewPieceStorage(test *testing.T) {
+	type args struct {
+		index int
+	}
+	type data struct {
+		args    args
+		wantFEN string
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{StandardPosition},
+			wantFEN: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR",
+			wantErr: false,
+		},
+		{
+			args:    args{0},
+			wantFEN: "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/BBQNNRKR",
+			wantErr: false,
+		},
+		{
+			args:    args{PositionCount},
+			wantFEN: "",
+			wantErr: true,
+		},
+	} {
+		storage, gotErr :=
+			NewPieceStorage(data.args.index, pieces.NewPiece, boards.NewMapBoard)
+
+		var gotFEN string
+		if storage != nil {
+			gotFEN = uci.EncodePieceStorage(storage)
+		}
+		if gotFEN != data.wantFEN {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestRandomIndex(test *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		index := RandomIndex(random)
+		if index < 0 || index >= PositionCount {
+			test.Fail()
+		}
+	}
+}
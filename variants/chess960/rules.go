@@ -0,0 +1,49 @@
+package chess960
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+var classicRules = classic.Rules{
+	PawnDoubleStep: true,
+	EnPassant:      true,
+	Castling:       true,
+	Promotions: []common.Kind{
+		common.Queen,
+		common.Rook,
+		common.Bishop,
+		common.Knight,
+	},
+	Chess960Castling: true,
+}
+
+// Rules ...
+//
+// It implements the models.Rules and models.MoveExpander interfaces.
+//
+// These are the orthodox rules with all special moves, where a castling
+// is encoded as a move of a king onto its own rook (as in UCI for Chess960).
+// The special moves are played only on a classic.PieceStorage, which keeps
+// castling rights (see classic.InitialCastlingRights()
+// and classic.DecodePieceStorage()).
+type Rules struct {
+	models.OrthodoxRules
+}
+
+// CheckMove ...
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	return classicRules.CheckMove(storage, move)
+}
+
+// ExpandMove ...
+func (rules Rules) ExpandMove(
+	storage common.PieceStorage,
+	move common.Move,
+) []common.Move {
+	return classicRules.ExpandMove(storage, move)
+}
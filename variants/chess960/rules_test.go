@@ -0,0 +1,152 @@
+package chess960
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+func TestRulesCheckMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/8/1R2K1R1 GB -",
+				move: "e1g1",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/8/1R2K1R1 GB -",
+				move: "e1b1",
+			},
+			want: nil,
+		},
+		{
+			// the king finish is encoded instead of the rook position
+			args: args{
+				fen:  "4k3/8/8/8/8/8/8/1R2K1R1 GB -",
+				move: "e1c1",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/8/1R2K1R1 B -",
+				move: "e1g1",
+			},
+			want: common.ErrFriendlyTarget,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := Rules{}.CheckMove(storage, move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPerft(test *testing.T) {
+	type args struct {
+		fen  string
+		deep int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	// the positions and the counts are from the published perft results
+	// of Chess960
+	for _, data := range []data{
+		{
+			args: args{
+				fen: "bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR " +
+					"HFhf -",
+				deep: 2,
+			},
+			want: 528,
+		},
+		{
+			args: args{
+				fen:  "2nnrbkr/p1qppppp/8/1ppb4/6PP/3PP3/PPP2P2/BQNNRBKR HEhe -",
+				deep: 2,
+			},
+			want: 807,
+		},
+		{
+			args: args{
+				fen:  "b1q1rrkb/pppppppp/3nn3/8/P7/1PPP4/4PPPP/BQNNRKRB GE -",
+				deep: 2,
+			},
+			want: 479,
+		},
+		{
+			args: args{
+				fen:  "qbbnnrkr/2pp2pp/p7/1p2pp2/8/P3PP2/1PPP1KPP/QBBNNR1R hf -",
+				deep: 2,
+			},
+			want: 593,
+		},
+		{
+			args: args{
+				fen: "1nbbnrkr/p1p1ppp1/3p4/1p3P1p/3Pq2P/8/PPP1P1P1/QNBBNRKR " +
+					"HFhf -",
+				deep: 2,
+			},
+			want: 1120,
+		},
+		{
+			args: args{
+				fen: "qnbnr1kr/ppp1b1pp/4p3/3p1p2/8/2NPP3/PPP1BPPP/QNB1R1KR " +
+					"HEhe -",
+				deep: 2,
+			},
+			want: 899,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewSliceBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		got := models.Perft(generator, storage, common.White, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
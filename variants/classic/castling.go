@@ -0,0 +1,304 @@
+package classic
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// CastlingRight ...
+//
+// It's identified by a position of a castling rook.
+type CastlingRight struct {
+	Color common.Color
+	Rook  common.Position
+}
+
+// CastlingRights ...
+type CastlingRights []CastlingRight
+
+// InitialCastlingRights ...
+//
+// It returns rights for the outermost rooks on both sides of each king
+// that stands on the first rank of its color. It assumes that these kings
+// and rooks haven't moved yet.
+func InitialCastlingRights(storage common.PieceStorage) CastlingRights {
+	var rights CastlingRights
+	for _, color := range []common.Color{common.White, common.Black} {
+		king, ok := findKing(storage, color)
+		if !ok || king.Position().Rank != firstRank(storage.Size(), color) {
+			continue
+		}
+
+		for _, direction := range []int{1, -1} {
+			if rook, ok := outermostRook(storage, king, direction); ok {
+				rights = append(rights, CastlingRight{Color: color, Rook: rook})
+			}
+		}
+	}
+
+	return rights
+}
+
+// Has ...
+func (rights CastlingRights) Has(right CastlingRight) bool {
+	for _, anotherRight := range rights {
+		if anotherRight == right {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyMove ...
+//
+// It returns rights after the move: a king move cancels all rights
+// of its color, a move from or to a position of a castling rook cancels
+// the corresponding right.
+//
+// The storage should be a state before the move.
+func (rights CastlingRights) ApplyMove(
+	storage common.PieceStorage,
+	move common.Move,
+) CastlingRights {
+	piece, ok := storage.Piece(move.Start)
+	isKingMove := ok && piece.Kind() == common.King
+
+	var nextRights CastlingRights
+	for _, right := range rights {
+		if isKingMove && right.Color == piece.Color() ||
+			right.Rook == move.Start ||
+			right.Rook == move.Finish {
+			continue
+		}
+
+		nextRights = append(nextRights, right)
+	}
+
+	return nextRights
+}
+
+// IsCastling ...
+//
+// It checks that the move is a castling encoded as a move of a king
+// onto its own rook (as in UCI for Chess960).
+func IsCastling(storage common.PieceStorage, move common.Move) bool {
+	king, ok := storage.Piece(move.Start)
+	if !ok || king.Kind() != common.King {
+		return false
+	}
+
+	rook, ok := storage.Piece(move.Finish)
+	return ok && rook.Kind() == common.Rook &&
+		rook.Color() == king.Color() &&
+		rook.Position().Rank == king.Position().Rank
+}
+
+// CastlingMoves ...
+//
+// It returns castling moves of the color permitted by the rights
+// and by the position. They are encoded as moves of a king onto its own rook
+// (see IsCastling()).
+//
+// A king and a rook go to the same squares as in orthodox chess
+// (i.e. the g-file and the f-file for a castling to the right,
+// and the c-file and the d-file for a castling to the left; these files are
+// counted from a board edge for other board widths).
+//
+// A castling is permitted if all squares between start and finish positions
+// of the king and the rook are free (except these pieces) and the king
+// isn't attacked on any square of its path (including start and finish ones).
+func CastlingMoves(
+	storage common.PieceStorage,
+	color common.Color,
+	rights CastlingRights,
+) []common.Move {
+	king, ok := findKing(storage, color)
+	if !ok {
+		return nil
+	}
+
+	var moves []common.Move
+	for _, right := range rights {
+		move := common.Move{Start: king.Position(), Finish: right.Rook}
+		if right.Color != color || !IsCastling(storage, move) {
+			continue
+		}
+
+		if isCastlingPermitted(storage, king, move) {
+			moves = append(moves, move)
+		}
+	}
+
+	return moves
+}
+
+// ApplyCastling ...
+//
+// It applies the move to the storage, processing a castling encoded
+// as a move of a king onto its own rook (see IsCastling()).
+//
+// It doesn't check that the move is correct.
+func ApplyCastling(
+	storage common.PieceStorage,
+	move common.Move,
+) common.PieceStorage {
+	if !IsCastling(storage, move) {
+		return storage.ApplyMove(move)
+	}
+
+	king, _ := storage.Piece(move.Start)
+	rook, _ := storage.Piece(move.Finish)
+	kingFinish, rookFinish := castlingFinishes(storage.Size(), move)
+	return storage.
+		RemovePiece(move.Start).
+		RemovePiece(move.Finish).
+		SetPiece(king.ApplyPosition(kingFinish)).
+		SetPiece(rook.ApplyPosition(rookFinish))
+}
+
+// it searches a castling permitted by the rights that corresponds to the move
+// in any notation (see the Rules.Chess960Castling field) and returns
+// the castling encoded as a move of a king onto its own rook
+func findCastling(
+	storage common.PieceStorage,
+	rights CastlingRights,
+	move common.Move,
+	isKingOntoRook bool,
+) (castling common.Move, ok bool) {
+	king, ok := storage.Piece(move.Start)
+	if !ok || king.Kind() != common.King {
+		return common.Move{}, false
+	}
+
+	for _, right := range rights {
+		castling := common.Move{Start: move.Start, Finish: right.Rook}
+		if right.Color != king.Color() || !IsCastling(storage, castling) {
+			continue
+		}
+
+		if isKingOntoRook {
+			if move.Finish == right.Rook {
+				return castling, true
+			}
+
+			continue
+		}
+
+		// a king move to an adjacent position is an ordinary one
+		kingFinish, _ := castlingFinishes(storage.Size(), castling)
+		if move.Finish == kingFinish &&
+			absInt(kingFinish.File-move.Start.File) > 1 {
+			return castling, true
+		}
+	}
+
+	return common.Move{}, false
+}
+
+func findKing(
+	storage common.PieceStorage,
+	color common.Color,
+) (king common.Piece, ok bool) {
+	for _, piece := range storage.Pieces() {
+		if piece.Kind() == common.King && piece.Color() == color {
+			return piece, true
+		}
+	}
+
+	return nil, false
+}
+
+func castlingFinishes(
+	size common.Size,
+	move common.Move,
+) (kingFinish common.Position, rookFinish common.Position) {
+	rank := move.Start.Rank
+	if move.Finish.File > move.Start.File {
+		kingFinish = common.Position{File: size.Width - 2, Rank: rank}
+		rookFinish = common.Position{File: size.Width - 3, Rank: rank}
+	} else {
+		kingFinish = common.Position{File: 2, Rank: rank}
+		rookFinish = common.Position{File: 3, Rank: rank}
+	}
+
+	return kingFinish, rookFinish
+}
+
+func isCastlingPermitted(
+	storage common.PieceStorage,
+	king common.Piece,
+	move common.Move,
+) bool {
+	kingFinish, rookFinish := castlingFinishes(storage.Size(), move)
+	if !common.HasPosition(storage, kingFinish) ||
+		!common.HasPosition(storage, rookFinish) {
+		return false
+	}
+
+	// the castling rook is removed too, because it can hide an attack
+	// on the king finish
+	restStorage := storage.RemovePiece(move.Start).RemovePiece(move.Finish)
+
+	files := []int{
+		move.Start.File,
+		move.Finish.File,
+		kingFinish.File,
+		rookFinish.File,
+	}
+	for file := minInt(files...); file <= maxInt(files...); file++ {
+		position := common.Position{File: file, Rank: move.Start.Rank}
+		if _, ok := restStorage.Piece(position); ok {
+			return false
+		}
+	}
+
+	kingFiles := []int{move.Start.File, kingFinish.File}
+	for file := minInt(kingFiles...); file <= maxInt(kingFiles...); file++ {
+		position := common.Position{File: file, Rank: move.Start.Rank}
+		nextStorage := restStorage.SetPiece(king.ApplyPosition(position))
+
+		if common.IsCheck(nextStorage, king.Color().Negative()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func firstRank(size common.Size, color common.Color) int {
+	if color == common.Black {
+		return size.Height - 1
+	}
+
+	return 0
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+
+	return result
+}
+
+func maxInt(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value > result {
+			result = value
+		}
+	}
+
+	return result
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
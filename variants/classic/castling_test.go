@@ -0,0 +1,362 @@
+package classic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestCastlingRightsHas(test *testing.T) {
+	rights := CastlingRights{
+		{Color: common.White, Rook: common.Position{File: 7, Rank: 0}},
+		{Color: common.Black, Rook: common.Position{File: 0, Rank: 7}},
+	}
+
+	blackRight := CastlingRight{
+		Color: common.Black,
+		Rook:  common.Position{File: 0, Rank: 7},
+	}
+	if !rights.Has(blackRight) {
+		test.Fail()
+	}
+
+	whiteRight := CastlingRight{
+		Color: common.White,
+		Rook:  common.Position{File: 0, Rank: 0},
+	}
+	if rights.Has(whiteRight) {
+		test.Fail()
+	}
+}
+
+func TestCastlingRightsApplyMove(test *testing.T) {
+	type args struct {
+		move string
+	}
+	type data struct {
+		args       args
+		wantRights CastlingRights
+	}
+
+	rights := CastlingRights{
+		{Color: common.White, Rook: common.Position{File: 7, Rank: 0}},
+		{Color: common.White, Rook: common.Position{File: 0, Rank: 0}},
+		{Color: common.Black, Rook: common.Position{File: 7, Rank: 7}},
+		{Color: common.Black, Rook: common.Position{File: 0, Rank: 7}},
+	}
+	for _, data := range []data{
+		{
+			args:       args{"b1c3"},
+			wantRights: rights,
+		},
+		{
+			args:       args{"e1f1"},
+			wantRights: rights[2:],
+		},
+		{
+			args:       args{"h1h8"},
+			wantRights: CastlingRights{rights[1], rights[3]},
+		},
+		{
+			args:       args{"a8a1"},
+			wantRights: CastlingRights{rights[0], rights[2]},
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			"r3k2r/8/8/8/8/8/8/RN2K2R",
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.FailNow()
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.FailNow()
+		}
+
+		gotRights := rights.ApplyMove(storage, move)
+
+		if !reflect.DeepEqual(gotRights, data.wantRights) {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsCastling(test *testing.T) {
+	type args struct {
+		move string
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"e1h1"},
+			want: true,
+		},
+		{
+			args: args{"e1f1"},
+			want: false,
+		},
+		{
+			args: args{"e1e8"},
+			want: false,
+		},
+		{
+			args: args{"a1e1"},
+			want: false,
+		},
+		{
+			args: args{"e1a8"},
+			want: false,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			"r3k2r/8/8/8/8/8/8/R3K2R",
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.FailNow()
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.FailNow()
+		}
+
+		got := IsCastling(storage, move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestCastlingMoves(test *testing.T) {
+	type args struct {
+		boardInFEN string
+		color      common.Color
+		rights     CastlingRights
+	}
+	type data struct {
+		args      args
+		wantMoves []string
+	}
+
+	whiteRights := CastlingRights{
+		{Color: common.White, Rook: common.Position{File: 7, Rank: 0}},
+		{Color: common.White, Rook: common.Position{File: 0, Rank: 0}},
+	}
+	for _, data := range []data{
+		{
+			args: args{
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				color:      common.White,
+				rights:     whiteRights,
+			},
+			wantMoves: []string{"e1h1", "e1a1"},
+		},
+		{
+			args: args{
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				color:      common.Black,
+				rights:     whiteRights,
+			},
+			wantMoves: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				color:      common.White,
+				rights:     whiteRights[1:],
+			},
+			wantMoves: []string{"e1a1"},
+		},
+		{
+			args: args{
+				boardInFEN: "8/8/8/8/8/8/8/RN2K1NR",
+				color:      common.White,
+				rights:     whiteRights,
+			},
+			wantMoves: nil,
+		},
+		{
+			// the f1 square is attacked
+			args: args{
+				boardInFEN: "5r2/8/8/8/8/8/8/R3K2R",
+				color:      common.White,
+				rights:     whiteRights,
+			},
+			wantMoves: []string{"e1a1"},
+		},
+		{
+			// only the rook passes through the attacked b1 square
+			args: args{
+				boardInFEN: "1r6/8/8/8/8/8/8/R3K2R",
+				color:      common.White,
+				rights:     whiteRights,
+			},
+			wantMoves: []string{"e1h1", "e1a1"},
+		},
+		{
+			// the king is in check
+			args: args{
+				boardInFEN: "4r3/8/8/8/8/8/8/R3K2R",
+				color:      common.White,
+				rights:     whiteRights,
+			},
+			wantMoves: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "8/8/8/8/8/8/8/RK6",
+				color:      common.White,
+				rights: CastlingRights{
+					{Color: common.White, Rook: common.Position{File: 0, Rank: 0}},
+				},
+			},
+			wantMoves: []string{"b1a1"},
+		},
+		{
+			args: args{
+				boardInFEN: "8/8/8/8/8/8/8/5KR1",
+				color:      common.White,
+				rights: CastlingRights{
+					{Color: common.White, Rook: common.Position{File: 6, Rank: 0}},
+				},
+			},
+			wantMoves: []string{"f1g1"},
+		},
+		{
+			// the castling rook hides an attack on the king finish
+			args: args{
+				boardInFEN: "8/8/8/8/8/8/8/rRK5",
+				color:      common.White,
+				rights: CastlingRights{
+					{Color: common.White, Rook: common.Position{File: 1, Rank: 0}},
+				},
+			},
+			wantMoves: nil,
+		},
+		{
+			args: args{
+				boardInFEN: "8/8/8/8/8/8/8/R6R",
+				color:      common.White,
+				rights:     whiteRights,
+			},
+			wantMoves: nil,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.FailNow()
+		}
+
+		var gotMoves []string
+		moves := CastlingMoves(storage, data.args.color, data.args.rights)
+		for _, move := range moves {
+			gotMoves = append(gotMoves, uci.EncodeMove(move))
+		}
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+	}
+}
+
+func TestApplyCastling(test *testing.T) {
+	type args struct {
+		boardInFEN string
+		move       string
+	}
+	type data struct {
+		args    args
+		wantFEN string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				move:       "e1h1",
+			},
+			wantFEN: "r3k2r/8/8/8/8/8/8/R4RK1",
+		},
+		{
+			args: args{
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				move:       "e8a8",
+			},
+			wantFEN: "2kr3r/8/8/8/8/8/8/R3K2R",
+		},
+		{
+			args: args{
+				boardInFEN: "8/8/8/8/8/8/8/RK6",
+				move:       "b1a1",
+			},
+			wantFEN: "8/8/8/8/8/8/8/2KR4",
+		},
+		{
+			args: args{
+				boardInFEN: "8/8/8/8/8/8/8/5KR1",
+				move:       "f1g1",
+			},
+			wantFEN: "8/8/8/8/8/8/8/5RK1",
+		},
+		{
+			args: args{
+				boardInFEN: "8/8/8/8/8/8/8/6KR",
+				move:       "g1h1",
+			},
+			wantFEN: "8/8/8/8/8/8/8/5RK1",
+		},
+		{
+			args: args{
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				move:       "e1f1",
+			},
+			wantFEN: "r3k2r/8/8/8/8/8/8/R4K1R",
+		},
+	} {
+		for _, pieceStorageFactory := range []uci.PieceStorageFactory{
+			boards.NewMapBoard,
+			boards.NewSliceBoard,
+			func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+				return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+			},
+		} {
+			storage, err := uci.DecodePieceStorage(
+				data.args.boardInFEN,
+				pieces.NewPiece,
+				pieceStorageFactory,
+			)
+			if err != nil {
+				test.FailNow()
+			}
+
+			move, err := uci.DecodeMove(data.args.move)
+			if err != nil {
+				test.FailNow()
+			}
+
+			gotStorage := ApplyCastling(storage, move)
+
+			if uci.EncodePieceStorage(gotStorage) != data.wantFEN {
+				test.Fail()
+			}
+		}
+	}
+}
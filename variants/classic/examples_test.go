@@ -0,0 +1,37 @@
+package classic_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+func ExampleCastlingMoves() {
+	storage, _ := uci.DecodePieceStorage(
+		"r3k2r/8/8/8/8/8/8/1R2K1R1",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	rights, _ := classic.DecodeCastlingRights("GBkq", storage)
+
+	moves := classic.CastlingMoves(storage, common.White, rights)
+	for _, move := range moves {
+		nextStorage := classic.ApplyCastling(storage, move)
+		nextRights := rights.ApplyMove(storage, move)
+
+		fmt.Printf(
+			"%s: %s %s\n",
+			uci.EncodeMove(move),
+			uci.EncodePieceStorage(nextStorage),
+			classic.EncodeCastlingRights(nextRights, nextStorage, classic.XFEN),
+		)
+	}
+
+	// Output:
+	// e1g1: r3k2r/8/8/8/8/8/8/1R3RK1 kq
+	// e1b1: r3k2r/8/8/8/8/8/8/2KR2R1 kq
+}
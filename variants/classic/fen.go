@@ -0,0 +1,250 @@
+package classic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+const (
+	minFileSymbol = 'a'
+	noneSymbol    = "-"
+)
+
+// CastlingNotation ...
+type CastlingNotation int
+
+// ...
+const (
+	// it uses K and Q for outermost rooks and file letters for other ones
+	XFEN CastlingNotation = iota
+	// it uses file letters only
+	ShredderFEN
+)
+
+// DecodeCastlingRights ...
+//
+// It decodes the castling field of FEN in X-FEN or Shredder-FEN.
+// The storage is used to find castling rooks; they should stay
+// on the same rank as a king of their color.
+//
+// If a board is wider than ten files, K and Q have a priority over file
+// letters.
+func DecodeCastlingRights(
+	text string,
+	storage common.PieceStorage,
+) (CastlingRights, error) {
+	if text == "" {
+		return nil, errors.New("empty text")
+	}
+	if text == noneSymbol {
+		return nil, nil
+	}
+
+	var rights CastlingRights
+	for _, symbol := range text {
+		color := common.Black
+		if unicode.IsUpper(symbol) {
+			color = common.White
+		}
+
+		king, ok := findKing(storage, color)
+		if !ok {
+			return nil, fmt.Errorf("no king for the castling %q", symbol)
+		}
+
+		var rook common.Position
+		switch unicode.ToLower(symbol) {
+		case 'k':
+			rook, ok = outermostRook(storage, king, 1)
+		case 'q':
+			rook, ok = outermostRook(storage, king, -1)
+		default:
+			file := int(unicode.ToLower(symbol) - minFileSymbol)
+			rook = common.Position{File: file, Rank: king.Position().Rank}
+			ok = file >= 0 && file < storage.Size().Width && isRook(storage, rook, color)
+		}
+		if !ok {
+			return nil, fmt.Errorf("no rook for the castling %q", symbol)
+		}
+
+		right := CastlingRight{Color: color, Rook: rook}
+		if rights.Has(right) {
+			return nil, fmt.Errorf("duplicate castling %q", symbol)
+		}
+
+		rights = append(rights, right)
+	}
+
+	return rights, nil
+}
+
+// EncodeCastlingRights ...
+//
+// It encodes the castling field of FEN in the specified notation. Rights are
+// ordered by colors (white first) and then from the right to the left.
+func EncodeCastlingRights(
+	rights CastlingRights,
+	storage common.PieceStorage,
+	notation CastlingNotation,
+) string {
+	if len(rights) == 0 {
+		return noneSymbol
+	}
+
+	sortedRights := make(CastlingRights, len(rights))
+	copy(sortedRights, rights)
+	sort.Slice(sortedRights, func(i int, j int) bool {
+		a, b := sortedRights[i], sortedRights[j]
+		if a.Color != b.Color {
+			return a.Color == common.White
+		}
+
+		return a.Rook.File > b.Rook.File
+	})
+
+	var text strings.Builder
+	for _, right := range sortedRights {
+		symbol := rune(right.Rook.File + minFileSymbol)
+		if king, ok := findKing(storage, right.Color); ok && notation == XFEN {
+			direction := 1
+			if right.Rook.File < king.Position().File {
+				direction = -1
+			}
+
+			rook, ok := outermostRook(storage, king, direction)
+			if ok && rook == right.Rook {
+				symbol = 'k'
+				if direction < 0 {
+					symbol = 'q'
+				}
+			}
+		}
+		if right.Color == common.White {
+			symbol = unicode.ToUpper(symbol)
+		}
+
+		text.WriteRune(symbol)
+	}
+
+	return text.String()
+}
+
+// DecodeEnPassant ...
+//
+// It decodes the en passant field of FEN: a position skipped by a pawn
+// double step or "-" if there is no one.
+func DecodeEnPassant(text string) (target common.Position, ok bool, err error) {
+	if text == noneSymbol {
+		return common.Position{}, false, nil
+	}
+
+	target, err = uci.DecodePosition(text)
+	if err != nil {
+		return common.Position{}, false, err
+	}
+
+	return target, true, nil
+}
+
+// EncodeEnPassant ...
+//
+// It encodes the en passant field of FEN, see DecodeEnPassant().
+func EncodeEnPassant(target common.Position, ok bool) string {
+	if !ok {
+		return noneSymbol
+	}
+
+	return uci.EncodePosition(target)
+}
+
+// DecodePieceStorage ...
+//
+// It decodes a piece placement of FEN followed by the castling field
+// (in X-FEN or Shredder-FEN) and the en passant field separated by spaces
+// (e.g. "r3k2r/8/8/8/4Pp2/8/8/R3K2R KQkq e3").
+func DecodePieceStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (PieceStorage, error) {
+	fields := strings.Fields(fen)
+	if len(fields) != 3 {
+		return PieceStorage{}, errors.New("incorrect field count")
+	}
+
+	storage, err :=
+		uci.DecodePieceStorage(fields[0], pieceFactory, pieceStorageFactory)
+	if err != nil {
+		return PieceStorage{}, fmt.Errorf("unable to decode the board: %s", err)
+	}
+
+	rights, err := DecodeCastlingRights(fields[1], storage)
+	if err != nil {
+		return PieceStorage{}, fmt.Errorf("unable to decode castling: %s", err)
+	}
+
+	target, ok, err := DecodeEnPassant(fields[2])
+	if err != nil {
+		return PieceStorage{}, fmt.Errorf("unable to decode en passant: %s", err)
+	}
+
+	classicStorage := NewPieceStorage(storage, pieceFactory, rights)
+	if ok {
+		classicStorage = classicStorage.ApplyEnPassant(target)
+	}
+
+	return classicStorage, nil
+}
+
+// EncodePieceStorage ...
+//
+// It encodes the piece storage in the form of DecodePieceStorage().
+func EncodePieceStorage(
+	storage PieceStorage,
+	notation CastlingNotation,
+) string {
+	target, ok := storage.EnPassant()
+	return strings.Join([]string{
+		uci.EncodePieceStorage(storage),
+		EncodeCastlingRights(storage.CastlingRights(), storage, notation),
+		EncodeEnPassant(target, ok),
+	}, " ")
+}
+
+// it searches a rook of the king color on the king rank; the search goes
+// from the board edge in the specified direction towards the king
+func outermostRook(
+	storage common.PieceStorage,
+	king common.Piece,
+	direction int,
+) (rook common.Position, ok bool) {
+	kingPosition := king.Position()
+	file := storage.Size().Width - 1
+	if direction < 0 {
+		file = 0
+	}
+
+	for ; file != kingPosition.File; file -= direction {
+		position := common.Position{File: file, Rank: kingPosition.Rank}
+		if isRook(storage, position, king.Color()) {
+			return position, true
+		}
+	}
+
+	return common.Position{}, false
+}
+
+func isRook(
+	storage common.PieceStorage,
+	position common.Position,
+	color common.Color,
+) bool {
+	piece, ok := storage.Piece(position)
+	return ok && piece.Kind() == common.Rook && piece.Color() == color
+}
@@ -0,0 +1,333 @@
+package classic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestDecodeCastlingRights(test *testing.T) {
+	type args struct {
+		text       string
+		boardInFEN string
+	}
+	type data struct {
+		args       args
+		wantRights CastlingRights
+		wantErr    bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				text:       "KQkq",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+			},
+			wantRights: CastlingRights{
+				{Color: common.White, Rook: common.Position{File: 7, Rank: 0}},
+				{Color: common.White, Rook: common.Position{File: 0, Rank: 0}},
+				{Color: common.Black, Rook: common.Position{File: 7, Rank: 7}},
+				{Color: common.Black, Rook: common.Position{File: 0, Rank: 7}},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "HAha",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+			},
+			wantRights: CastlingRights{
+				{Color: common.White, Rook: common.Position{File: 7, Rank: 0}},
+				{Color: common.White, Rook: common.Position{File: 0, Rank: 0}},
+				{Color: common.Black, Rook: common.Position{File: 7, Rank: 7}},
+				{Color: common.Black, Rook: common.Position{File: 0, Rank: 7}},
+			},
+			wantErr: false,
+		},
+		{
+			// X-FEN with an inner rook
+			args: args{
+				text:       "KFq",
+				boardInFEN: "rk6/8/8/8/8/8/8/1R1K1R1R",
+			},
+			wantRights: CastlingRights{
+				{Color: common.White, Rook: common.Position{File: 7, Rank: 0}},
+				{Color: common.White, Rook: common.Position{File: 5, Rank: 0}},
+				{Color: common.Black, Rook: common.Position{File: 0, Rank: 7}},
+			},
+			wantErr: false,
+		},
+		{
+			args: args{
+				text:       "-",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+			},
+			wantRights: nil,
+			wantErr:    false,
+		},
+		{
+			args: args{
+				text:       "",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+			},
+			wantRights: nil,
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text:       "K",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R6R",
+			},
+			wantRights: nil,
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text:       "K",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K3",
+			},
+			wantRights: nil,
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text:       "B",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+			},
+			wantRights: nil,
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text:       "KH",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+			},
+			wantRights: nil,
+			wantErr:    true,
+		},
+		{
+			args: args{
+				text:       "#",
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+			},
+			wantRights: nil,
+			wantErr:    true,
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.FailNow()
+		}
+
+		gotRights, gotErr := DecodeCastlingRights(data.args.text, storage)
+
+		if !reflect.DeepEqual(gotRights, data.wantRights) {
+			test.Fail()
+		}
+
+		hasErr := gotErr != nil
+		if hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeCastlingRights(test *testing.T) {
+	type args struct {
+		rights     CastlingRights
+		boardInFEN string
+		notation   CastlingNotation
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	allRights := CastlingRights{
+		{Color: common.Black, Rook: common.Position{File: 0, Rank: 7}},
+		{Color: common.White, Rook: common.Position{File: 0, Rank: 0}},
+		{Color: common.Black, Rook: common.Position{File: 7, Rank: 7}},
+		{Color: common.White, Rook: common.Position{File: 7, Rank: 0}},
+	}
+	for _, data := range []data{
+		{
+			args: args{
+				rights:     allRights,
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				notation:   XFEN,
+			},
+			want: "KQkq",
+		},
+		{
+			args: args{
+				rights:     allRights,
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				notation:   ShredderFEN,
+			},
+			want: "HAha",
+		},
+		{
+			args: args{
+				rights: CastlingRights{
+					{Color: common.White, Rook: common.Position{File: 5, Rank: 0}},
+					{Color: common.White, Rook: common.Position{File: 7, Rank: 0}},
+					{Color: common.Black, Rook: common.Position{File: 0, Rank: 7}},
+				},
+				boardInFEN: "rk6/8/8/8/8/8/8/1R1K1R1R",
+				notation:   XFEN,
+			},
+			want: "KFq",
+		},
+		{
+			args: args{
+				rights:     nil,
+				boardInFEN: "r3k2r/8/8/8/8/8/8/R3K2R",
+				notation:   XFEN,
+			},
+			want: "-",
+		},
+	} {
+		storage, err := uci.DecodePieceStorage(
+			data.args.boardInFEN,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.FailNow()
+		}
+
+		got := EncodeCastlingRights(data.args.rights, storage, data.args.notation)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodeEnPassant(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args       args
+		wantTarget common.Position
+		wantOk     bool
+		wantErr    bool
+	}
+
+	for _, data := range []data{
+		{
+			args:       args{"e3"},
+			wantTarget: common.Position{File: 4, Rank: 2},
+			wantOk:     true,
+			wantErr:    false,
+		},
+		{
+			args:       args{"-"},
+			wantTarget: common.Position{},
+			wantOk:     false,
+			wantErr:    false,
+		},
+		{
+			args:       args{"e"},
+			wantTarget: common.Position{},
+			wantOk:     false,
+			wantErr:    true,
+		},
+	} {
+		gotTarget, gotOk, gotErr := DecodeEnPassant(data.args.text)
+
+		if !reflect.DeepEqual(gotTarget, data.wantTarget) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		fen string
+	}
+	type data struct {
+		args    args
+		wantFEN string
+		wantErr bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{"r3k2r/8/8/8/4Pp2/8/8/R3K2R KQkq e3"},
+			wantFEN: "r3k2r/8/8/8/4Pp2/8/8/R3K2R KQkq e3",
+			wantErr: false,
+		},
+		{
+			args:    args{"r3k2r/8/8/8/8/8/8/R3K2R HAh -"},
+			wantFEN: "r3k2r/8/8/8/8/8/8/R3K2R KQk -",
+			wantErr: false,
+		},
+		{
+			args:    args{"r3k2r/8/8/8/8/8/8/R3K2R KQkq"},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{"r3k2r/8/8/8/8/8/8/R3K2x KQkq -"},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{"r3k2r/8/8/8/8/8/8/4K3 KQkq -"},
+			wantFEN: "",
+			wantErr: true,
+		},
+		{
+			args:    args{"r3k2r/8/8/8/8/8/8/R3K2R KQkq e"},
+			wantFEN: "",
+			wantErr: true,
+		},
+	} {
+		storage, gotErr :=
+			DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+
+		if data.wantFEN != "" && EncodePieceStorage(storage, XFEN) != data.wantFEN {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePieceStorage(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"r3k2r/8/8/8/8/8/8/R3K2R",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	rights := InitialCastlingRights(storage)
+	classicStorage := NewPieceStorage(storage, pieces.NewPiece, rights).
+		ApplyEnPassant(common.Position{File: 3, Rank: 5})
+	got := EncodePieceStorage(classicStorage, ShredderFEN)
+
+	want := "r3k2r/8/8/8/8/8/8/R3K2R HAha d6"
+	if got != want {
+		test.Fail()
+	}
+}
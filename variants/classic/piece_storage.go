@@ -0,0 +1,236 @@
+package classic
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Wrapper ...
+//
+// It's an optional interface of a piece storage that wraps a PieceStorage
+// to keep a variant-specific state (e.g. see crazyhouse.PieceStorage).
+// Without it, the wrapper hides the special moves from Rules.
+type Wrapper interface {
+	ClassicStorage() (storage PieceStorage, ok bool)
+}
+
+// Unwrap ...
+//
+// It returns the piece storage itself if it's a PieceStorage and a wrapped
+// one if the piece storage implements the Wrapper interface.
+func Unwrap(
+	storage common.PieceStorage,
+) (classicStorage PieceStorage, ok bool) {
+	switch storage := storage.(type) {
+	case PieceStorage:
+		return storage, true
+	case Wrapper:
+		return storage.ClassicStorage()
+	}
+
+	return PieceStorage{}, false
+}
+
+// CapturedPosition ...
+//
+// It returns a position of a piece captured by the move: a position of a pawn
+// captured en passant or the finish of the move otherwise.
+//
+// It doesn't check that the move is correct.
+func CapturedPosition(
+	storage common.PieceStorage,
+	move common.Move,
+) common.Position {
+	if classicStorage, ok := Unwrap(storage); ok &&
+		classicStorage.isEnPassant(move) {
+		return enPassantCapture(move)
+	}
+
+	return move.Finish
+}
+
+// PieceStorage ...
+//
+// It wraps a piece storage and keeps castling rights and an en passant
+// target, i.e. a position skipped by a pawn double step on the last move.
+//
+// It doesn't forward the common.SparsenessChecker interface, because castling
+// and a pawn double step go beyond a reach of pieces.
+type PieceStorage struct {
+	common.PieceStorage
+
+	pieceFactory   common.PieceFactory
+	castlingRights CastlingRights
+	enPassant      common.Position
+	hasEnPassant   bool
+}
+
+// NewPieceStorage ...
+//
+// The piece factory is used for promotions.
+func NewPieceStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	castlingRights CastlingRights,
+) PieceStorage {
+	return PieceStorage{
+		PieceStorage: storage,
+
+		pieceFactory:   pieceFactory,
+		castlingRights: castlingRights,
+	}
+}
+
+// CastlingRights ...
+func (storage PieceStorage) CastlingRights() CastlingRights {
+	return storage.castlingRights
+}
+
+// EnPassant ...
+//
+// It returns an en passant target, if any.
+func (storage PieceStorage) EnPassant() (target common.Position, ok bool) {
+	return storage.enPassant, storage.hasEnPassant
+}
+
+// ApplyEnPassant ...
+//
+// It sets the en passant target.
+func (storage PieceStorage) ApplyEnPassant(
+	target common.Position,
+) PieceStorage {
+	storage.enPassant, storage.hasEnPassant = target, true
+	return storage
+}
+
+// ResetEnPassant ...
+//
+// It removes the en passant target (e.g. after a drop, which is a move too).
+func (storage PieceStorage) ResetEnPassant() PieceStorage {
+	storage.enPassant, storage.hasEnPassant = common.Position{}, false
+	return storage
+}
+
+// Geometry ...
+//
+// It returns the geometry of the wrapped storage, so the wrapper doesn't
+// hide it (see the common.GeometryGetter interface).
+func (storage PieceStorage) Geometry() common.Geometry {
+	return common.StorageGeometry(storage.PieceStorage)
+}
+
+// ApplyMove ...
+//
+// It processes a castling in any notation (see the Rules.Chess960Castling
+// field), an en passant capture and a promotion. It updates castling rights
+// and sets the en passant target after a pawn double step.
+//
+// It doesn't check that the move is correct.
+func (storage PieceStorage) ApplyMove(move common.Move) common.PieceStorage {
+	nextStorage := storage
+	nextStorage.castlingRights = storage.castlingRights.ApplyMove(storage, move)
+	nextStorage.enPassant, nextStorage.hasEnPassant = common.Position{}, false
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok {
+		nextStorage.PieceStorage = storage.PieceStorage.ApplyMove(move)
+		return nextStorage
+	}
+
+	castling, isCastling := storage.findCastling(move)
+	switch {
+	case IsCastling(storage.PieceStorage, move):
+		nextStorage.PieceStorage = ApplyCastling(storage.PieceStorage, move)
+	case isCastling:
+		nextStorage.PieceStorage = ApplyCastling(storage.PieceStorage, castling)
+	case storage.isEnPassant(move):
+		nextStorage.PieceStorage = storage.PieceStorage.
+			ApplyMove(move).
+			RemovePiece(enPassantCapture(move))
+	default:
+		promotions := common.Promotions{PieceFactory: storage.pieceFactory}
+		nextStorage.PieceStorage = promotions.ApplyMove(storage.PieceStorage, move)
+	}
+
+	if piece.Kind() == common.Pawn &&
+		move.Start.File == move.Finish.File &&
+		absInt(move.Finish.Rank-move.Start.Rank) == 2 {
+		nextStorage.enPassant = common.Position{
+			File: move.Start.File,
+			Rank: (move.Start.Rank + move.Finish.Rank) / 2,
+		}
+		nextStorage.hasEnPassant = true
+	}
+
+	return nextStorage
+}
+
+// SetPiece ...
+//
+// It doesn't change castling rights and the en passant target.
+func (storage PieceStorage) SetPiece(piece common.Piece) common.PieceStorage {
+	return storage.update(storage.PieceStorage.SetPiece(piece))
+}
+
+// RemovePiece ...
+//
+// It doesn't change castling rights and the en passant target.
+func (storage PieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return storage.update(storage.PieceStorage.RemovePiece(position))
+}
+
+func (storage PieceStorage) update(
+	baseStorage common.PieceStorage,
+) PieceStorage {
+	storage.PieceStorage = baseStorage
+	return storage
+}
+
+// it searches a castling, which is encoded as a move of a king
+// to its finish (see the Rules.Chess960Castling field)
+func (storage PieceStorage) findCastling(
+	move common.Move,
+) (castling common.Move, ok bool) {
+	return findCastling(storage.PieceStorage, storage.castlingRights, move, false)
+}
+
+// it checks that the move is a capture of a pawn en passant;
+// the move should go to the en passant target diagonally
+func (storage PieceStorage) isEnPassant(move common.Move) bool {
+	if !storage.hasEnPassant || move.Finish != storage.enPassant {
+		return false
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || piece.Kind() != common.Pawn {
+		return false
+	}
+
+	direction, ok := pawnDirection(piece.Color())
+	if !ok ||
+		absInt(move.Finish.File-move.Start.File) != 1 ||
+		move.Finish.Rank-move.Start.Rank != direction {
+		return false
+	}
+
+	captured, ok := storage.Piece(enPassantCapture(move))
+	return ok && captured.Kind() == common.Pawn &&
+		captured.Color() != piece.Color()
+}
+
+// it returns a position of a pawn captured en passant by the move
+func enPassantCapture(move common.Move) common.Position {
+	return common.Position{File: move.Finish.File, Rank: move.Start.Rank}
+}
+
+func pawnDirection(color common.Color) (direction int, ok bool) {
+	switch color {
+	case common.White:
+		return 1, true
+	case common.Black:
+		return -1, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,252 @@
+package classic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func newTestPieceStorage(test *testing.T, fen string) PieceStorage {
+	storage, err := DecodePieceStorage(fen, pieces.NewPiece, boards.NewMapBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return storage
+}
+
+func decodeTestMove(test *testing.T, text string) common.Move {
+	move, err := uci.DecodeMove(text)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return move
+}
+
+func TestPieceStorageApplyMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/4P3/4K3 - -",
+				move: "e2e4",
+			},
+			want: "4k3/8/8/8/4P3/8/8/4K3 - e3",
+		},
+		{
+			args: args{
+				fen:  "4k3/4p3/8/8/8/8/8/4K3 - -",
+				move: "e7e5",
+			},
+			want: "4k3/8/8/4p3/8/8/8/4K3 - e6",
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/4Pp2/8/8/4K3 - e3",
+				move: "f4e3",
+			},
+			want: "4k3/8/8/8/8/4p3/8/4K3 - -",
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/4Pp2/8/8/4K3 - e3",
+				move: "f4f3",
+			},
+			want: "4k3/8/8/8/4P3/5p2/8/4K3 - -",
+		},
+		{
+			args: args{
+				fen:  "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move: "e1g1",
+			},
+			want: "r3k2r/8/8/8/8/8/8/R4RK1 kq -",
+		},
+		{
+			args: args{
+				fen:  "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move: "e8a8",
+			},
+			want: "2kr3r/8/8/8/8/8/8/R3K2R KQ -",
+		},
+		{
+			args: args{
+				fen:  "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move: "e1f1",
+			},
+			want: "r3k2r/8/8/8/8/8/8/R4K1R kq -",
+		},
+		{
+			args: args{
+				fen:  "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move: "a1a8",
+			},
+			want: "R3k2r/8/8/8/8/8/8/4K2R Kk -",
+		},
+		{
+			args: args{
+				fen:  "4k3/1P6/8/8/8/8/8/4K3 - -",
+				move: "b7b8n",
+			},
+			want: "1N2k3/8/8/8/8/8/8/4K3 - -",
+		},
+	} {
+		storage := newTestPieceStorage(test, data.args.fen)
+		move := decodeTestMove(test, data.args.move)
+		nextStorage, ok := storage.ApplyMove(move).(PieceStorage)
+		if !ok {
+			test.Fail()
+			continue
+		}
+
+		if got := EncodePieceStorage(nextStorage, XFEN); got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageSetPiece(test *testing.T) {
+	storage := newTestPieceStorage(test, "r3k2r/8/8/8/4Pp2/8/8/R3K2R KQkq e3")
+	queen := pieces.NewQueen(common.White, common.Position{File: 3, Rank: 0})
+	got, ok := storage.SetPiece(queen).(PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+
+	if !reflect.DeepEqual(got.CastlingRights(), storage.CastlingRights()) {
+		test.Fail()
+	}
+	if target, ok := got.EnPassant(); !ok || uci.EncodePosition(target) != "e3" {
+		test.Fail()
+	}
+	if _, ok := got.Piece(queen.Position()); !ok {
+		test.Fail()
+	}
+}
+
+func TestPieceStorageRemovePiece(test *testing.T) {
+	storage := newTestPieceStorage(test, "r3k2r/8/8/8/4Pp2/8/8/R3K2R KQkq e3")
+	position := common.Position{File: 4, Rank: 3}
+	got, ok := storage.RemovePiece(position).(PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+
+	if !reflect.DeepEqual(got.CastlingRights(), storage.CastlingRights()) {
+		test.Fail()
+	}
+	if target, ok := got.EnPassant(); !ok || uci.EncodePosition(target) != "e3" {
+		test.Fail()
+	}
+	if _, ok := got.Piece(position); ok {
+		test.Fail()
+	}
+}
+
+type testWrapper struct {
+	common.PieceStorage
+}
+
+func (storage testWrapper) ClassicStorage() (PieceStorage, bool) {
+	return Unwrap(storage.PieceStorage)
+}
+
+func TestUnwrap(test *testing.T) {
+	storage := newTestPieceStorage(test, "4k3/8/8/8/4Pp2/8/8/4K3 - e3")
+	type data struct {
+		storage common.PieceStorage
+		wantOk  bool
+	}
+
+	for _, data := range []data{
+		{
+			storage: storage,
+			wantOk:  true,
+		},
+		{
+			storage: testWrapper{storage},
+			wantOk:  true,
+		},
+		{
+			storage: storage.PieceStorage,
+			wantOk:  false,
+		},
+	} {
+		got, gotOk := Unwrap(data.storage)
+
+		if data.wantOk &&
+			EncodePieceStorage(got, XFEN) != EncodePieceStorage(storage, XFEN) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestCapturedPosition(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "4k3/8/8/8/4Pp2/8/8/4K3 - e3",
+				move: "f4e3",
+			},
+			want: "e4",
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/4Pp2/3N4/8/4K3 - -",
+				move: "f4e3",
+			},
+			want: "e3",
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/4Pp2/3N4/8/4K3 - -",
+				move: "f4f3",
+			},
+			want: "f3",
+		},
+	} {
+		storage := newTestPieceStorage(test, data.args.fen)
+		move := decodeTestMove(test, data.args.move)
+		got := CapturedPosition(testWrapper{storage}, move)
+
+		if uci.EncodePosition(got) != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageResetEnPassant(test *testing.T) {
+	storage := newTestPieceStorage(test, "4k3/8/8/8/4Pp2/8/8/4K3 - e3")
+	got := storage.ResetEnPassant()
+
+	if _, ok := got.EnPassant(); ok {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(got.PieceStorage, storage.PieceStorage) {
+		test.Fail()
+	}
+}
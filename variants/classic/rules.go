@@ -0,0 +1,139 @@
+// Package classic implements the special moves of orthodox chess: a pawn
+// double step, an en passant capture, castling and promotions.
+//
+// They are used by orthodox chess and by its variants on other boards.
+// Their state (castling rights and an en passant target) is kept
+// by the PieceStorage wrapper.
+package classic
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Rules ...
+//
+// It implements the models.Rules and models.MoveExpander interfaces.
+//
+// The special moves are played only on a PieceStorage or its wrapper
+// (see the Wrapper interface); on other piece storages the rules are the same
+// as models.OrthodoxRules.
+type Rules struct {
+	models.OrthodoxRules
+
+	PawnDoubleStep bool
+	EnPassant      bool
+	Castling       bool
+
+	// pawns on the last rank are promoted to these kinds;
+	// if it's empty, pawns aren't promoted
+	Promotions []common.Kind
+
+	// if it's set, a castling is encoded as a move of a king onto its own rook
+	// (as in UCI for Chess960); otherwise, as a move of a king to its finish
+	Chess960Castling bool
+}
+
+// CheckMove ...
+//
+// A pawn move to the last rank should be a promotion if the promotion list
+// isn't empty.
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	classicStorage, ok := Unwrap(storage)
+	if !ok {
+		return storage.CheckMove(move)
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || move.IsDrop {
+		return storage.CheckMove(move)
+	}
+
+	if rules.Castling && piece.Kind() == common.King {
+		castling, ok := findCastling(
+			classicStorage.PieceStorage,
+			classicStorage.castlingRights,
+			move,
+			rules.Chess960Castling,
+		)
+		if ok {
+			if move.IsPromotion ||
+				!isCastlingPermitted(classicStorage.PieceStorage, piece, castling) {
+				return common.ErrIllegalMove
+			}
+
+			return nil
+		}
+	}
+
+	isSpecialPawnMove := piece.Kind() == common.Pawn &&
+		(rules.EnPassant && classicStorage.isEnPassant(move) ||
+			rules.PawnDoubleStep && isPawnDoubleStep(storage, piece, move))
+	if !isSpecialPawnMove {
+		if err := storage.CheckMove(move); err != nil {
+			return err
+		}
+	}
+
+	return rules.promotions().CheckMove(move, rules.isPromotion(storage, move))
+}
+
+// ExpandMove ...
+//
+// It expands a pawn move to the last rank to promotions to kinds
+// from the promotion list.
+func (rules Rules) ExpandMove(
+	storage common.PieceStorage,
+	move common.Move,
+) []common.Move {
+	if _, ok := Unwrap(storage); !ok {
+		return nil
+	}
+
+	return rules.promotions().ExpandMove(move, rules.isPromotion(storage, move))
+}
+
+func (rules Rules) promotions() common.Promotions {
+	return common.Promotions{Kinds: rules.Promotions}
+}
+
+func (rules Rules) isPromotion(
+	storage common.PieceStorage,
+	move common.Move,
+) bool {
+	return len(rules.Promotions) != 0 && common.IsPawnPromotion(storage, move)
+}
+
+// it checks that the move is a pawn move by two ranks forward
+// from its second rank over an empty cell to an empty cell
+func isPawnDoubleStep(
+	storage common.PieceStorage,
+	pawn common.Piece,
+	move common.Move,
+) bool {
+	direction, ok := pawnDirection(pawn.Color())
+	if !ok ||
+		move.Start.Rank != firstRank(storage.Size(), pawn.Color())+direction ||
+		move.Finish.File != move.Start.File ||
+		move.Finish.Rank-move.Start.Rank != 2*direction {
+		return false
+	}
+
+	middle := common.Position{
+		File: move.Start.File,
+		Rank: move.Start.Rank + direction,
+	}
+	for _, position := range []common.Position{middle, move.Finish} {
+		if !common.HasPosition(storage, position) {
+			return false
+		}
+		if _, ok := storage.Piece(position); ok {
+			return false
+		}
+	}
+
+	return true
+}
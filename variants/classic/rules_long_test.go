@@ -0,0 +1,87 @@
+//go:build long
+// +build long
+
+package classic
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestPerft_long(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		deep  int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR KQkq -",
+				color: common.White,
+				deep:  3,
+			},
+			want: 8902,
+		},
+		{
+			// the position known as Kiwipete
+			args: args{
+				fen: "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R " +
+					"KQkq -",
+				color: common.White,
+				deep:  3,
+			},
+			want: 97862,
+		},
+		{
+			args: args{
+				fen:   "8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 - -",
+				color: common.White,
+				deep:  4,
+			},
+			want: 43238,
+		},
+		{
+			args: args{
+				fen: "r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 " +
+					"kq -",
+				color: common.White,
+				deep:  3,
+			},
+			want: 9467,
+		},
+		{
+			args: args{
+				fen:   "rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R KQ -",
+				color: common.White,
+				deep:  3,
+			},
+			want: 62379,
+		},
+	} {
+		storage, err := DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewSliceBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: orthodoxRules}
+		got := models.Perft(generator, storage, data.args.color, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
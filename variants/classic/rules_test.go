@@ -0,0 +1,339 @@
+package classic
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+var orthodoxRules = Rules{
+	PawnDoubleStep: true,
+	EnPassant:      true,
+	Castling:       true,
+	Promotions: []common.Kind{
+		common.Queen,
+		common.Rook,
+		common.Bishop,
+		common.Knight,
+	},
+}
+
+func TestRulesCheckMove(test *testing.T) {
+	type args struct {
+		rules Rules
+		fen   string
+		move  string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/8/8/8/8/8/4P3/4K3 - -",
+				move:  "e2e4",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				rules: Rules{},
+				fen:   "4k3/8/8/8/8/8/4P3/4K3 - -",
+				move:  "e2e4",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/8/8/8/8/4n3/4P3/4K3 - -",
+				move:  "e2e4",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/8/8/8/8/4P3/8/4K3 - -",
+				move:  "e3e5",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/8/8/8/4Pp2/8/8/4K3 - e3",
+				move:  "f4e3",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/8/8/8/4Pp2/8/8/4K3 - -",
+				move:  "f4e3",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move:  "e1g1",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move:  "e1c1",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "r3k2r/8/8/8/8/8/8/R3K2R Qkq -",
+				move:  "e1g1",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move:  "e1h1",
+			},
+			want: common.ErrFriendlyTarget,
+		},
+		{
+			// the king passes an attacked position
+			args: args{
+				rules: orthodoxRules,
+				fen:   "r3kr2/8/8/8/8/8/8/R3K2R KQq -",
+				move:  "e1g1",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "r3k2r/8/8/8/8/8/8/RN2K2R KQkq -",
+				move:  "e1c1",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: Rules{Castling: true, Chess960Castling: true},
+				fen:   "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move:  "e1h1",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				rules: Rules{Castling: true, Chess960Castling: true},
+				fen:   "r3k2r/8/8/8/8/8/8/R3K2R KQkq -",
+				move:  "e1g1",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/1P6/8/8/8/8/8/4K3 - -",
+				move:  "b7b8q",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/1P6/8/8/8/8/8/4K3 - -",
+				move:  "b7b8",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/1P6/8/8/8/8/8/4K3 - -",
+				move:  "b7b8k",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				rules: Rules{},
+				fen:   "4k3/1P6/8/8/8/8/8/4K3 - -",
+				move:  "b7b8",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				rules: orthodoxRules,
+				fen:   "4k3/8/8/8/8/8/8/4R2K - -",
+				move:  "e1e8",
+			},
+			want: common.ErrKingCapture,
+		},
+	} {
+		storage := newTestPieceStorage(test, data.args.fen)
+		move := decodeTestMove(test, data.args.move)
+		got := data.args.rules.CheckMove(storage, move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesCheckMove_withAnotherPieceStorage(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"4k3/8/8/8/8/8/4P3/4K3",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	move := decodeTestMove(test, "e2e4")
+	got := orthodoxRules.CheckMove(storage, move)
+
+	if got != common.ErrIllegalMove {
+		test.Fail()
+	}
+}
+
+func TestRulesExpandMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want []string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "4k3/1P6/8/8/8/8/8/4K3 - -",
+				move: "b7b8",
+			},
+			want: []string{"b7b8q", "b7b8r", "b7b8b", "b7b8n"},
+		},
+		{
+			args: args{
+				fen:  "4k3/1P6/8/8/8/8/8/4K3 - -",
+				move: "b7b8q",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "4k3/8/8/8/8/8/4P3/4K3 - -",
+				move: "e2e4",
+			},
+			want: nil,
+		},
+	} {
+		storage := newTestPieceStorage(test, data.args.fen)
+		move := decodeTestMove(test, data.args.move)
+
+		var got []string
+		for _, move := range orthodoxRules.ExpandMove(storage, move) {
+			got = append(got, uci.EncodeMove(move))
+		}
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestPerft(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		deep  int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR KQkq -",
+				color: common.White,
+				deep:  2,
+			},
+			want: 400,
+		},
+		{
+			// the position known as Kiwipete
+			args: args{
+				fen: "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R " +
+					"KQkq -",
+				color: common.White,
+				deep:  2,
+			},
+			want: 2039,
+		},
+		{
+			args: args{
+				fen:   "8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 - -",
+				color: common.White,
+				deep:  3,
+			},
+			want: 2812,
+		},
+		{
+			args: args{
+				fen: "r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 " +
+					"kq -",
+				color: common.White,
+				deep:  2,
+			},
+			want: 264,
+		},
+		{
+			args: args{
+				fen:   "rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R KQ -",
+				color: common.White,
+				deep:  2,
+			},
+			want: 1486,
+		},
+	} {
+		storage, err := DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewSliceBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: orthodoxRules}
+		got := models.Perft(generator, storage, data.args.color, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
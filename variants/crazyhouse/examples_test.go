@@ -0,0 +1,41 @@
+package crazyhouse_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+)
+
+func ExampleRules() {
+	board, _ := uci.DecodePieceStorage(
+		"4k3/8/8/8/3n4/8/8/3RK3",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	storage := crazyhouse.NewPieceStorage(
+		board,
+		pieces.NewPiece,
+		common.White,
+		crazyhouse.Reserves{},
+	)
+
+	generator := models.MoveGenerator{Rules: crazyhouse.Rules{}}
+	for _, text := range []string{"d1d4", "e8d8", "N@c6"} {
+		move, _ := uci.DecodeMove(text)
+		storage = generator.ApplyMove(storage, move).(crazyhouse.PieceStorage)
+		fmt.Println(
+			uci.EncodePieceStorage(storage),
+			storage.Reserves().Count(common.White, common.Knight),
+		)
+	}
+
+	// Output:
+	// 4k3/8/8/8/3R4/8/8/4K3 1
+	// 3k4/8/8/8/3R4/8/8/4K3 1
+	// 3k4/8/2N5/8/3R4/8/8/4K3 0
+}
@@ -0,0 +1,186 @@
+package crazyhouse
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+// PieceStorage ...
+//
+// It wraps a piece storage and keeps pieces in hands, positions of promoted
+// pieces and a color to move. The latter is necessary for drops, because
+// they don't specify a color.
+type PieceStorage struct {
+	common.PieceStorage
+
+	pieceFactory common.PieceFactory
+	color        common.Color
+	reserves     Reserves
+	promoted     map[common.Position]struct{}
+}
+
+// NewPieceStorage ...
+func NewPieceStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+	reserves Reserves,
+) PieceStorage {
+	return PieceStorage{
+		PieceStorage: storage,
+
+		pieceFactory: pieceFactory,
+		color:        color,
+		reserves:     reserves,
+	}
+}
+
+// Color ...
+//
+// It returns a color to move.
+func (storage PieceStorage) Color() common.Color {
+	return storage.color
+}
+
+// Reserves ...
+func (storage PieceStorage) Reserves() Reserves {
+	return storage.reserves
+}
+
+// ClassicStorage ...
+//
+// It returns the wrapped classic.PieceStorage, if any, so the wrapper doesn't
+// hide the special moves (see the classic.Wrapper interface).
+func (storage PieceStorage) ClassicStorage() (classic.PieceStorage, bool) {
+	return classic.Unwrap(storage.PieceStorage)
+}
+
+// IsPromoted ...
+//
+// It checks that a piece on the position was promoted from a pawn.
+func (storage PieceStorage) IsPromoted(position common.Position) bool {
+	_, ok := storage.promoted[position]
+	return ok
+}
+
+// CheckMove ...
+//
+// A drop is checked for the color to move. It should go to an empty position
+// and a pawn can't be dropped to the first or the last rank.
+//
+// It doesn't check for a check before or after the move.
+func (storage PieceStorage) CheckMove(move common.Move) error {
+	if !move.IsDrop {
+		return storage.PieceStorage.CheckMove(move)
+	}
+
+	if !storage.Size().HasPosition(move.Finish) {
+		return common.ErrOutOfSize
+	}
+
+	if storage.reserves.Count(storage.color, move.Drop) == 0 {
+		return common.ErrNoPiece
+	}
+
+	if _, ok := storage.Piece(move.Finish); ok {
+		return common.ErrIllegalMove
+	}
+
+	lastRank := storage.Size().Height - 1
+	if move.Drop == common.Pawn &&
+		(move.Finish.Rank == 0 || move.Finish.Rank == lastRank) {
+		return common.ErrIllegalMove
+	}
+
+	return nil
+}
+
+// ApplyMove ...
+//
+// A captured piece (including a pawn captured en passant) goes to a hand
+// of the capturer (a promoted one is demoted to a pawn). A dropped piece
+// is taken from a hand of the color to move; the drop resets an en passant
+// target of a wrapped classic.PieceStorage. A pawn is replaced with
+// a promoted piece.
+func (storage PieceStorage) ApplyMove(move common.Move) common.PieceStorage {
+	if move.IsDrop {
+		piece := storage.pieceFactory(move.Drop, storage.color, move.Finish)
+
+		baseStorage := storage.PieceStorage.SetPiece(piece)
+		if classicStorage, ok := baseStorage.(classic.PieceStorage); ok {
+			baseStorage = classicStorage.ResetEnPassant()
+		}
+
+		nextStorage := storage.update(baseStorage)
+		nextStorage.reserves = storage.reserves.Remove(storage.color, move.Drop)
+		nextStorage.color = storage.color.Negative()
+		return nextStorage
+	}
+
+	piece, _ := storage.Piece(move.Start)
+	promotions := common.Promotions{PieceFactory: storage.pieceFactory}
+	nextStorage :=
+		storage.update(promotions.ApplyMove(storage.PieceStorage, move))
+	captured := classic.CapturedPosition(storage.PieceStorage, move)
+	if target, ok := storage.Piece(captured); ok {
+		kind := target.Kind()
+		if storage.IsPromoted(captured) {
+			kind = common.Pawn
+		}
+
+		nextStorage.reserves = storage.reserves.Add(piece.Color(), kind)
+	}
+
+	nextStorage.promoted = storage.copyPromoted()
+	delete(nextStorage.promoted, captured)
+	if storage.IsPromoted(move.Start) {
+		delete(nextStorage.promoted, move.Start)
+		nextStorage.promoted[move.Finish] = struct{}{}
+	}
+	if move.IsPromotion {
+		nextStorage.promoted[move.Finish] = struct{}{}
+	}
+
+	nextStorage.color = piece.Color().Negative()
+	return nextStorage
+}
+
+// SetPiece ...
+//
+// It doesn't change pieces in hands. A set piece isn't promoted.
+func (storage PieceStorage) SetPiece(piece common.Piece) common.PieceStorage {
+	nextStorage := storage.update(storage.PieceStorage.SetPiece(piece))
+	nextStorage.promoted = storage.copyPromoted()
+	delete(nextStorage.promoted, piece.Position())
+
+	return nextStorage
+}
+
+// RemovePiece ...
+//
+// It doesn't change pieces in hands.
+func (storage PieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	nextStorage := storage.update(storage.PieceStorage.RemovePiece(position))
+	nextStorage.promoted = storage.copyPromoted()
+	delete(nextStorage.promoted, position)
+
+	return nextStorage
+}
+
+func (storage PieceStorage) update(
+	baseStorage common.PieceStorage,
+) PieceStorage {
+	storage.PieceStorage = baseStorage
+	return storage
+}
+
+func (storage PieceStorage) copyPromoted() map[common.Position]struct{} {
+	promotedCopy := make(map[common.Position]struct{}, len(storage.promoted)+1)
+	for position := range storage.promoted {
+		promotedCopy[position] = struct{}{}
+	}
+
+	return promotedCopy
+}
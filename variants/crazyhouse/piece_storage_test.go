@@ -0,0 +1,330 @@
+package crazyhouse
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+func newTestPieceStorage(
+	test *testing.T,
+	fen string,
+	color common.Color,
+	reserves Reserves,
+) PieceStorage {
+	storage, err :=
+		uci.DecodePieceStorage(fen, pieces.NewPiece, boards.NewMapBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return NewPieceStorage(storage, pieces.NewPiece, color, reserves)
+}
+
+func decodeTestMove(test *testing.T, text string) common.Move {
+	move, err := uci.DecodeMove(text)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return move
+}
+
+func TestPieceStorageCheckMove(test *testing.T) {
+	type args struct {
+		fen      string
+		color    common.Color
+		reserves Reserves
+		move     string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	whitePawn := Reserves{}.Add(common.White, common.Pawn)
+	for _, data := range []data{
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: whitePawn,
+				move:     "P@e4",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.Black,
+				reserves: whitePawn,
+				move:     "P@e4",
+			},
+			want: common.ErrNoPiece,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: whitePawn,
+				move:     "N@e4",
+			},
+			want: common.ErrNoPiece,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/4p3/8/8/4K3",
+				color:    common.White,
+				reserves: whitePawn,
+				move:     "P@e4",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: whitePawn,
+				move:     "P@a8",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: whitePawn,
+				move:     "P@a1",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: whitePawn,
+				move:     "P@i4",
+			},
+			want: common.ErrOutOfSize,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: whitePawn,
+				move:     "e1e2",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: whitePawn,
+				move:     "e1e3",
+			},
+			want: common.ErrIllegalMove,
+		},
+	} {
+		storage := newTestPieceStorage(
+			test,
+			data.args.fen,
+			data.args.color,
+			data.args.reserves,
+		)
+		got := storage.CheckMove(decodeTestMove(test, data.args.move))
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageApplyMove(test *testing.T) {
+	type args struct {
+		fen      string
+		color    common.Color
+		reserves Reserves
+		moves    []string
+	}
+	type data struct {
+		args          args
+		wantFEN       string
+		wantColor     common.Color
+		wantReserves  Reserves
+		wantPromotion []common.Position
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: Reserves{}.Add(common.White, common.Knight),
+				moves:    []string{"N@e4"},
+			},
+			wantFEN:       "4k3/8/8/8/4N3/8/8/4K3",
+			wantColor:     common.Black,
+			wantReserves:  Reserves{},
+			wantPromotion: nil,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/3n4/8/8/3RK3",
+				color:    common.White,
+				reserves: Reserves{},
+				moves:    []string{"d1d4"},
+			},
+			wantFEN:       "4k3/8/8/8/3R4/8/8/4K3",
+			wantColor:     common.Black,
+			wantReserves:  Reserves{}.Add(common.White, common.Knight),
+			wantPromotion: nil,
+		},
+		{
+			args: args{
+				fen:      "4k3/1P6/8/8/8/8/8/4K3",
+				color:    common.White,
+				reserves: Reserves{},
+				moves:    []string{"b7b8q", "e8d8", "b8b1"},
+			},
+			wantFEN:       "3k4/8/8/8/8/8/8/1Q2K3",
+			wantColor:     common.Black,
+			wantReserves:  Reserves{},
+			wantPromotion: []common.Position{{File: 1, Rank: 0}},
+		},
+		{
+			args: args{
+				fen:      "4k3/1P6/8/8/8/8/8/r3K3",
+				color:    common.White,
+				reserves: Reserves{},
+				moves:    []string{"b7b8q", "e8d8", "b8b1", "a1b1"},
+			},
+			wantFEN:       "3k4/8/8/8/8/8/8/1r2K3",
+			wantColor:     common.White,
+			wantReserves:  Reserves{}.Add(common.Black, common.Pawn),
+			wantPromotion: nil,
+		},
+	} {
+		var storage common.PieceStorage = newTestPieceStorage(
+			test,
+			data.args.fen,
+			data.args.color,
+			data.args.reserves,
+		)
+		for _, move := range data.args.moves {
+			storage = storage.ApplyMove(decodeTestMove(test, move))
+		}
+
+		crazyhouseStorage := storage.(PieceStorage)
+		var gotPromotion []common.Position
+		for _, position := range storage.Size().Positions() {
+			if crazyhouseStorage.IsPromoted(position) {
+				gotPromotion = append(gotPromotion, position)
+			}
+		}
+
+		if uci.EncodePieceStorage(storage) != data.wantFEN {
+			test.Fail()
+		}
+		if crazyhouseStorage.Color() != data.wantColor {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(
+			crazyhouseStorage.Reserves().Kinds(common.White),
+			data.wantReserves.Kinds(common.White),
+		) || !reflect.DeepEqual(
+			crazyhouseStorage.Reserves().Kinds(common.Black),
+			data.wantReserves.Kinds(common.Black),
+		) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotPromotion, data.wantPromotion) {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageApplyMove_withClassicStorage(test *testing.T) {
+	classicStorage, err := classic.DecodePieceStorage(
+		"4k3/8/8/8/4p3/8/3P4/4K3 - -",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var storage common.PieceStorage = NewPieceStorage(
+		classicStorage,
+		pieces.NewPiece,
+		common.White,
+		Reserves{}.Add(common.Black, common.Knight),
+	)
+	for _, move := range []string{"d2d4", "N@a6"} {
+		storage = storage.ApplyMove(decodeTestMove(test, move))
+	}
+
+	got, ok := storage.(PieceStorage).ClassicStorage()
+	if !ok {
+		test.FailNow()
+	}
+
+	want := "4k3/8/n7/8/3Pp3/8/8/4K3 - -"
+	if classic.EncodePieceStorage(got, classic.XFEN) != want {
+		test.Fail()
+	}
+}
+
+func TestPieceStorageSetPiece(test *testing.T) {
+	storage := newTestPieceStorage(
+		test,
+		"4k3/1P6/8/8/8/8/8/4K3",
+		common.White,
+		Reserves{}.Add(common.White, common.Pawn),
+	)
+	storage = storage.ApplyMove(decodeTestMove(test, "b7b8q")).(PieceStorage)
+
+	rook := pieces.NewRook(common.White, common.Position{File: 1, Rank: 7})
+	nextStorage := storage.SetPiece(rook).(PieceStorage)
+
+	if uci.EncodePieceStorage(nextStorage) != "1R2k3/8/8/8/8/8/8/4K3" {
+		test.Fail()
+	}
+	if nextStorage.IsPromoted(common.Position{File: 1, Rank: 7}) {
+		test.Fail()
+	}
+	if !storage.IsPromoted(common.Position{File: 1, Rank: 7}) {
+		test.Fail()
+	}
+	if nextStorage.Reserves().Count(common.White, common.Pawn) != 1 {
+		test.Fail()
+	}
+}
+
+func TestPieceStorageRemovePiece(test *testing.T) {
+	storage := newTestPieceStorage(
+		test,
+		"4k3/1P6/8/8/8/8/8/4K3",
+		common.White,
+		Reserves{}.Add(common.White, common.Pawn),
+	)
+	storage = storage.ApplyMove(decodeTestMove(test, "b7b8q")).(PieceStorage)
+
+	nextStorage :=
+		storage.RemovePiece(common.Position{File: 1, Rank: 7}).(PieceStorage)
+
+	if uci.EncodePieceStorage(nextStorage) != "4k3/8/8/8/8/8/8/4K3" {
+		test.Fail()
+	}
+	if nextStorage.IsPromoted(common.Position{File: 1, Rank: 7}) {
+		test.Fail()
+	}
+	if nextStorage.Reserves().Count(common.White, common.Pawn) != 1 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,76 @@
+package crazyhouse
+
+import (
+	"sort"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Reserves ...
+//
+// It's pieces in hands of both colors. It's immutable, i.e. all changes
+// return a copy. The zero value is empty reserves.
+type Reserves struct {
+	counts [common.ColorCount]map[common.Kind]int
+}
+
+// Count ...
+func (reserves Reserves) Count(color common.Color, kind common.Kind) int {
+	return reserves.counts[color][kind]
+}
+
+// Kinds ...
+//
+// It returns kinds of pieces in a hand of the color in ascending order.
+func (reserves Reserves) Kinds(color common.Color) []common.Kind {
+	var kinds []common.Kind
+	for kind, count := range reserves.counts[color] {
+		if count > 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	sort.Slice(kinds, func(i int, j int) bool {
+		return kinds[i] < kinds[j]
+	})
+
+	return kinds
+}
+
+// Add ...
+//
+// It puts a piece of the kind to a hand of the color.
+func (reserves Reserves) Add(color common.Color, kind common.Kind) Reserves {
+	return reserves.change(color, kind, 1)
+}
+
+// Remove ...
+//
+// It takes a piece of the kind from a hand of the color.
+// It does nothing if there is no such piece.
+func (reserves Reserves) Remove(color common.Color, kind common.Kind) Reserves {
+	if reserves.Count(color, kind) == 0 {
+		return reserves
+	}
+
+	return reserves.change(color, kind, -1)
+}
+
+func (reserves Reserves) change(
+	color common.Color,
+	kind common.Kind,
+	delta int,
+) Reserves {
+	countsCopy := make(map[common.Kind]int, len(reserves.counts[color])+1)
+	for kind, count := range reserves.counts[color] {
+		countsCopy[kind] = count
+	}
+
+	countsCopy[kind] += delta
+	if countsCopy[kind] == 0 {
+		delete(countsCopy, kind)
+	}
+
+	reserves.counts[color] = countsCopy
+	return reserves
+}
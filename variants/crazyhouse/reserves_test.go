@@ -0,0 +1,90 @@
+package crazyhouse
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestReserves(test *testing.T) {
+	var reserves Reserves
+	reservesOne := reserves.Add(common.White, common.Knight)
+	reservesTwo := reservesOne.Add(common.White, common.Pawn)
+	reservesThree := reservesTwo.Add(common.White, common.Knight)
+	reservesFour := reservesThree.Remove(common.White, common.Knight)
+	reservesFive := reservesFour.Remove(common.Black, common.Knight)
+
+	type data struct {
+		reserves   Reserves
+		wantCount  int
+		wantKinds  []common.Kind
+		wantBlacks []common.Kind
+	}
+
+	for _, data := range []data{
+		{
+			reserves:   reserves,
+			wantCount:  0,
+			wantKinds:  nil,
+			wantBlacks: nil,
+		},
+		{
+			reserves:   reservesOne,
+			wantCount:  1,
+			wantKinds:  []common.Kind{common.Knight},
+			wantBlacks: nil,
+		},
+		{
+			reserves:   reservesTwo,
+			wantCount:  1,
+			wantKinds:  []common.Kind{common.Knight, common.Pawn},
+			wantBlacks: nil,
+		},
+		{
+			reserves:   reservesThree,
+			wantCount:  2,
+			wantKinds:  []common.Kind{common.Knight, common.Pawn},
+			wantBlacks: nil,
+		},
+		{
+			reserves:   reservesFour,
+			wantCount:  1,
+			wantKinds:  []common.Kind{common.Knight, common.Pawn},
+			wantBlacks: nil,
+		},
+		{
+			reserves:   reservesFive,
+			wantCount:  1,
+			wantKinds:  []common.Kind{common.Knight, common.Pawn},
+			wantBlacks: nil,
+		},
+	} {
+		gotCount := data.reserves.Count(common.White, common.Knight)
+		gotKinds := data.reserves.Kinds(common.White)
+		gotBlacks := data.reserves.Kinds(common.Black)
+
+		if gotCount != data.wantCount {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotKinds, data.wantKinds) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(gotBlacks, data.wantBlacks) {
+			test.Fail()
+		}
+	}
+}
+
+func TestReservesRemove_toEmpty(test *testing.T) {
+	reserves := Reserves{}.
+		Add(common.Black, common.Queen).
+		Remove(common.Black, common.Queen)
+
+	if reserves.Count(common.Black, common.Queen) != 0 {
+		test.Fail()
+	}
+	if reserves.Kinds(common.Black) != nil {
+		test.Fail()
+	}
+}
@@ -0,0 +1,60 @@
+// Package crazyhouse implements the rules of Crazyhouse.
+//
+// A captured piece goes to a hand of the capturer and can be dropped later
+// to an empty position instead of a move. Otherwise, the game follows
+// the orthodox rules including their special moves (see the classic package).
+package crazyhouse
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+// Rules ...
+//
+// It implements the models.Rules, models.MoveExpander
+// and models.DropGenerator interfaces. The special moves are enabled
+// by the embedded classic.Rules.
+//
+// A piece storage should be created by NewPieceStorage() over
+// a classic.PieceStorage, otherwise drops or the special moves
+// aren't supported.
+type Rules struct {
+	classic.Rules
+}
+
+// Drops ...
+//
+// It returns drops of all pieces in a hand of the color to all empty
+// positions, if the color is to move. They are restricted by CheckMove().
+func (rules Rules) Drops(
+	storage common.PieceStorage,
+	color common.Color,
+) []common.Move {
+	crazyhouseStorage, ok := storage.(PieceStorage)
+	if !ok || crazyhouseStorage.Color() != color {
+		return nil
+	}
+
+	kinds := crazyhouseStorage.Reserves().Kinds(color)
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	var moves []common.Move
+	for _, position := range storage.Size().Positions() {
+		if _, ok := storage.Piece(position); ok {
+			continue
+		}
+
+		for _, kind := range kinds {
+			moves = append(moves, common.Move{
+				Finish: position,
+				Drop:   kind,
+				IsDrop: true,
+			})
+		}
+	}
+
+	return moves
+}
@@ -0,0 +1,345 @@
+package crazyhouse
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+var crazyhouseRules = Rules{
+	Rules: classic.Rules{
+		PawnDoubleStep: true,
+		EnPassant:      true,
+		Castling:       true,
+		Promotions: []common.Kind{
+			common.Queen,
+			common.Rook,
+			common.Bishop,
+			common.Knight,
+		},
+	},
+}
+
+func TestRulesCheckMove(test *testing.T) {
+	type args struct {
+		fen      string
+		reserves Reserves
+		move     string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/R3K3 - -",
+				reserves: Reserves{},
+				move:     "a1a5",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:      "4k3/4P3/8/8/8/8/8/4K3 - -",
+				reserves: Reserves{},
+				move:     "e1e2",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:      "3k4/4P3/8/8/8/8/8/4K3 - -",
+				reserves: Reserves{},
+				move:     "e7e8",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:      "3k4/4P3/8/8/8/8/8/4K3 - -",
+				reserves: Reserves{},
+				move:     "e7e8n",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:      "3k4/4P3/8/8/8/8/8/4K3 - -",
+				reserves: Reserves{},
+				move:     "e7e8k",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3 - -",
+				reserves: Reserves{}.Add(common.White, common.Rook),
+				move:     "R@e7",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3 - -",
+				reserves: Reserves{}.Add(common.White, common.Rook),
+				move:     "R@e8",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:      "4k3/8/8/8/8/8/8/4K3 - -",
+				reserves: Reserves{}.Add(common.Black, common.Rook),
+				move:     "R@e7",
+			},
+			want: common.ErrNoPiece,
+		},
+	} {
+		storage, err := classic.DecodePieceStorage(
+			data.args.fen,
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := crazyhouseRules.CheckMove(
+			NewPieceStorage(storage, pieces.NewPiece, common.White, data.args.reserves),
+			move,
+		)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesApplyMove(test *testing.T) {
+	type args struct {
+		fen   string
+		moves []string
+	}
+	type data struct {
+		args      args
+		wantFEN   string
+		wantWhite []common.Kind
+		wantBlack []common.Kind
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "4k3/8/8/8/3n4/8/8/3RK3 - -",
+				moves: []string{"d1d4", "e8d8", "N@c6"},
+			},
+			wantFEN:   "3k4/8/2N5/8/3R4/8/8/4K3",
+			wantWhite: nil,
+			wantBlack: nil,
+		},
+		{
+			args: args{
+				fen:   "n2k4/1P6/8/8/8/8/8/4K3 - -",
+				moves: []string{"b7a8q", "d8c7", "a8b8", "c7b8"},
+			},
+			wantFEN:   "1k6/8/8/8/8/8/8/4K3",
+			wantWhite: []common.Kind{common.Knight},
+			wantBlack: []common.Kind{common.Pawn},
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/3Pp3/8/8/8/4K3 - e6",
+				moves: []string{"d5e6", "e8d8", "P@d7"},
+			},
+			wantFEN:   "3k4/3P4/4P3/8/8/8/8/4K3",
+			wantWhite: nil,
+			wantBlack: nil,
+		},
+		{
+			args: args{
+				fen:   "r3k3/8/8/8/8/8/8/4K2R K -",
+				moves: []string{"e1g1", "a8a1", "f1a1"},
+			},
+			wantFEN:   "4k3/8/8/8/8/8/8/R5K1",
+			wantWhite: []common.Kind{common.Rook},
+			wantBlack: nil,
+		},
+	} {
+		for _, factory := range []uci.PieceStorageFactory{
+			boards.NewMapBoard,
+			boards.NewSliceBoard,
+			func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+				return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+			},
+		} {
+			classicStorage, err :=
+				classic.DecodePieceStorage(data.args.fen, pieces.NewPiece, factory)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			var storage common.PieceStorage = NewPieceStorage(
+				classicStorage,
+				pieces.NewPiece,
+				common.White,
+				Reserves{},
+			)
+			for _, text := range data.args.moves {
+				move, err := uci.DecodeMove(text)
+				if err != nil {
+					test.Fatal(err)
+				}
+				if err := crazyhouseRules.CheckMove(storage, move); err != nil {
+					test.Fatal(err)
+				}
+
+				storage = crazyhouseRules.ApplyMove(storage, move)
+			}
+
+			reserves := storage.(PieceStorage).Reserves()
+			if uci.EncodePieceStorage(storage) != data.wantFEN {
+				test.Fail()
+			}
+			if !reflect.DeepEqual(reserves.Kinds(common.White), data.wantWhite) {
+				test.Fail()
+			}
+			if !reflect.DeepEqual(reserves.Kinds(common.Black), data.wantBlack) {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestRulesDrops(test *testing.T) {
+	type args struct {
+		storage common.PieceStorage
+		color   common.Color
+	}
+	type data struct {
+		args args
+		want []string
+	}
+
+	newStorage := func(reserves Reserves) common.PieceStorage {
+		storage, err := uci.DecodePieceStorage(
+			"k7/8/8/8/8/8/8/K7",
+			pieces.NewPiece,
+			boards.NewMapBoard,
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		return NewPieceStorage(storage, pieces.NewPiece, common.White, reserves)
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage: boards.NewMapBoard(common.Size{Width: 8, Height: 8}, nil),
+				color:   common.White,
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				storage: newStorage(Reserves{}),
+				color:   common.White,
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				storage: newStorage(Reserves{}.Add(common.White, common.Knight)),
+				color:   common.Black,
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				storage: newStorage(
+					Reserves{}.Add(common.White, common.Knight).Add(common.White, common.Pawn),
+				),
+				color: common.White,
+			},
+			want: func() []string {
+				var moves []string
+				for rank := 1; rank <= 8; rank++ {
+					for file := 'a'; file <= 'h'; file++ {
+						position := string(file) + string(rune('0'+rank))
+						if position == "a1" || position == "a8" {
+							continue
+						}
+
+						moves = append(moves, "N@"+position, "P@"+position)
+					}
+				}
+
+				return moves
+			}(),
+		},
+	} {
+		var rules Rules
+		var got []string
+		for _, move := range rules.Drops(data.args.storage, data.args.color) {
+			got = append(got, uci.EncodeMove(move))
+		}
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withMoveGenerator(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"4r1k1/8/8/8/8/8/8/4K3",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	generator := models.MoveGenerator{Rules: Rules{}}
+	moves, err := generator.LegalMovesForColor(
+		NewPieceStorage(
+			storage,
+			pieces.NewPiece,
+			common.White,
+			Reserves{}.Add(common.White, common.Bishop),
+		),
+		common.White,
+	)
+
+	var got []string
+	for _, move := range moves {
+		got = append(got, uci.EncodeMove(move))
+	}
+	sort.Strings(got)
+
+	want := []string{
+		"B@e2", "B@e3", "B@e4", "B@e5", "B@e6", "B@e7",
+		"e1d1", "e1d2", "e1f1", "e1f2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
@@ -0,0 +1,21 @@
+package variants_test
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants"
+)
+
+func ExampleLookup() {
+	variant, _ := variants.Lookup("los-alamos")
+	storage, _ := variant.NewPieceStorage(pieces.NewPiece, boards.NewMapBoard)
+	fmt.Printf("%+v\n", storage.Size())
+	fmt.Println(uci.EncodePieceStorage(storage))
+
+	// Output:
+	// {Width:6 Height:6}
+	// rnqknr/pppppp/6/6/PPPPPP/RNQKNR
+}
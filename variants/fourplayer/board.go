@@ -0,0 +1,110 @@
+package fourplayer
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Size ...
+//
+// It's a size of the square, in which the cross board is inscribed.
+var Size = common.Size{Width: 14, Height: 14}
+
+// CornerSize ...
+//
+// It's a side of squares cut off corners of the board.
+const CornerSize = 3
+
+// PromotionRank ...
+//
+// It's an index of a rank relative to the side of a color (see RelativeRank()),
+// where its pawns are promoted, i.e. the eighth rank.
+const PromotionRank = 7
+
+// Colors ...
+//
+// It's colors of players in order of their turns.
+var Colors = []common.Color{
+	common.Red,
+	common.Blue,
+	common.Yellow,
+	common.Green,
+}
+
+// InitialFEN ...
+//
+// It's the initial position of four-player chess in the four-player FEN
+// (see DecodePieceStorage()). Red starts on lower ranks, blue on left files,
+// yellow on upper ranks and green on right files.
+const InitialFEN = "3,yR,yN,yB,yK,yQ,yB,yN,yR,3/3,yP,yP,yP,yP,yP,yP,yP,yP,3/" +
+	"14/" +
+	"bR,bP,10,gP,gR/bN,bP,10,gP,gN/bB,bP,10,gP,gB/bK,bP,10,gP,gQ/" +
+	"bQ,bP,10,gP,gK/bB,bP,10,gP,gB/bN,bP,10,gP,gN/bR,bP,10,gP,gR/" +
+	"14/" +
+	"3,rP,rP,rP,rP,rP,rP,rP,rP,3/3,rR,rN,rB,rQ,rK,rB,rN,rR,3"
+
+// NewMask ...
+//
+// It returns the geometry of the cross board, where corners are holes.
+func NewMask() common.Mask {
+	var holes []common.Position
+	Size.IteratePositions(func(position common.Position) error { // nolint: errcheck
+		if isCorner(position.File) && isCorner(position.Rank) {
+			holes = append(holes, position)
+		}
+
+		return nil
+	})
+
+	return common.NewMask(holes)
+}
+
+// WrapPieceStorage ...
+//
+// It provides the cross board geometry for the piece storage of the size
+// 14x14 and wraps it by NewPieceStorage(). Players without a king
+// are considered eliminated.
+func WrapPieceStorage(storage common.PieceStorage) PieceStorage {
+	if geometryBoard, ok := storage.(boards.GeometryBoard); ok {
+		storage = geometryBoard.PieceStorage
+	}
+
+	var eliminations Eliminations
+	for _, color := range Colors {
+		eliminations[color] = true
+	}
+	for _, piece := range storage.Pieces() {
+		if piece.Kind() == common.King {
+			eliminations[piece.Color()] = false
+		}
+	}
+
+	storage = boards.NewGeometryBoard(storage, NewMask())
+	return NewPieceStorage(storage, eliminations)
+}
+
+// RelativeRank ...
+//
+// It returns an index of a rank of the position relative to the side
+// of the color, i.e. a count of pawn steps from the edge of the color
+// (see common.Color.Forward()).
+func RelativeRank(
+	size common.Size,
+	color common.Color,
+	position common.Position,
+) int {
+	switch color {
+	case common.Blue:
+		return position.File
+	case common.Yellow:
+		return size.Height - 1 - position.Rank
+	case common.Green:
+		return size.Width - 1 - position.File
+	}
+
+	return position.Rank
+}
+
+func isCorner(coordinate int) bool {
+	return coordinate < CornerSize || coordinate >= Size.Width-CornerSize
+}
@@ -0,0 +1,118 @@
+package fourplayer
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// it makes a piece storage from a placement like "rKh1 bKa7",
+// where each piece is a color symbol, a kind symbol and a position
+func makeStorage(test *testing.T, placement string) PieceStorage {
+	var pieces []common.Piece
+	for _, token := range strings.Fields(placement) {
+		piece, err := decodePiece(token[:2], NewPiece)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		rank, err := strconv.Atoi(token[3:])
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		position := common.Position{File: int(token[2] - 'a'), Rank: rank - 1}
+		pieces = append(pieces, piece.ApplyPosition(position))
+	}
+
+	return WrapPieceStorage(boards.NewMapBoard(Size, pieces))
+}
+
+func TestNewMask(test *testing.T) {
+	mask := NewMask()
+
+	var count int
+	Size.IteratePositions(func(position common.Position) error { // nolint: errcheck
+		if mask.HasPosition(Size, position) {
+			count++
+		}
+
+		return nil
+	})
+
+	if count != 160 {
+		test.Fail()
+	}
+	if !mask.IsHole(common.Position{File: 2, Rank: 11}) ||
+		mask.IsHole(common.Position{File: 3, Rank: 11}) ||
+		mask.IsHole(common.Position{File: 2, Rank: 10}) {
+		test.Fail()
+	}
+}
+
+func TestWrapPieceStorage(test *testing.T) {
+	storage := boards.NewMapBoard(Size, []common.Piece{
+		NewPiece(common.King, common.Red, common.Position{File: 7, Rank: 0}),
+		NewPiece(common.King, common.Yellow, common.Position{File: 6, Rank: 13}),
+		NewPiece(common.Queen, common.Blue, common.Position{File: 0, Rank: 6}),
+	})
+	got := WrapPieceStorage(boards.NewGeometryBoard(storage, common.Rectangular{}))
+
+	wantEliminations := Eliminations{
+		common.Blue:  true,
+		common.Green: true,
+	}
+	if got.Eliminations() != wantEliminations {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(common.StorageGeometry(got), NewMask()) {
+		test.Fail()
+	}
+	if len(got.Pieces()) != 3 {
+		test.Fail()
+	}
+}
+
+func TestRelativeRank(test *testing.T) {
+	type args struct {
+		color    common.Color
+		position common.Position
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.Red, common.Position{File: 5, Rank: 1}},
+			want: 1,
+		},
+		{
+			args: args{common.Blue, common.Position{File: 1, Rank: 5}},
+			want: 1,
+		},
+		{
+			args: args{common.Yellow, common.Position{File: 5, Rank: 12}},
+			want: 1,
+		},
+		{
+			args: args{common.Green, common.Position{File: 12, Rank: 5}},
+			want: 1,
+		},
+		{
+			args: args{common.Green, common.Position{File: 6, Rank: 5}},
+			want: 7,
+		},
+	} {
+		got := RelativeRank(Size, data.args.color, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
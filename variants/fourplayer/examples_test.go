@@ -0,0 +1,37 @@
+package fourplayer_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/fourplayer"
+)
+
+func ExampleRules() {
+	storage, _ := fourplayer.DecodePieceStorage(
+		fourplayer.InitialFEN,
+		fourplayer.NewPiece,
+		boards.NewMapBoard,
+	)
+
+	generator := models.MoveGenerator{Rules: fourplayer.Rules{IsTeamGame: true}}
+	moves, _ := generator.LegalMovesForColor(storage, common.Red)
+	fmt.Println(len(moves))
+
+	position, _ := uci.DecodePosition("e1")
+	moves, _ = generator.MovesForPosition(storage, position)
+	for _, move := range moves {
+		fmt.Println(uci.EncodeMove(move))
+	}
+
+	fmt.Println(generator.NextColor(storage, common.Red) == common.Blue)
+
+	// Output:
+	// 20
+	// e1d3
+	// e1f3
+	// true
+}
@@ -0,0 +1,178 @@
+package fourplayer
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+const (
+	rankSeparator = "/"
+	cellSeparator = ","
+)
+
+var colorSymbols = map[common.Color]rune{
+	common.Red:    'r',
+	common.Blue:   'b',
+	common.Yellow: 'y',
+	common.Green:  'g',
+}
+
+// DecodePieceStorage ...
+//
+// It decodes a piece storage from the four-player FEN and wraps it
+// by WrapPieceStorage(). It's a piece placement as in the FEN4 notation:
+// ranks of the size 14x14 are listed from the upper one and separated by "/",
+// cells of a rank are separated by ",". A cell is either a count of empty
+// positions or a lowercase symbol of a color ("r", "b", "y" or "g")
+// followed by an uppercase symbol of a kind (e.g. "rK"). Corners
+// of the board should be empty.
+//
+// The piece factory should make four-player pawns, see NewPieceFactory().
+func DecodePieceStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (PieceStorage, error) {
+	ranks := strings.Split(fen, rankSeparator)
+	if len(ranks) != Size.Height {
+		return PieceStorage{}, fmt.Errorf("incorrect rank count %d", len(ranks))
+	}
+
+	mask := NewMask()
+	var pieces []common.Piece
+	for index, rank := range ranks {
+		rankIndex := Size.Height - 1 - index
+		rankPieces, err := decodeRank(rankIndex, rank, pieceFactory)
+		if err != nil {
+			return PieceStorage{}, fmt.Errorf("incorrect rank #%d: %s", rankIndex, err)
+		}
+
+		for _, piece := range rankPieces {
+			if !mask.HasPosition(Size, piece.Position()) {
+				return PieceStorage{},
+					fmt.Errorf("piece outside the board %+v", piece.Position())
+			}
+		}
+
+		pieces = append(pieces, rankPieces...)
+	}
+
+	storage := pieceStorageFactory(Size, pieces)
+	return WrapPieceStorage(storage), nil
+}
+
+// EncodePieceStorage ...
+//
+// It converts the piece storage to the four-player FEN
+// (see DecodePieceStorage()).
+func EncodePieceStorage(storage common.PieceStorage) string {
+	size := storage.Size()
+	ranks := make([]string, 0, size.Height)
+	for rank := size.Height - 1; rank >= 0; rank-- {
+		var cells []string
+		var shift int
+		for file := 0; file < size.Width; file++ {
+			piece, ok := storage.Piece(common.Position{File: file, Rank: rank})
+			if !ok {
+				shift++
+				continue
+			}
+
+			if shift != 0 {
+				cells = append(cells, strconv.Itoa(shift))
+				shift = 0
+			}
+
+			cells = append(cells, encodePiece(piece))
+		}
+		if shift != 0 {
+			cells = append(cells, strconv.Itoa(shift))
+		}
+
+		ranks = append(ranks, strings.Join(cells, cellSeparator))
+	}
+
+	return strings.Join(ranks, rankSeparator)
+}
+
+func decodeRank(
+	rankIndex int,
+	rank string,
+	pieceFactory common.PieceFactory,
+) ([]common.Piece, error) {
+	var pieces []common.Piece
+	var file int
+	for _, cell := range strings.Split(rank, cellSeparator) {
+		if shift, err := strconv.Atoi(cell); err == nil {
+			if shift <= 0 {
+				return nil, fmt.Errorf("incorrect shift %d", shift)
+			}
+
+			file += shift
+			continue
+		}
+
+		piece, err := decodePiece(cell, pieceFactory)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect piece %q: %s", cell, err)
+		}
+
+		position := common.Position{File: file, Rank: rankIndex}
+		pieces = append(pieces, piece.ApplyPosition(position))
+		file++
+	}
+	if file != Size.Width {
+		return nil, fmt.Errorf("incorrect length %d", file)
+	}
+
+	return pieces, nil
+}
+
+func decodePiece(
+	cell string,
+	pieceFactory common.PieceFactory,
+) (common.Piece, error) {
+	symbols := []rune(cell)
+	if len(symbols) != 2 || !unicode.IsUpper(symbols[1]) {
+		return nil, errors.New("incorrect format")
+	}
+
+	color, ok := lookupColorBySymbol(symbols[0])
+	if !ok {
+		return nil, errors.New("unknown color")
+	}
+
+	kind, ok := common.LookupKindBySymbol(symbols[1])
+	if !ok {
+		return nil, errors.New("unknown kind")
+	}
+
+	piece := pieceFactory(kind, color, common.Position{})
+	if piece == nil {
+		return nil, errors.New("unsupported kind")
+	}
+
+	return piece, nil
+}
+
+func encodePiece(piece common.Piece) string {
+	descriptor, _ := common.LookupKind(piece.Kind())
+	kind := unicode.ToUpper(descriptor.Symbol)
+	return string(colorSymbols[piece.Color()]) + string(kind)
+}
+
+func lookupColorBySymbol(symbol rune) (color common.Color, ok bool) {
+	for color, colorSymbol := range colorSymbols {
+		if colorSymbol == symbol {
+			return color, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,140 @@
+package fourplayer
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		fen string
+	}
+	type data struct {
+		args           args
+		wantPieceCount int
+		wantErr        bool
+	}
+
+	for _, data := range []data{
+		{
+			args:           args{InitialFEN},
+			wantPieceCount: 64,
+			wantErr:        false,
+		},
+		{
+			args: args{
+				"7,yK,6/14/14/14/14/14/14/bK,13/14/14/14/14/14/7,rK,6",
+			},
+			wantPieceCount: 3,
+			wantErr:        false,
+		},
+		{
+			args:           args{"14/14/14"},
+			wantPieceCount: 0,
+			wantErr:        true,
+		},
+		{
+			args: args{
+				"yK,13/14/14/14/14/14/14/14/14/14/14/14/14/7,rK,6",
+			},
+			wantPieceCount: 0,
+			wantErr:        true,
+		},
+		{
+			args: args{
+				"7,yK,5/14/14/14/14/14/14/14/14/14/14/14/14/7,rK,6",
+			},
+			wantPieceCount: 0,
+			wantErr:        true,
+		},
+		{
+			args: args{
+				"7,xK,6/14/14/14/14/14/14/14/14/14/14/14/14/7,rK,6",
+			},
+			wantPieceCount: 0,
+			wantErr:        true,
+		},
+		{
+			args: args{
+				"7,yX,6/14/14/14/14/14/14/14/14/14/14/14/14/7,rK,6",
+			},
+			wantPieceCount: 0,
+			wantErr:        true,
+		},
+		{
+			args: args{
+				"7,yk,6/14/14/14/14/14/14/14/14/14/14/14/14/7,rK,6",
+			},
+			wantPieceCount: 0,
+			wantErr:        true,
+		},
+		{
+			args: args{
+				"0,7,yK,6/14/14/14/14/14/14/14/14/14/14/14/14/7,rK,6",
+			},
+			wantPieceCount: 0,
+			wantErr:        true,
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(data.args.fen, NewPiece, boards.NewMapBoard)
+
+		if hasErr := err != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+		if err == nil && len(storage.Pieces()) != data.wantPieceCount {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodePieceStorage_withPositions(test *testing.T) {
+	storage, err := DecodePieceStorage(InitialFEN, NewPiece, boards.NewMapBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	type data struct {
+		position common.Position
+		kind     common.Kind
+		color    common.Color
+	}
+
+	for _, data := range []data{
+		{common.Position{File: 7, Rank: 0}, common.King, common.Red},
+		{common.Position{File: 0, Rank: 7}, common.King, common.Blue},
+		{common.Position{File: 6, Rank: 13}, common.King, common.Yellow},
+		{common.Position{File: 13, Rank: 6}, common.King, common.Green},
+		{common.Position{File: 12, Rank: 3}, common.Pawn, common.Green},
+	} {
+		piece, ok := storage.Piece(data.position)
+		if !ok || piece.Kind() != data.kind || piece.Color() != data.color {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePieceStorage(test *testing.T) {
+	for _, pieceStorageFactory := range []uci.PieceStorageFactory{
+		boards.NewMapBoard,
+		boards.NewSliceBoard,
+		func(size common.Size, pieces []common.Piece) common.PieceStorage {
+			return boards.NewBitBoard(size, pieces, NewPiece)
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(InitialFEN, NewPiece, pieceStorageFactory)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := EncodePieceStorage(storage)
+
+		if got != InitialFEN {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package fourplayer
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// NewPiece ...
+//
+// It makes four-player pawns and orthodox pieces of other built-in kinds.
+// It returns nil for unknown kinds.
+func NewPiece(
+	kind common.Kind,
+	color common.Color,
+	position common.Position,
+) common.Piece {
+	return NewPieceFactory(pieces.NewPiece)(kind, color, position)
+}
+
+// NewPieceFactory ...
+//
+// It returns a factory, which makes four-player pawns and uses the fallback
+// factory for other kinds. The fallback can be nil.
+func NewPieceFactory(fallback common.PieceFactory) common.PieceFactory {
+	return func(
+		kind common.Kind,
+		color common.Color,
+		position common.Position,
+	) common.Piece {
+		if kind == common.Pawn {
+			return NewPawn(color, position)
+		}
+
+		if fallback == nil {
+			return nil
+		}
+
+		return fallback(kind, color, position)
+	}
+}
@@ -0,0 +1,82 @@
+package fourplayer
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Pawn ...
+//
+// It moves as an orthodox pawn in the forward direction of its color
+// (see common.Color.Forward()) and makes a double step from the second rank
+// from its side (see RelativeRank()). There are no en passant captures.
+type Pawn struct{ pieces.Pawn }
+
+// NewPawn ...
+func NewPawn(color common.Color, position common.Position) Pawn {
+	return Pawn{pieces.NewPawn(color, position)}
+}
+
+// ApplyPosition ...
+func (piece Pawn) ApplyPosition(position common.Position) common.Piece {
+	return NewPawn(piece.Color(), position)
+}
+
+// CheckMove ...
+func (piece Pawn) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	if piece.Pawn.CheckMove(move, storage) {
+		return true
+	}
+
+	middle, finish, ok := piece.doubleStep(storage)
+	if !ok || move.Finish != finish {
+		return false
+	}
+
+	for _, position := range []common.Position{middle, finish} {
+		if _, ok := storage.Piece(position); ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Reach ...
+func (piece Pawn) Reach(storage common.PieceStorage) []common.Position {
+	positions := piece.Pawn.Reach(storage)
+	if _, finish, ok := piece.doubleStep(storage); ok {
+		positions = append(positions, finish)
+	}
+
+	return positions
+}
+
+// it returns positions passed by a double step,
+// if the pawn is on the second rank from its side
+func (piece Pawn) doubleStep(
+	storage common.PieceStorage,
+) (middle common.Position, finish common.Position, ok bool) {
+	start, forward := piece.Position(), piece.Color().Forward()
+	if RelativeRank(storage.Size(), piece.Color(), start) != 1 {
+		return common.Position{}, common.Position{}, false
+	}
+
+	middle = common.Position{
+		File: start.File + forward.File,
+		Rank: start.Rank + forward.Rank,
+	}
+	finish = common.Position{
+		File: middle.File + forward.File,
+		Rank: middle.Rank + forward.Rank,
+	}
+	if !common.HasPosition(storage, middle) ||
+		!common.HasPosition(storage, finish) {
+		return common.Position{}, common.Position{}, false
+	}
+
+	return middle, finish, true
+}
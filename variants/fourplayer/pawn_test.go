@@ -0,0 +1,148 @@
+package fourplayer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestPawnCheckMove(test *testing.T) {
+	type args struct {
+		placement string
+		start     common.Position
+		finish    common.Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"rPf5", common.Position{5, 4}, common.Position{5, 5}},
+			want: true,
+		},
+		{
+			args: args{"rPf5", common.Position{5, 4}, common.Position{5, 3}},
+			want: false,
+		},
+		{
+			args: args{"rPf5 bNg6", common.Position{5, 4}, common.Position{6, 5}},
+			want: true,
+		},
+		{
+			args: args{"rPf5 bNf6", common.Position{5, 4}, common.Position{5, 5}},
+			want: false,
+		},
+		{
+			args: args{"bPf5", common.Position{5, 4}, common.Position{6, 4}},
+			want: true,
+		},
+		{
+			args: args{"bPf5", common.Position{5, 4}, common.Position{5, 5}},
+			want: false,
+		},
+		{
+			args: args{"bPf5 yNg4", common.Position{5, 4}, common.Position{6, 3}},
+			want: true,
+		},
+		{
+			args: args{"yPf5", common.Position{5, 4}, common.Position{5, 3}},
+			want: true,
+		},
+		{
+			args: args{"yPf5 gNe4", common.Position{5, 4}, common.Position{4, 3}},
+			want: true,
+		},
+		{
+			args: args{"gPf5", common.Position{5, 4}, common.Position{4, 4}},
+			want: true,
+		},
+		{
+			args: args{"gPf5 rNe6", common.Position{5, 4}, common.Position{4, 5}},
+			want: true,
+		},
+		{
+			args: args{"gPf5 rNg6", common.Position{5, 4}, common.Position{6, 5}},
+			want: false,
+		},
+		{
+			args: args{"rPf2", common.Position{5, 1}, common.Position{5, 3}},
+			want: true,
+		},
+		{
+			args: args{"rPf2 bNf3", common.Position{5, 1}, common.Position{5, 3}},
+			want: false,
+		},
+		{
+			args: args{"rPf2 bNf4", common.Position{5, 1}, common.Position{5, 3}},
+			want: false,
+		},
+		{
+			args: args{"rPf3", common.Position{5, 2}, common.Position{5, 4}},
+			want: false,
+		},
+		{
+			args: args{"bPb5", common.Position{1, 4}, common.Position{3, 4}},
+			want: true,
+		},
+		{
+			args: args{"yPf13", common.Position{5, 12}, common.Position{5, 10}},
+			want: true,
+		},
+		{
+			args: args{"gPm5", common.Position{12, 4}, common.Position{10, 4}},
+			want: true,
+		},
+		{
+			args: args{"gPm5", common.Position{12, 4}, common.Position{14, 4}},
+			want: false,
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+		piece, _ := storage.Piece(data.args.start)
+		move := common.Move{Start: data.args.start, Finish: data.args.finish}
+		got := piece.CheckMove(move, storage)
+
+		if got != data.want {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
+
+func TestPawnReach(test *testing.T) {
+	type args struct {
+		placement string
+		position  common.Position
+	}
+	type data struct {
+		args args
+		want []common.Position
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"rPf2", common.Position{5, 1}},
+			want: []common.Position{{4, 2}, {5, 2}, {6, 2}, {5, 3}},
+		},
+		{
+			args: args{"rPf3", common.Position{5, 2}},
+			want: []common.Position{{4, 3}, {5, 3}, {6, 3}},
+		},
+		{
+			args: args{"bPb5", common.Position{1, 4}},
+			want: []common.Position{{2, 3}, {2, 4}, {2, 5}, {3, 4}},
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+		piece, _ := storage.Piece(data.args.position)
+		got := piece.(Pawn).Reach(storage)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package fourplayer
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Eliminations ...
+//
+// It's flags of eliminated players indexed by colors.
+type Eliminations [common.AllColorCount]bool
+
+// PieceStorage ...
+//
+// It wraps a piece storage and keeps eliminated players.
+// Pieces of an eliminated player are removed from the board.
+type PieceStorage struct {
+	common.PieceStorage
+
+	eliminations Eliminations
+}
+
+// NewPieceStorage ...
+func NewPieceStorage(
+	storage common.PieceStorage,
+	eliminations Eliminations,
+) PieceStorage {
+	return PieceStorage{PieceStorage: storage, eliminations: eliminations}
+}
+
+// Eliminations ...
+func (storage PieceStorage) Eliminations() Eliminations {
+	return storage.eliminations
+}
+
+// Geometry ...
+//
+// It returns the geometry of the wrapped storage, so the wrapper doesn't
+// hide it (see the common.GeometryGetter interface).
+func (storage PieceStorage) Geometry() common.Geometry {
+	return common.StorageGeometry(storage.PieceStorage)
+}
+
+// Eliminate ...
+//
+// It marks the player of the color as eliminated and removes its pieces.
+func (storage PieceStorage) Eliminate(color common.Color) PieceStorage {
+	for _, piece := range storage.PieceStorage.Pieces() {
+		if piece.Color() == color {
+			storage.PieceStorage = storage.PieceStorage.RemovePiece(piece.Position())
+		}
+	}
+
+	storage.eliminations[color] = true
+	return storage
+}
+
+// ApplyMove ...
+//
+// It eliminates the player whose king is captured by the move.
+func (storage PieceStorage) ApplyMove(move common.Move) common.PieceStorage {
+	target, hasTarget := storage.Piece(move.Finish)
+
+	nextStorage := storage.update(storage.PieceStorage.ApplyMove(move))
+	if hasTarget && target.Kind() == common.King {
+		nextStorage = nextStorage.Eliminate(target.Color())
+	}
+
+	return nextStorage
+}
+
+// SetPiece ...
+//
+// It doesn't change eliminated players.
+func (storage PieceStorage) SetPiece(piece common.Piece) common.PieceStorage {
+	return storage.update(storage.PieceStorage.SetPiece(piece))
+}
+
+// RemovePiece ...
+//
+// It doesn't change eliminated players.
+func (storage PieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return storage.update(storage.PieceStorage.RemovePiece(position))
+}
+
+func (storage PieceStorage) update(
+	baseStorage common.PieceStorage,
+) PieceStorage {
+	storage.PieceStorage = baseStorage
+	return storage
+}
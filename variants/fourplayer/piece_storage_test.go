@@ -0,0 +1,81 @@
+package fourplayer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestPieceStorageEliminate(test *testing.T) {
+	storage := makeStorage(test, "rKh1 rQh2 bKa7 bRb7 yKg14")
+	got := storage.Eliminate(common.Blue)
+
+	wantEliminations := Eliminations{common.Blue: true, common.Green: true}
+	if got.Eliminations() != wantEliminations {
+		test.Fail()
+	}
+	want := makeStorage(test, "rKh1 rQh2 yKg14").PieceStorage
+	if !reflect.DeepEqual(got.PieceStorage, want) {
+		test.Fail()
+	}
+	if storage.Eliminations()[common.Blue] {
+		test.Fail()
+	}
+}
+
+func TestPieceStorageApplyMove(test *testing.T) {
+	type args struct {
+		move common.Move
+	}
+	type data struct {
+		args             args
+		wantPlacement    string
+		wantEliminations Eliminations
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 7, Rank: 1},
+					Finish: common.Position{File: 7, Rank: 5},
+				},
+			},
+			wantPlacement:    "rKh1 rQh6 bKb8 bRb7 yKg14",
+			wantEliminations: Eliminations{common.Green: true},
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 7, Rank: 1},
+					Finish: common.Position{File: 1, Rank: 7},
+				},
+			},
+			wantPlacement:    "rKh1 rQb8 yKg14",
+			wantEliminations: Eliminations{common.Blue: true, common.Green: true},
+		},
+	} {
+		storage := makeStorage(test, "rKh1 rQh2 bKb8 bRb7 yKg14")
+		got := storage.ApplyMove(data.args.move).(PieceStorage)
+
+		want := makeStorage(test, data.wantPlacement).PieceStorage
+		if !reflect.DeepEqual(got.PieceStorage, want) {
+			test.Fail()
+		}
+		if got.Eliminations() != data.wantEliminations {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageGeometry(test *testing.T) {
+	storage := makeStorage(test, "rKh1")
+
+	if !reflect.DeepEqual(storage.Geometry(), NewMask()) {
+		test.Fail()
+	}
+	if common.HasPosition(storage, common.Position{File: 0, Rank: 0}) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,297 @@
+// Package fourplayer implements four-player chess.
+//
+// Red, blue, yellow and green players move in turn on the cross board 14x14
+// without corners 3x3 (see the common.Mask geometry). Each color has its own
+// direction of pawns, which are promoted on the eighth rank from its side.
+//
+// In a free-for-all game, a checkmated or stalemated player is eliminated
+// and the last player wins. In a team game, opposite players are partners
+// (red and yellow against blue and green), a checkmate of any player
+// is a win of the other team and a stalemate is a draw.
+//
+// A king can be left under attack of a player by a move of another one
+// (e.g. by a discovered attack). Then it can be captured, which eliminates
+// its player.
+package fourplayer
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+var promotionKinds = []common.Kind{
+	common.Queen,
+	common.Rook,
+	common.Bishop,
+	common.Knight,
+}
+
+// Rules ...
+//
+// It implements the models.Rules, models.MoveExpander, models.MoveFilter
+// and models.TurnOrder interfaces. A piece storage should be created
+// by WrapPieceStorage() with four-player pawns (see NewPieceFactory()),
+// otherwise players aren't eliminated.
+//
+// Since a king capture is a legal move here, illegal moves are removed
+// by FilterMoves(), so a move generator returns only legal moves.
+type Rules struct {
+	models.OrthodoxRules
+
+	IsTeamGame bool
+
+	// it's used for promotions; nil means NewPiece()
+	PieceFactory common.PieceFactory
+}
+
+// CheckMove ...
+//
+// A king capture is a regular capture. In a team game, a piece can't capture
+// pieces of the partner. A pawn move to the eighth rank from its side
+// should be a promotion.
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	if err := storage.CheckMove(move); err != nil &&
+		err != common.ErrKingCapture {
+		return err
+	}
+
+	piece, _ := storage.Piece(move.Start)
+	target, hasTarget := storage.Piece(move.Finish)
+	if hasTarget && !rules.isOpponent(piece.Color(), target.Color()) {
+		return common.ErrFriendlyTarget
+	}
+
+	return rules.promotions().CheckMove(move, isPawnPromotion(storage, move))
+}
+
+// ExpandMove ...
+//
+// It replaces a pawn move to the eighth rank from its side with promotions
+// to a queen, a rook, a bishop and a knight.
+func (rules Rules) ExpandMove(
+	storage common.PieceStorage,
+	move common.Move,
+) []common.Move {
+	return rules.promotions().ExpandMove(move, isPawnPromotion(storage, move))
+}
+
+// FilterMoves ...
+//
+// It removes moves that leave the king of the color under attack.
+func (rules Rules) FilterMoves(
+	storage common.PieceStorage,
+	color common.Color,
+	moves []common.Move,
+) []common.Move {
+	var legalMoves []common.Move
+	for _, move := range moves {
+		if rules.IsCheck(storage.ApplyMove(move), color) {
+			continue
+		}
+
+		legalMoves = append(legalMoves, move)
+	}
+
+	return legalMoves
+}
+
+// ApplyMove ...
+//
+// It replaces a pawn with a promoted piece. In a free-for-all game,
+// it also eliminates next players without legal moves.
+func (rules Rules) ApplyMove(
+	storage common.PieceStorage,
+	move common.Move,
+) common.PieceStorage {
+	piece, _ := storage.Piece(move.Start)
+
+	storage = rules.promotions().ApplyMove(storage, move)
+
+	fourPlayerStorage, ok := storage.(PieceStorage)
+	if !ok || rules.IsTeamGame {
+		return storage
+	}
+
+	for color := piece.Color(); ; {
+		color = rules.NextColor(fourPlayerStorage, color)
+		if rules.Termination(fourPlayerStorage, color).IsFinished() ||
+			rules.hasLegalMoves(fourPlayerStorage, color) {
+			break
+		}
+
+		fourPlayerStorage = fourPlayerStorage.Eliminate(color)
+	}
+
+	return fourPlayerStorage
+}
+
+// Termination ...
+//
+// In a free-for-all game, the last player wins. In a team game, elimination
+// of a player (i.e. a capture of its king) is a win of the other team.
+func (rules Rules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) models.Result {
+	fourPlayerStorage, ok := storage.(PieceStorage)
+	if !ok {
+		return models.Unfinished
+	}
+
+	eliminations := fourPlayerStorage.Eliminations()
+	var players []common.Color
+	for _, player := range Colors {
+		if eliminations[player] {
+			if rules.IsTeamGame {
+				return models.TeamWin(nextColor(player))
+			}
+
+			continue
+		}
+
+		players = append(players, player)
+	}
+	if len(players) != 1 {
+		return models.Unfinished
+	}
+
+	return models.Win(players[0])
+}
+
+// NoMovesResult ...
+//
+// In a team game, a checkmate is a win of the other team and a stalemate
+// is a draw. In a free-for-all game, players without legal moves are
+// eliminated by ApplyMove(), so it's possible only in a decoded position;
+// then the game is unfinished and the player should be eliminated
+// by PieceStorage.Eliminate().
+func (rules Rules) NoMovesResult(
+	color common.Color,
+	isCheck bool,
+) models.Result {
+	if !rules.IsTeamGame {
+		return models.Unfinished
+	}
+	if !isCheck {
+		return models.Draw
+	}
+
+	return models.TeamWin(nextColor(color))
+}
+
+// NextColor ...
+//
+// It returns the next color in order of turns (see Colors) skipping
+// eliminated players.
+func (rules Rules) NextColor(
+	storage common.PieceStorage,
+	color common.Color,
+) common.Color {
+	var eliminations Eliminations
+	if fourPlayerStorage, ok := storage.(PieceStorage); ok {
+		eliminations = fourPlayerStorage.Eliminations()
+	}
+
+	next := color
+	for range Colors {
+		next = nextColor(next)
+		if !eliminations[next] {
+			return next
+		}
+	}
+
+	return color
+}
+
+// IsCheck ...
+//
+// It checks that any opponent attacks the king of the color. In a team game,
+// the partner isn't an opponent.
+func (rules Rules) IsCheck(
+	storage common.PieceStorage,
+	color common.Color,
+) bool {
+	pieces := storage.Pieces()
+	for _, king := range pieces {
+		if king.Kind() != common.King || king.Color() != color {
+			continue
+		}
+
+		for _, piece := range pieces {
+			if !rules.isOpponent(piece.Color(), color) {
+				continue
+			}
+
+			move := common.Move{Start: piece.Position(), Finish: king.Position()}
+			if storage.CheckMove(move) == common.ErrKingCapture {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// it stops on the first piece with legal moves unlike a move generator
+func (rules Rules) hasLegalMoves(
+	storage common.PieceStorage,
+	color common.Color,
+) bool {
+	generator := models.MoveGenerator{Rules: rules}
+	for _, piece := range storage.Pieces() {
+		if piece.Color() != color {
+			continue
+		}
+
+		moves, _ := generator.MovesForPosition(storage, piece.Position())
+		if len(rules.FilterMoves(storage, color, moves)) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rules Rules) isOpponent(color common.Color, another common.Color) bool {
+	if rules.IsTeamGame && another == color.Partner() {
+		return false
+	}
+
+	return color != another
+}
+
+func (rules Rules) promotions() common.Promotions {
+	pieceFactory := rules.PieceFactory
+	if pieceFactory == nil {
+		pieceFactory = NewPiece
+	}
+
+	return common.Promotions{Kinds: promotionKinds, PieceFactory: pieceFactory}
+}
+
+func nextColor(color common.Color) common.Color {
+	for index, player := range Colors {
+		if player == color {
+			return Colors[(index+1)%len(Colors)]
+		}
+	}
+
+	return color.Negative()
+}
+
+func isPawnPromotion(storage common.PieceStorage, move common.Move) bool {
+	if move.IsDrop {
+		return false
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || piece.Kind() != common.Pawn {
+		return false
+	}
+
+	rank := RelativeRank(storage.Size(), piece.Color(), move.Finish)
+	return rank == PromotionRank
+}
@@ -0,0 +1,515 @@
+package fourplayer
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// the blue king on a7 is checkmated by the move d11a11
+const matePlacement = "rKh1 rRb10 rRd11 bKa7 yKh14"
+
+var mateMove = common.Move{
+	Start:  common.Position{File: 3, Rank: 10},
+	Finish: common.Position{File: 0, Rank: 10},
+}
+
+func TestRulesCheckMove(test *testing.T) {
+	type fields struct {
+		isTeamGame bool
+	}
+	type args struct {
+		placement string
+		move      common.Move
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   error
+	}
+
+	rookMove := common.Move{
+		Start:  common.Position{File: 3, Rank: 3},
+		Finish: common.Position{File: 3, Rank: 9},
+	}
+	for _, data := range []data{
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{"rRd4 gKd10", rookMove},
+			want:   nil,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{"rRd4 yNd10", rookMove},
+			want:   nil,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{"rRd4 yNd10", rookMove},
+			want:   common.ErrFriendlyTarget,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{"rRd4 bNd10", rookMove},
+			want:   nil,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{"rRd4 rNd10", rookMove},
+			want:   common.ErrFriendlyTarget,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{"rRd4 bNd6", rookMove},
+			want:   common.ErrIllegalMove,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args: args{"rPe7", common.Move{
+				Start:  common.Position{File: 4, Rank: 6},
+				Finish: common.Position{File: 4, Rank: 7},
+			}},
+			want: common.ErrIllegalMove,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args: args{"rPe7", common.Move{
+				Start:       common.Position{File: 4, Rank: 6},
+				Finish:      common.Position{File: 4, Rank: 7},
+				Promotion:   common.Queen,
+				IsPromotion: true,
+			}},
+			want: nil,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args: args{"rPe7", common.Move{
+				Start:       common.Position{File: 4, Rank: 6},
+				Finish:      common.Position{File: 4, Rank: 7},
+				Promotion:   common.King,
+				IsPromotion: true,
+			}},
+			want: common.ErrIllegalMove,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args: args{"rPe6", common.Move{
+				Start:       common.Position{File: 4, Rank: 5},
+				Finish:      common.Position{File: 4, Rank: 6},
+				Promotion:   common.Queen,
+				IsPromotion: true,
+			}},
+			want: common.ErrIllegalMove,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args: args{"bPg5", common.Move{
+				Start:       common.Position{File: 6, Rank: 4},
+				Finish:      common.Position{File: 7, Rank: 4},
+				Promotion:   common.Knight,
+				IsPromotion: true,
+			}},
+			want: nil,
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+		rules := Rules{IsTeamGame: data.fields.isTeamGame}
+		got := rules.CheckMove(storage, data.args.move)
+
+		if got != data.want {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesExpandMove(test *testing.T) {
+	storage := makeStorage(test, "gPh5")
+	move := common.Move{
+		Start:  common.Position{File: 7, Rank: 4},
+		Finish: common.Position{File: 6, Rank: 4},
+	}
+	got := Rules{}.ExpandMove(storage, move)
+
+	var want []common.Move
+	for _, kind := range promotionKinds {
+		move.Promotion, move.IsPromotion = kind, true
+		want = append(want, move)
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+
+	storage = makeStorage(test, "gPi5")
+	move = common.Move{
+		Start:  common.Position{File: 8, Rank: 4},
+		Finish: common.Position{File: 7, Rank: 4},
+	}
+	if (Rules{}).ExpandMove(storage, move) != nil {
+		test.Fail()
+	}
+}
+
+func TestRulesFilterMoves(test *testing.T) {
+	storage := makeStorage(test, "rKh1 rRh2 yRh10 bKa7")
+	moves := []common.Move{
+		{
+			Start:  common.Position{File: 7, Rank: 1},
+			Finish: common.Position{File: 6, Rank: 1},
+		},
+		{
+			Start:  common.Position{File: 7, Rank: 1},
+			Finish: common.Position{File: 7, Rank: 4},
+		},
+		{
+			Start:  common.Position{File: 7, Rank: 1},
+			Finish: common.Position{File: 7, Rank: 9},
+		},
+	}
+	got := Rules{}.FilterMoves(storage, common.Red, moves)
+
+	if !reflect.DeepEqual(got, moves[1:]) {
+		test.Fail()
+	}
+}
+
+func TestRulesIsCheck(test *testing.T) {
+	type fields struct {
+		isTeamGame bool
+	}
+	type args struct {
+		placement string
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   bool
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{"rKh1 yRh10"},
+			want:   true,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{"rKh1 yRh10"},
+			want:   false,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{"rKh1 gBd5"},
+			want:   true,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{"rKh1 rRh2 bRh10"},
+			want:   false,
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+		rules := Rules{IsTeamGame: data.fields.isTeamGame}
+		got := rules.IsCheck(storage, common.Red)
+
+		if got != data.want {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesNextColor(test *testing.T) {
+	type args struct {
+		placement string
+		color     common.Color
+	}
+	type data struct {
+		args args
+		want common.Color
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"rKh1 bKa8 yKg14 gKn7", common.Red},
+			want: common.Blue,
+		},
+		{
+			args: args{"rKh1 bKa8 yKg14 gKn7", common.Green},
+			want: common.Red,
+		},
+		{
+			args: args{"rKh1 yKg14", common.Red},
+			want: common.Yellow,
+		},
+		{
+			args: args{"rKh1 yKg14", common.Yellow},
+			want: common.Red,
+		},
+		{
+			args: args{"rKh1", common.Red},
+			want: common.Red,
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+		got := Rules{}.NextColor(storage, data.args.color)
+
+		if got != data.want {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesApplyMove(test *testing.T) {
+	storage := makeStorage(test, "rKh1 rPe7 bKa8 yKg14")
+	move := common.Move{
+		Start:       common.Position{File: 4, Rank: 6},
+		Finish:      common.Position{File: 4, Rank: 7},
+		Promotion:   common.Rook,
+		IsPromotion: true,
+	}
+	got := Rules{}.ApplyMove(storage, move)
+
+	piece, ok := got.Piece(move.Finish)
+	if !ok || piece.Kind() != common.Rook || piece.Color() != common.Red {
+		test.Fail()
+	}
+	if _, ok := got.Piece(move.Start); ok {
+		test.Fail()
+	}
+}
+
+func TestRulesApplyMove_withElimination(test *testing.T) {
+	storage := makeStorage(test, matePlacement)
+	got := Rules{}.ApplyMove(storage, mateMove).(PieceStorage)
+
+	wantEliminations := Eliminations{common.Blue: true, common.Green: true}
+	if got.Eliminations() != wantEliminations {
+		test.Fail()
+	}
+	if _, ok := got.Piece(common.Position{File: 0, Rank: 6}); ok {
+		test.Fail()
+	}
+	if (Rules{}).NextColor(got, common.Red) != common.Yellow {
+		test.Fail()
+	}
+	if (Rules{}).Termination(got, common.Yellow) != models.Unfinished {
+		test.Fail()
+	}
+
+	// in a team game, the checkmated player isn't eliminated
+	storage = makeStorage(test, matePlacement+" gKn7")
+	got = Rules{IsTeamGame: true}.ApplyMove(storage, mateMove).(PieceStorage)
+
+	if got.Eliminations() != (Eliminations{}) {
+		test.Fail()
+	}
+}
+
+func TestRulesTermination(test *testing.T) {
+	type fields struct {
+		isTeamGame bool
+	}
+	type args struct {
+		storage common.PieceStorage
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   models.Result
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{makeStorage(test, "rKh1 bKa8 yKg14")},
+			want:   models.Unfinished,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{makeStorage(test, "bKa8 bQa9")},
+			want:   models.BlueWin,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{makeStorage(test, "rKh1 bKa8 yKg14 gKn7")},
+			want:   models.Unfinished,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{makeStorage(test, "rKh1 bKa8 gKn7")},
+			want:   models.BlueAndGreenWin,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{makeStorage(test, "rKh1 yKg14 gKn7")},
+			want:   models.RedAndYellowWin,
+		},
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{boards.NewMapBoard(Size, nil)},
+			want:   models.Unfinished,
+		},
+	} {
+		rules := Rules{IsTeamGame: data.fields.isTeamGame}
+		got := rules.Termination(data.args.storage, common.Red)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesNoMovesResult(test *testing.T) {
+	type fields struct {
+		isTeamGame bool
+	}
+	type args struct {
+		color   common.Color
+		isCheck bool
+	}
+	type data struct {
+		fields fields
+		args   args
+		want   models.Result
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{isTeamGame: false},
+			args:   args{common.Blue, true},
+			want:   models.Unfinished,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{common.Blue, true},
+			want:   models.RedAndYellowWin,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{common.Yellow, true},
+			want:   models.BlueAndGreenWin,
+		},
+		{
+			fields: fields{isTeamGame: true},
+			args:   args{common.Blue, false},
+			want:   models.Draw,
+		},
+	} {
+		rules := Rules{IsTeamGame: data.fields.isTeamGame}
+		got := rules.NoMovesResult(data.args.color, data.args.isCheck)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveGenerator(test *testing.T) {
+	for _, pieceStorageFactory := range []uci.PieceStorageFactory{
+		boards.NewMapBoard,
+		boards.NewSliceBoard,
+		func(size common.Size, pieces []common.Piece) common.PieceStorage {
+			return boards.NewBitBoard(size, pieces, NewPiece)
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(InitialFEN, NewPiece, pieceStorageFactory)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		for _, color := range Colors {
+			generator := models.MoveGenerator{Rules: Rules{}}
+			moves, err := generator.LegalMovesForColor(storage, color)
+
+			// eight pawn steps, eight double steps and two moves of each knight
+			if len(moves) != 20 {
+				test.Fail()
+			}
+			if err != nil {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestMoveGeneratorMovesForColor_withKingCapture(test *testing.T) {
+	// the move of the red rook discovers the attack
+	// of the blue bishop on the green king
+	storage := makeStorage(test, "rKh1 rRf6 bBd4 bKa8 gKi9")
+	storage = Rules{}.ApplyMove(storage, common.Move{
+		Start:  common.Position{File: 5, Rank: 5},
+		Finish: common.Position{File: 5, Rank: 2},
+	}).(PieceStorage)
+
+	generator := models.MoveGenerator{Rules: Rules{}}
+	moves, err := generator.MovesForColor(storage, common.Blue)
+
+	var gotFinishes []string
+	for _, move := range moves {
+		if move.Start == (common.Position{File: 3, Rank: 3}) {
+			gotFinishes = append(gotFinishes, uci.EncodeMove(move))
+		}
+	}
+	sort.Strings(gotFinishes)
+
+	wantFinishes := []string{
+		"d4a7",
+		"d4b6",
+		"d4c5",
+		"d4e3",
+		"d4e5",
+		"d4f2",
+		"d4f6",
+		"d4g1",
+		"d4g7",
+		"d4h8",
+		"d4i9",
+	}
+	if !reflect.DeepEqual(gotFinishes, wantFinishes) {
+		test.Log(gotFinishes)
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestMoveGeneratorResult_withTeamGame(test *testing.T) {
+	storage := makeStorage(test, matePlacement+" gKn7")
+	rules := Rules{IsTeamGame: true}
+	storage = rules.ApplyMove(storage, mateMove).(PieceStorage)
+
+	generator := models.MoveGenerator{Rules: rules}
+	got, err := generator.Result(storage, common.Blue)
+
+	if got != models.RedAndYellowWin {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestPerft(test *testing.T) {
+	storage, err := DecodePieceStorage(InitialFEN, NewPiece, boards.NewMapBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	// each player has 20 moves (see TestMoveGenerator()), except that the red
+	// double step d2d4 blocks the blue double step b4d4
+	generator := models.MoveGenerator{Rules: Rules{}}
+	got := models.Perft(generator, storage, common.Red, 2, nil)
+
+	if got != 20*20-1 {
+		test.Fail()
+	}
+}
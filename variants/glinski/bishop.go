@@ -0,0 +1,33 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Bishop ...
+//
+// It moves any number of cells along six diagonal lines, i.e. through
+// vertices of cells. So it never changes a color of its cell, and there are
+// three bishops for three colors of cells.
+type Bishop struct{ pieces.Base }
+
+// NewBishop ...
+func NewBishop(color common.Color, position common.Position) Bishop {
+	base := pieces.NewBase(common.Bishop, color, position)
+	return Bishop{base}
+}
+
+// ApplyPosition ...
+func (piece Bishop) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Bishop{base}
+}
+
+// CheckMove ...
+func (piece Bishop) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return checkDirections(move, storage, diagonalDirections, true)
+}
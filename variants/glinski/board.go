@@ -0,0 +1,133 @@
+package glinski
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// Size ...
+//
+// It's a size of the square, in which the board hexagon of 91 cells
+// is inscribed (see the common.Hexagonal geometry).
+var Size = common.Size{Width: 11, Height: 11}
+
+// InitialFEN ...
+//
+// It's the initial position of Glinski's chess in the generic FEN
+// of the size 11x11, positions outside the hexagon are holes.
+const InitialFEN = "1prnqb*****/2p2bk****/3p1b1n***/4p3r**/5ppppp*/11/" +
+	"*PPPPP5/**R3P4/***N1B1P3/****QB2P2/*****BKNRP1"
+
+// WrapPieceStorage ...
+//
+// It provides the hexagonal geometry for the piece storage of the size 11x11
+// and wraps it by PieceStorage without an en passant target. A geometry
+// of the piece storage, if any, is replaced (e.g. a mask of holes after FEN
+// decoding).
+func WrapPieceStorage(storage common.PieceStorage) common.PieceStorage {
+	if glinskiStorage, ok := storage.(PieceStorage); ok {
+		storage = glinskiStorage.PieceStorage
+	}
+	if geometryBoard, ok := storage.(boards.GeometryBoard); ok {
+		storage = geometryBoard.PieceStorage
+	}
+
+	geometryBoard := boards.NewGeometryBoard(storage, common.Hexagonal{})
+	return PieceStorage{PieceStorage: geometryBoard}
+}
+
+// DecodePieceStorage ...
+//
+// It decodes a piece storage from the generic FEN of the size 11x11
+// and wraps it by WrapPieceStorage(). Positions outside the hexagon can be
+// either empty or holes.
+//
+// The piece factory should make hexagonal pieces, see NewPieceFactory().
+func DecodePieceStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	notation := uci.Notation{GeometryWrapper: boards.NewGeometryBoard}
+	storage, err :=
+		notation.DecodePieceStorage(fen, pieceFactory, pieceStorageFactory)
+	if err != nil {
+		return nil, err
+	}
+	if storage.Size() != Size {
+		return nil, fmt.Errorf("incorrect size %+v", storage.Size())
+	}
+
+	for _, piece := range storage.Pieces() {
+		if !(common.Hexagonal{}).HasPosition(Size, piece.Position()) {
+			return nil, fmt.Errorf("piece outside the board %+v", piece.Position())
+		}
+	}
+
+	return WrapPieceStorage(storage), nil
+}
+
+// EncodePieceStorage ...
+//
+// It converts the piece storage to the generic FEN of the size 11x11
+// (see DecodePieceStorage()), where positions outside the hexagon are holes.
+func EncodePieceStorage(storage common.PieceStorage) string {
+	var holes []common.Position
+	Size.IteratePositions(func(position common.Position) error { // nolint: errcheck
+		if !(common.Hexagonal{}).HasPosition(Size, position) {
+			holes = append(holes, position)
+		}
+
+		return nil
+	})
+
+	mask := common.NewMask(holes)
+	return uci.EncodePieceStorage(boards.NewGeometryBoard(storage, mask))
+}
+
+// IsLastPosition ...
+//
+// It checks that the position is the last one of its file relative
+// to the color, i.e. a pawn of the color is promoted there.
+func IsLastPosition(
+	size common.Size,
+	color common.Color,
+	position common.Position,
+) bool {
+	step := mirror(pawnMoveDirection, color)
+	nextPosition := common.Position{
+		File: position.File + step.file,
+		Rank: position.Rank + step.rank,
+	}
+	return !(common.Hexagonal{}).HasPosition(size, nextPosition)
+}
+
+// IsPawnStart ...
+//
+// It checks that the position is an initial one of a pawn of the color,
+// i.e. the pawn makes a double step from there. White pawns start
+// on the cells b1, c2, d3, e4, f5, g4, h3, i2 and k1.
+func IsPawnStart(color common.Color, position common.Position) bool {
+	radius := common.HexagonRadius(Size)
+	if color != common.White {
+		// see mirror()
+		position.Rank = 3*radius - position.File - position.Rank
+	}
+
+	if position.File < 1 || position.File > 2*radius-1 {
+		return false
+	}
+
+	return position.Rank == minInt(radius-1, 2*radius-1-position.File)
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
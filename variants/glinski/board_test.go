@@ -0,0 +1,209 @@
+package glinski
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+type checkMoveArgs struct {
+	placement string // pieces in Glinski's notation, e.g. "Rf6 pf8"
+	move      string
+}
+
+func makeStorage(test *testing.T, placement string) common.PieceStorage {
+	var pieceGroup []common.Piece
+	for _, token := range strings.Fields(placement) {
+		piece, err := uci.DecodePiece(rune(token[0]), NewPiece)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		position, err := DecodePosition(token[1:])
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		pieceGroup = append(pieceGroup, piece.ApplyPosition(position))
+	}
+
+	return WrapPieceStorage(boards.NewMapBoard(Size, pieceGroup))
+}
+
+func checkPieceMove(test *testing.T, args checkMoveArgs) bool {
+	storage := makeStorage(test, args.placement)
+
+	move, err := DecodeMove(args.move)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok {
+		test.Fatal("no piece")
+	}
+
+	return piece.CheckMove(move, storage)
+}
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		fen string
+	}
+	type data struct {
+		args         args
+		wantPieces   int
+		wantErr      bool
+		wantGeometry common.Geometry
+	}
+
+	for _, data := range []data{
+		{
+			args:         args{InitialFEN},
+			wantPieces:   36,
+			wantErr:      false,
+			wantGeometry: common.Hexagonal{},
+		},
+		{
+			args:    args{"8/8/8/8/8/8/8/8"},
+			wantErr: true,
+		},
+		{
+			args:    args{"11/11/11/11/11/11/11/11/11/11/K10"},
+			wantErr: true,
+		},
+		{
+			args:    args{"11/11/11/11/11/11/11/11/11/11/x10"},
+			wantErr: true,
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(data.args.fen, NewPiece, boards.NewMapBoard)
+
+		if data.wantErr {
+			if err == nil {
+				test.Fail()
+			}
+
+			continue
+		}
+
+		if err != nil || len(storage.Pieces()) != data.wantPieces {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(common.StorageGeometry(storage), data.wantGeometry) {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePieceStorage(test *testing.T) {
+	type args struct {
+		fen string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{InitialFEN},
+			want: InitialFEN,
+		},
+		{
+			// positions outside the hexagon are empty
+			args: args{"11/11/11/11/11/11/11/11/11/11/5K5"},
+			want: "6*****/7****/8***/9**/10*/11/*10/**9/***8/****7/*****K5",
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(data.args.fen, NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := EncodePieceStorage(storage)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsLastPosition(test *testing.T) {
+	type args struct {
+		color    common.Color
+		position string
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{args: args{common.White, "f11"}, want: true},
+		{args: args{common.White, "f10"}, want: false},
+		{args: args{common.White, "a6"}, want: true},
+		{args: args{common.White, "l6"}, want: true},
+		{args: args{common.White, "l1"}, want: false},
+		{args: args{common.Black, "f1"}, want: true},
+		{args: args{common.Black, "a1"}, want: true},
+		{args: args{common.Black, "l1"}, want: true},
+		{args: args{common.Black, "f11"}, want: false},
+	} {
+		position, err := DecodePosition(data.args.position)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := IsLastPosition(Size, data.args.color, position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsPawnStart(test *testing.T) {
+	type args struct {
+		color    common.Color
+		position string
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{args: args{common.White, "b1"}, want: true},
+		{args: args{common.White, "f5"}, want: true},
+		{args: args{common.White, "g4"}, want: true},
+		{args: args{common.White, "k1"}, want: true},
+		{args: args{common.White, "a1"}, want: false},
+		{args: args{common.White, "l1"}, want: false},
+		{args: args{common.White, "f4"}, want: false},
+		{args: args{common.White, "f7"}, want: false},
+		{args: args{common.Black, "b7"}, want: true},
+		{args: args{common.Black, "f7"}, want: true},
+		{args: args{common.Black, "k7"}, want: true},
+		{args: args{common.Black, "f5"}, want: false},
+		{args: args{common.Black, "a6"}, want: false},
+	} {
+		position, err := DecodePosition(data.args.position)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := IsPawnStart(data.args.color, position)
+
+		if got != data.want {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
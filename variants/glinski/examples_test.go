@@ -0,0 +1,34 @@
+package glinski_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/glinski"
+)
+
+func ExampleRules() {
+	storage, _ := glinski.DecodePieceStorage(
+		glinski.InitialFEN,
+		glinski.NewPiece,
+		boards.NewMapBoard,
+	)
+
+	generator := models.MoveGenerator{Rules: glinski.Rules{}}
+	moves, _ := generator.LegalMovesForColor(storage, common.White)
+	fmt.Println(len(moves))
+
+	position, _ := glinski.DecodePosition("c1")
+	moves, _ = generator.MovesForPosition(storage, position)
+	for _, move := range moves {
+		fmt.Println(glinski.EncodeMove(move))
+	}
+
+	// Output:
+	// 51
+	// c1d2
+	// c1e3
+	// c1f4
+}
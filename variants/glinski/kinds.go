@@ -0,0 +1,50 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// NewPiece ...
+//
+// It makes hexagonal pieces of built-in orthodox kinds.
+// It returns nil for other kinds.
+func NewPiece(
+	kind common.Kind,
+	color common.Color,
+	position common.Position,
+) common.Piece {
+	return NewPieceFactory(nil)(kind, color, position)
+}
+
+// NewPieceFactory ...
+//
+// It returns a factory, which makes hexagonal pieces and uses the fallback
+// factory for other kinds. The fallback can be nil.
+func NewPieceFactory(fallback common.PieceFactory) common.PieceFactory {
+	return func(
+		kind common.Kind,
+		color common.Color,
+		position common.Position,
+	) common.Piece {
+		switch kind {
+		case common.King:
+			return NewKing(color, position)
+		case common.Queen:
+			return NewQueen(color, position)
+		case common.Rook:
+			return NewRook(color, position)
+		case common.Bishop:
+			return NewBishop(color, position)
+		case common.Knight:
+			return NewKnight(color, position)
+		case common.Pawn:
+			return NewPawn(color, position)
+		}
+
+		if fallback == nil {
+			return nil
+		}
+
+		return fallback(kind, color, position)
+	}
+}
@@ -0,0 +1,31 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// King ...
+//
+// It moves one cell in any of six orthogonal and six diagonal directions.
+type King struct{ pieces.Base }
+
+// NewKing ...
+func NewKing(color common.Color, position common.Position) King {
+	base := pieces.NewBase(common.King, color, position)
+	return King{base}
+}
+
+// ApplyPosition ...
+func (piece King) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return King{base}
+}
+
+// CheckMove ...
+func (piece King) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return checkDirections(move, storage, allDirections, false)
+}
@@ -0,0 +1,32 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Knight ...
+//
+// It jumps to the nearest cells that aren't on the same orthogonal
+// or diagonal line, there are twelve of them.
+type Knight struct{ pieces.Base }
+
+// NewKnight ...
+func NewKnight(color common.Color, position common.Position) Knight {
+	base := pieces.NewBase(common.Knight, color, position)
+	return Knight{base}
+}
+
+// ApplyPosition ...
+func (piece Knight) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Knight{base}
+}
+
+// CheckMove ...
+func (piece Knight) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return checkDirections(move, storage, knightDirections, false)
+}
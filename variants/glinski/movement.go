@@ -0,0 +1,126 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// it's a step in axial coordinates for white;
+// for black it's mirrored vertically (see mirror())
+type direction struct {
+	file int
+	rank int
+}
+
+// see the common.Hexagonal geometry
+var (
+	orthogonalDirections = []direction{
+		{0, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1},
+	}
+	diagonalDirections = []direction{
+		{1, 1}, {2, -1}, {1, -2}, {-1, -1}, {-2, 1}, {-1, 2},
+	}
+	knightDirections = []direction{
+		{1, 2}, {2, 1}, {3, -1}, {3, -2}, {2, -3}, {1, -3},
+		{-1, -2}, {-2, -1}, {-3, 1}, {-3, 2}, {-2, 3}, {-1, 3},
+	}
+	allDirections = append(
+		append([]direction(nil), orthogonalDirections...),
+		diagonalDirections...,
+	)
+
+	pawnMoveDirection     = direction{0, 1}
+	pawnCaptureDirections = []direction{{1, 0}, {-1, 1}}
+)
+
+// it maps a step for white to the one for the color; a vertical mirror
+// keeps a file of a step and a sum of a file and a rank of a cell
+// is inverted relative to the hexagon center
+func mirror(step direction, color common.Color) direction {
+	if color == common.White {
+		return step
+	}
+
+	return direction{step.file, -step.file - step.rank}
+}
+
+// it checks that the move makes one step in one of the directions mirrored
+// for the color
+func hasDirection(
+	move common.Move,
+	color common.Color,
+	directions []direction,
+) bool {
+	delta := moveDelta(move)
+	for _, step := range directions {
+		if mirror(step, color) == delta {
+			return true
+		}
+	}
+
+	return false
+}
+
+func moveDelta(move common.Move) direction {
+	return direction{
+		file: move.Finish.File - move.Start.File,
+		rank: move.Finish.Rank - move.Start.Rank,
+	}
+}
+
+// it checks that the move makes one step (a leaper) or any number of steps
+// (a rider) in one of the directions; a rider is blocked by any piece
+// or any position, which isn't a cell of the board, on its path
+func checkDirections(
+	move common.Move,
+	storage common.PieceStorage,
+	directions []direction,
+	isRider bool,
+) bool {
+	delta := moveDelta(move)
+	for _, step := range directions {
+		count, ok := stepCount(delta, step)
+		if !ok || (!isRider && count != 1) {
+			continue
+		}
+
+		for i := 1; i < count; i++ {
+			position := common.Position{
+				File: move.Start.File + step.file*i,
+				Rank: move.Start.Rank + step.rank*i,
+			}
+			if !common.HasPosition(storage, position) {
+				return false
+			}
+			if _, ok := storage.Piece(position); ok {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// it returns a positive number of steps that gives the delta
+func stepCount(delta direction, step direction) (count int, ok bool) {
+	if step.file != 0 {
+		if delta.file%step.file != 0 {
+			return 0, false
+		}
+
+		count = delta.file / step.file
+	} else {
+		if delta.file != 0 || delta.rank%step.rank != 0 {
+			return 0, false
+		}
+
+		count = delta.rank / step.rank
+	}
+
+	if count <= 0 || delta.rank != step.rank*count {
+		return 0, false
+	}
+
+	return count, true
+}
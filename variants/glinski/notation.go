@@ -0,0 +1,120 @@
+package glinski
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// files are named without the "j" letter
+const fileSymbols = "abcdefghikl"
+
+// DecodePosition ...
+//
+// It decodes a position from Glinski's notation, where ranks are counted
+// along each file from its first cell for white (e.g. "f1" or "f11").
+func DecodePosition(text string) (position common.Position, err error) {
+	if len(text) < 2 {
+		return common.Position{}, errors.New("incorrect length")
+	}
+
+	file := strings.IndexByte(fileSymbols, text[0])
+	if file == -1 {
+		return common.Position{}, errors.New("incorrect file")
+	}
+
+	rank, err := strconv.Atoi(text[1:])
+	if err != nil {
+		return common.Position{}, fmt.Errorf("incorrect rank: %s", err)
+	}
+
+	position = common.Position{File: file, Rank: firstRank(file) + rank - 1}
+	if rank < 1 || !(common.Hexagonal{}).HasPosition(Size, position) {
+		return common.Position{}, errors.New("out of the board")
+	}
+
+	return position, nil
+}
+
+// DecodeMove ...
+//
+// It decodes a move from Glinski's notation of its start and finish
+// (see DecodePosition()). An optional last symbol is a kind of a promoted
+// piece (e.g. "f10f11q").
+func DecodeMove(text string) (move common.Move, err error) {
+	if len(text) < 4 {
+		return common.Move{}, errors.New("incorrect length")
+	}
+
+	finishIndex := strings.IndexFunc(text[1:], unicode.IsLetter)
+	if finishIndex == -1 {
+		return common.Move{}, errors.New("no finish")
+	}
+	finishIndex++
+
+	promotionIndex := len(text)
+	if lastSymbol := rune(text[len(text)-1]); unicode.IsLetter(lastSymbol) {
+		promotionIndex--
+	}
+
+	start, err := DecodePosition(text[:finishIndex])
+	if err != nil {
+		return common.Move{}, fmt.Errorf("incorrect start: %s", err)
+	}
+
+	finish, err := DecodePosition(text[finishIndex:promotionIndex])
+	if err != nil {
+		return common.Move{}, fmt.Errorf("incorrect finish: %s", err)
+	}
+
+	move = common.Move{Start: start, Finish: finish}
+	if promotionIndex != len(text) {
+		kind, ok := common.LookupKindBySymbol(rune(text[promotionIndex]))
+		if !ok {
+			return common.Move{}, errors.New("incorrect promotion")
+		}
+
+		move.Promotion, move.IsPromotion = kind, true
+	}
+
+	return move, nil
+}
+
+// EncodePosition ...
+//
+// It converts the position of the board to Glinski's notation
+// (see DecodePosition()).
+func EncodePosition(position common.Position) string {
+	file := string(fileSymbols[position.File])
+	rank := strconv.Itoa(position.Rank - firstRank(position.File) + 1)
+	return file + rank
+}
+
+// EncodeMove ...
+//
+// It converts the move to Glinski's notation (see DecodeMove()).
+func EncodeMove(move common.Move) string {
+	start := EncodePosition(move.Start)
+	finish := EncodePosition(move.Finish)
+	if !move.IsPromotion {
+		return start + finish
+	}
+
+	descriptor, _ := common.LookupKind(move.Promotion)
+	promotion := string(unicode.ToLower(descriptor.Symbol))
+	return start + finish + promotion
+}
+
+// it returns an axial rank of the first cell of the file for white
+func firstRank(file int) int {
+	radius := common.HexagonRadius(Size)
+	if file > radius {
+		return 0
+	}
+
+	return radius - file
+}
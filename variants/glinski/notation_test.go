@@ -0,0 +1,173 @@
+package glinski
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestDecodePosition(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args         args
+		wantPosition common.Position
+		wantErr      bool
+	}
+
+	for _, data := range []data{
+		{
+			args:         args{"f6"},
+			wantPosition: common.Position{File: 5, Rank: 5},
+		},
+		{
+			args:         args{"a1"},
+			wantPosition: common.Position{File: 0, Rank: 5},
+		},
+		{
+			args:         args{"l1"},
+			wantPosition: common.Position{File: 10, Rank: 0},
+		},
+		{
+			args:         args{"f11"},
+			wantPosition: common.Position{File: 5, Rank: 10},
+		},
+		{
+			args:         args{"g10"},
+			wantPosition: common.Position{File: 6, Rank: 9},
+		},
+		{
+			args:    args{"f"},
+			wantErr: true,
+		},
+		{
+			args:    args{"j1"},
+			wantErr: true,
+		},
+		{
+			args:    args{"fx"},
+			wantErr: true,
+		},
+		{
+			args:    args{"f0"},
+			wantErr: true,
+		},
+		{
+			args:    args{"a7"},
+			wantErr: true,
+		},
+		{
+			args:    args{"f12"},
+			wantErr: true,
+		},
+	} {
+		gotPosition, gotErr := DecodePosition(data.args.text)
+
+		if !reflect.DeepEqual(gotPosition, data.wantPosition) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodeMove(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args     args
+		wantMove common.Move
+		wantErr  bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"a1l1"},
+			wantMove: common.Move{
+				Start:  common.Position{File: 0, Rank: 5},
+				Finish: common.Position{File: 10, Rank: 0},
+			},
+		},
+		{
+			args: args{"f10f11q"},
+			wantMove: common.Move{
+				Start:       common.Position{File: 5, Rank: 9},
+				Finish:      common.Position{File: 5, Rank: 10},
+				Promotion:   common.Queen,
+				IsPromotion: true,
+			},
+		},
+		{
+			args:    args{"f6"},
+			wantErr: true,
+		},
+		{
+			args:    args{"f678"},
+			wantErr: true,
+		},
+		{
+			args:    args{"f6a7"},
+			wantErr: true,
+		},
+		{
+			args:    args{"j6f7"},
+			wantErr: true,
+		},
+		{
+			args:    args{"f10f11x"},
+			wantErr: true,
+		},
+	} {
+		gotMove, gotErr := DecodeMove(data.args.text)
+
+		if !reflect.DeepEqual(gotMove, data.wantMove) {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeMove(test *testing.T) {
+	type args struct {
+		move common.Move
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				move: common.Move{
+					Start:  common.Position{File: 0, Rank: 5},
+					Finish: common.Position{File: 10, Rank: 0},
+				},
+			},
+			want: "a1l1",
+		},
+		{
+			args: args{
+				move: common.Move{
+					Start:       common.Position{File: 5, Rank: 9},
+					Finish:      common.Position{File: 5, Rank: 10},
+					Promotion:   common.Knight,
+					IsPromotion: true,
+				},
+			},
+			want: "f10f11n",
+		},
+	} {
+		got := EncodeMove(data.args.move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
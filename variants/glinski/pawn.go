@@ -0,0 +1,38 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Pawn ...
+//
+// It moves one cell forward (along its file) and captures one cell
+// forward-left or forward-right (through edges of cells). A double step
+// and an en passant capture are checked by Rules.
+type Pawn struct{ pieces.Base }
+
+// NewPawn ...
+func NewPawn(color common.Color, position common.Position) Pawn {
+	base := pieces.NewBase(common.Pawn, color, position)
+	return Pawn{base}
+}
+
+// ApplyPosition ...
+func (piece Pawn) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Pawn{base}
+}
+
+// CheckMove ...
+func (piece Pawn) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	directions := []direction{pawnMoveDirection}
+	if _, ok := storage.Piece(move.Finish); ok {
+		directions = pawnCaptureDirections
+	}
+
+	return hasDirection(move, piece.Color(), directions)
+}
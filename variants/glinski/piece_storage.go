@@ -0,0 +1,125 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// PieceStorage ...
+//
+// It wraps a piece storage with the hexagonal geometry (see WrapPieceStorage())
+// and keeps an en passant target, i.e. a cell skipped by a pawn double step
+// on the last move.
+//
+// It doesn't forward the common.SparsenessChecker interface, because a pawn
+// double step goes beyond a reach of pieces.
+type PieceStorage struct {
+	common.PieceStorage
+
+	enPassant    common.Position
+	hasEnPassant bool
+}
+
+// EnPassant ...
+//
+// It returns an en passant target, if any.
+func (storage PieceStorage) EnPassant() (target common.Position, ok bool) {
+	return storage.enPassant, storage.hasEnPassant
+}
+
+// Geometry ...
+//
+// It returns the geometry of the wrapped storage, so the wrapper doesn't
+// hide it (see the common.GeometryGetter interface).
+func (storage PieceStorage) Geometry() common.Geometry {
+	return common.StorageGeometry(storage.PieceStorage)
+}
+
+// ApplyMove ...
+//
+// It processes an en passant capture and sets the en passant target
+// after a pawn double step.
+//
+// It doesn't check that the move is correct.
+func (storage PieceStorage) ApplyMove(move common.Move) common.PieceStorage {
+	nextStorage := storage
+	nextStorage.enPassant, nextStorage.hasEnPassant = common.Position{}, false
+
+	if storage.isEnPassant(move) {
+		piece, _ := storage.Piece(move.Start)
+		nextStorage.PieceStorage = storage.PieceStorage.
+			ApplyMove(move).
+			RemovePiece(enPassantCapture(piece.Color(), move))
+
+		return nextStorage
+	}
+
+	nextStorage.PieceStorage = storage.PieceStorage.ApplyMove(move)
+
+	piece, ok := storage.Piece(move.Start)
+	if ok && !move.IsDrop && piece.Kind() == common.Pawn {
+		step := mirror(pawnMoveDirection, piece.Color())
+		if moveDelta(move) == (direction{2 * step.file, 2 * step.rank}) {
+			nextStorage.enPassant = common.Position{
+				File: move.Start.File + step.file,
+				Rank: move.Start.Rank + step.rank,
+			}
+			nextStorage.hasEnPassant = true
+		}
+	}
+
+	return nextStorage
+}
+
+// SetPiece ...
+//
+// It doesn't change the en passant target.
+func (storage PieceStorage) SetPiece(piece common.Piece) common.PieceStorage {
+	return storage.update(storage.PieceStorage.SetPiece(piece))
+}
+
+// RemovePiece ...
+//
+// It doesn't change the en passant target.
+func (storage PieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return storage.update(storage.PieceStorage.RemovePiece(position))
+}
+
+func (storage PieceStorage) update(
+	baseStorage common.PieceStorage,
+) PieceStorage {
+	storage.PieceStorage = baseStorage
+	return storage
+}
+
+// it checks that the move is a capture of a pawn en passant;
+// the move should go to the en passant target in a capture direction
+func (storage PieceStorage) isEnPassant(move common.Move) bool {
+	if !storage.hasEnPassant || move.IsDrop || move.Finish != storage.enPassant {
+		return false
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || piece.Kind() != common.Pawn {
+		return false
+	}
+
+	if !hasDirection(move, piece.Color(), pawnCaptureDirections) {
+		return false
+	}
+
+	captured, ok := storage.Piece(enPassantCapture(piece.Color(), move))
+	return ok && captured.Kind() == common.Pawn &&
+		captured.Color() != piece.Color()
+}
+
+// it returns a position of a pawn captured en passant by the move
+// of a pawn of the color, i.e. a cell behind the en passant target
+func enPassantCapture(color common.Color, move common.Move) common.Position {
+	step := mirror(pawnMoveDirection, color)
+	return common.Position{
+		File: move.Finish.File - step.file,
+		Rank: move.Finish.Rank - step.rank,
+	}
+}
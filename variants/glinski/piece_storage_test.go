@@ -0,0 +1,63 @@
+package glinski
+
+import (
+	"testing"
+)
+
+func TestPieceStorageApplyMove(test *testing.T) {
+	type args struct {
+		placement string
+		moves     []string
+	}
+	type data struct {
+		args          args
+		wantEnPassant string
+	}
+
+	for _, data := range []data{
+		{
+			args:          args{"Pe4", []string{"e4e6"}},
+			wantEnPassant: "e5",
+		},
+		{
+			args:          args{"Pg4", []string{"g4g6"}},
+			wantEnPassant: "g5",
+		},
+		{
+			args:          args{"pe7", []string{"e7e5"}},
+			wantEnPassant: "e6",
+		},
+		{
+			args:          args{"Pe4", []string{"e4e5"}},
+			wantEnPassant: "",
+		},
+		{
+			args:          args{"Pe4 ra1", []string{"e4e6", "a1a2"}},
+			wantEnPassant: "",
+		},
+		{
+			args:          args{"Re4", []string{"e4e6"}},
+			wantEnPassant: "",
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+		for _, text := range data.args.moves {
+			move, err := DecodeMove(text)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			storage = storage.ApplyMove(move)
+		}
+
+		var got string
+		if target, ok := storage.(PieceStorage).EnPassant(); ok {
+			got = EncodePosition(target)
+		}
+
+		if got != data.wantEnPassant {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package glinski
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewPiece(test *testing.T) {
+	position := common.Position{File: 5, Rank: 5}
+	for _, data := range []struct {
+		kind common.Kind
+		want common.Piece
+	}{
+		{common.King, NewKing(common.White, position)},
+		{common.Queen, NewQueen(common.White, position)},
+		{common.Rook, NewRook(common.White, position)},
+		{common.Bishop, NewBishop(common.White, position)},
+		{common.Knight, NewKnight(common.White, position)},
+		{common.Pawn, NewPawn(common.White, position)},
+		{common.Amazon, nil},
+	} {
+		got := NewPiece(data.kind, common.White, position)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestNewPieceFactory(test *testing.T) {
+	position := common.Position{File: 5, Rank: 5}
+	factory := NewPieceFactory(pieces.NewPiece)
+
+	if _, ok := factory(common.Rook, common.White, position).(Rook); !ok {
+		test.Fail()
+	}
+	if _, ok :=
+		factory(common.Amazon, common.White, position).(pieces.Amazon); !ok {
+		test.Fail()
+	}
+}
+
+func TestPieceApplyPosition(test *testing.T) {
+	position := common.Position{File: 4, Rank: 6}
+	piece := NewBishop(common.Black, common.Position{File: 5, Rank: 5})
+	nextPiece := piece.ApplyPosition(position)
+
+	expectedNextPiece := NewBishop(common.Black, position)
+	if !reflect.DeepEqual(nextPiece, expectedNextPiece) {
+		test.Fail()
+	}
+}
+
+func TestPieceCheckMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want bool
+	}
+
+	for _, data := range []data{
+		// king
+		{args: checkMoveArgs{"Kf6", "f6f7"}, want: true},
+		{args: checkMoveArgs{"Kf6", "f6g7"}, want: true},
+		{args: checkMoveArgs{"Kf6", "f6h5"}, want: true},
+		{args: checkMoveArgs{"Kf6", "f6f8"}, want: false},
+		{args: checkMoveArgs{"Kf6", "f6g8"}, want: false},
+
+		// rook
+		{args: checkMoveArgs{"Rf6", "f6f11"}, want: true},
+		{args: checkMoveArgs{"Rf6", "f6a1"}, want: true},
+		{args: checkMoveArgs{"Rf6", "f6l6"}, want: true},
+		{args: checkMoveArgs{"Rf6", "f6g7"}, want: false},
+		{args: checkMoveArgs{"Rf6 pf8", "f6f8"}, want: true},
+		{args: checkMoveArgs{"Rf6 pf8", "f6f10"}, want: false},
+
+		// bishop
+		{args: checkMoveArgs{"Bf6", "f6g7"}, want: true},
+		{args: checkMoveArgs{"Bf6", "f6h5"}, want: true},
+		{args: checkMoveArgs{"Bf6", "f6h8"}, want: true},
+		{args: checkMoveArgs{"Bf6", "f6f7"}, want: false},
+		{args: checkMoveArgs{"Bf6 Pg7", "f6h8"}, want: false},
+		{args: checkMoveArgs{"Bf6 Pf7 Pg6", "f6g7"}, want: true},
+
+		// queen
+		{args: checkMoveArgs{"Qf6", "f6f11"}, want: true},
+		{args: checkMoveArgs{"Qf6", "f6h8"}, want: true},
+		{args: checkMoveArgs{"Qf6", "f6g8"}, want: false},
+		{args: checkMoveArgs{"Qf6 pf7", "f6f8"}, want: false},
+
+		// knight
+		{args: checkMoveArgs{"Nf6", "f6g8"}, want: true},
+		{args: checkMoveArgs{"Nf6 Pf7 Pg7 Pg6", "f6g8"}, want: true},
+		{args: checkMoveArgs{"Nf6", "f6f9"}, want: false},
+		{args: checkMoveArgs{"Nf6", "f6h8"}, want: false},
+
+		// pawn
+		{args: checkMoveArgs{"Pf6", "f6f7"}, want: true},
+		{args: checkMoveArgs{"Pf6", "f6f5"}, want: false},
+		{args: checkMoveArgs{"Pf6 pf7", "f6f7"}, want: false},
+		{args: checkMoveArgs{"Pf6", "f6g6"}, want: false},
+		{args: checkMoveArgs{"Pf6 pg6", "f6g6"}, want: true},
+		{args: checkMoveArgs{"Pf6 pe6", "f6e6"}, want: true},
+		{args: checkMoveArgs{"Pf6 pg7", "f6g7"}, want: false},
+		{args: checkMoveArgs{"pf6", "f6f5"}, want: true},
+		{args: checkMoveArgs{"pf6", "f6f7"}, want: false},
+		{args: checkMoveArgs{"pf6 Pg5", "f6g5"}, want: true},
+		{args: checkMoveArgs{"pf6 Pe5", "f6e5"}, want: true},
+		{args: checkMoveArgs{"pf6 Pg6", "f6g6"}, want: false},
+	} {
+		got := checkPieceMove(test, data.args)
+
+		if got != data.want {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
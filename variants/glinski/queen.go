@@ -0,0 +1,31 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Queen ...
+//
+// It combines moves of a rook and a bishop.
+type Queen struct{ pieces.Base }
+
+// NewQueen ...
+func NewQueen(color common.Color, position common.Position) Queen {
+	base := pieces.NewBase(common.Queen, color, position)
+	return Queen{base}
+}
+
+// ApplyPosition ...
+func (piece Queen) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Queen{base}
+}
+
+// CheckMove ...
+func (piece Queen) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return checkDirections(move, storage, allDirections, true)
+}
@@ -0,0 +1,32 @@
+package glinski
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Rook ...
+//
+// It moves any number of cells along six orthogonal lines,
+// i.e. through edges of cells.
+type Rook struct{ pieces.Base }
+
+// NewRook ...
+func NewRook(color common.Color, position common.Position) Rook {
+	base := pieces.NewBase(common.Rook, color, position)
+	return Rook{base}
+}
+
+// ApplyPosition ...
+func (piece Rook) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Rook{base}
+}
+
+// CheckMove ...
+func (piece Rook) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return checkDirections(move, storage, orthogonalDirections, true)
+}
@@ -0,0 +1,142 @@
+// Package glinski implements Glinski's hexagonal chess.
+//
+// It provides pieces moving on a hexagonal board of 91 cells in axial
+// coordinates (see the common.Hexagonal geometry), pawn double steps,
+// en passant captures, promotions of pawns on the last cells of their files
+// and Glinski's notation of positions. White starts on lower ranks.
+package glinski
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+var promotionKinds = []common.Kind{
+	common.Queen,
+	common.Rook,
+	common.Bishop,
+	common.Knight,
+}
+
+// Rules ...
+//
+// It implements the models.Rules and models.MoveExpander interfaces.
+// Moves except special pawn ones are checked by pieces, so a piece storage
+// should be created with hexagonal pieces (see NewPieceFactory()); it should
+// be wrapped by WrapPieceStorage() for the hexagonal geometry and the special
+// pawn moves.
+//
+// A checkmate is a win and a stalemate is a draw (originally, the latter
+// gives 3/4 of a point to the stalemating player).
+type Rules struct {
+	models.OrthodoxRules
+
+	// it's used for promotions; nil means NewPiece()
+	PieceFactory common.PieceFactory
+}
+
+// CheckMove ...
+//
+// A pawn makes a double step from its initial cell (see IsPawnStart())
+// and an en passant capture on a cell skipped by a double step of an enemy
+// pawn on the last move. A pawn move to the last position of its file should
+// be a promotion.
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	if !isSpecialPawnMove(storage, move) {
+		if err := storage.CheckMove(move); err != nil {
+			return err
+		}
+	}
+
+	return rules.promotions().CheckMove(move, isPawnPromotion(storage, move))
+}
+
+// ExpandMove ...
+//
+// It replaces a pawn move to the last position of its file with promotions
+// to a queen, a rook, a bishop and a knight.
+func (rules Rules) ExpandMove(
+	storage common.PieceStorage,
+	move common.Move,
+) []common.Move {
+	return rules.promotions().ExpandMove(move, isPawnPromotion(storage, move))
+}
+
+// ApplyMove ...
+//
+// It replaces a pawn with a promoted piece.
+func (rules Rules) ApplyMove(
+	storage common.PieceStorage,
+	move common.Move,
+) common.PieceStorage {
+	return rules.promotions().ApplyMove(storage, move)
+}
+
+func (rules Rules) promotions() common.Promotions {
+	pieceFactory := rules.PieceFactory
+	if pieceFactory == nil {
+		pieceFactory = NewPiece
+	}
+
+	return common.Promotions{Kinds: promotionKinds, PieceFactory: pieceFactory}
+}
+
+func isPawnPromotion(storage common.PieceStorage, move common.Move) bool {
+	if move.IsDrop {
+		return false
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || piece.Kind() != common.Pawn {
+		return false
+	}
+
+	return IsLastPosition(storage.Size(), piece.Color(), move.Finish)
+}
+
+func isSpecialPawnMove(storage common.PieceStorage, move common.Move) bool {
+	glinskiStorage, ok := storage.(PieceStorage)
+	if !ok || move.IsDrop {
+		return false
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || piece.Kind() != common.Pawn {
+		return false
+	}
+
+	return glinskiStorage.isEnPassant(move) ||
+		isPawnDoubleStep(storage, piece, move)
+}
+
+// it checks that the move is a pawn move by two cells forward
+// from its initial cell over an empty cell to an empty cell
+func isPawnDoubleStep(
+	storage common.PieceStorage,
+	pawn common.Piece,
+	move common.Move,
+) bool {
+	step := mirror(pawnMoveDirection, pawn.Color())
+	if !IsPawnStart(pawn.Color(), move.Start) ||
+		moveDelta(move) != (direction{2 * step.file, 2 * step.rank}) {
+		return false
+	}
+
+	middle := common.Position{
+		File: move.Start.File + step.file,
+		Rank: move.Start.Rank + step.rank,
+	}
+	for _, position := range []common.Position{middle, move.Finish} {
+		if !common.HasPosition(storage, position) {
+			return false
+		}
+		if _, ok := storage.Piece(position); ok {
+			return false
+		}
+	}
+
+	return true
+}
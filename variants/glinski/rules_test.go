@@ -0,0 +1,256 @@
+package glinski
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestRulesCheckMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want error
+	}
+
+	for _, data := range []data{
+		{
+			args: checkMoveArgs{"Pf9", "f9f10"},
+			want: nil,
+		},
+		{
+			args: checkMoveArgs{"Pf10", "f10f11q"},
+			want: nil,
+		},
+		{
+			args: checkMoveArgs{"Pa5", "a5a6n"},
+			want: nil,
+		},
+		{
+			args: checkMoveArgs{"pf2", "f2f1r"},
+			want: nil,
+		},
+		{
+			args: checkMoveArgs{"Pf10", "f10f11"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: checkMoveArgs{"Pf10", "f10f11k"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: checkMoveArgs{"Pf9", "f9f10q"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: checkMoveArgs{"Rf10", "f10f11q"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: checkMoveArgs{"Pf10 kf11", "f10f11q"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: checkMoveArgs{"Pf10 ke10", "f10e10q"},
+			want: common.ErrKingCapture,
+		},
+		{
+			args: checkMoveArgs{"Pe4", "e4e6"},
+			want: nil,
+		},
+		{
+			args: checkMoveArgs{"Pg4", "g4g6"},
+			want: nil,
+		},
+		{
+			args: checkMoveArgs{"pe7", "e7e5"},
+			want: nil,
+		},
+		{
+			args: checkMoveArgs{"Pe4 ne5", "e4e6"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: checkMoveArgs{"Pe4 ne6", "e4e6"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: checkMoveArgs{"Pe5", "e5e7"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: checkMoveArgs{"Pe7", "e7e9"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			// there is no en passant target
+			args: checkMoveArgs{"Pf6 pe5", "f6e6"},
+			want: common.ErrIllegalMove,
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+
+		move, err := DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		got := Rules{}.CheckMove(storage, move)
+
+		if got != data.want {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesExpandMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want []string
+	}
+
+	for _, data := range []data{
+		{
+			args: checkMoveArgs{"Pf10", "f10f11"},
+			want: []string{"f10f11q", "f10f11r", "f10f11b", "f10f11n"},
+		},
+		{
+			args: checkMoveArgs{"Pf9", "f9f10"},
+			want: nil,
+		},
+		{
+			args: checkMoveArgs{"Pf10", "f10f11q"},
+			want: nil,
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+
+		move, err := DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var got []string
+		for _, move := range (Rules{}).ExpandMove(storage, move) {
+			got = append(got, EncodeMove(move))
+		}
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesApplyMove(test *testing.T) {
+	storage := makeStorage(test, "Pf10 Ke1")
+
+	move, err := DecodeMove("f10f11q")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	got := Rules{}.ApplyMove(storage, move)
+
+	want := makeStorage(test, "Qf11 Ke1")
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestRulesApplyMove_withEnPassant(test *testing.T) {
+	type args struct {
+		placement string
+		moves     []string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"Pf6 pe7", []string{"e7e5", "f6e6"}},
+			want: "Pe6",
+		},
+		{
+			args: args{"Pd5 pe7", []string{"e7e5", "d5e6"}},
+			want: "Pe6",
+		},
+		{
+			args: args{"Pe4 pf6", []string{"e4e6", "f6e5"}},
+			want: "pe5",
+		},
+		{
+			args: args{"Pe4 pd5", []string{"e4e6", "d5e5"}},
+			want: "pe5",
+		},
+	} {
+		storage := makeStorage(test, data.args.placement)
+		for _, text := range data.args.moves {
+			move, err := DecodeMove(text)
+			if err != nil {
+				test.Fatal(err)
+			}
+			if err := (Rules{}).CheckMove(storage, move); err != nil {
+				test.Fatal(err)
+			}
+
+			storage = Rules{}.ApplyMove(storage, move)
+		}
+
+		want := makeStorage(test, data.want)
+		if !reflect.DeepEqual(storage, want) {
+			test.Log(data.args)
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveGenerator(test *testing.T) {
+	for _, pieceStorageFactory := range []func(
+		size common.Size,
+		pieceGroup []common.Piece,
+	) common.PieceStorage{
+		boards.NewMapBoard,
+		boards.NewSliceBoard,
+		func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+			return boards.NewBitBoard(size, pieceGroup, NewPiece)
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(InitialFEN, NewPiece, pieceStorageFactory)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		// 17 pawn moves (9 steps and 8 double steps, the f-pawn is blocked),
+		// 8 knight moves, 12 bishop moves, 6 rook moves, 6 queen moves
+		// and 2 king moves; black has the same moves
+		generator := models.MoveGenerator{Rules: Rules{}}
+		for _, color := range []common.Color{common.White, common.Black} {
+			moves, err := generator.LegalMovesForColor(storage, color)
+			if len(moves) != 51 || err != nil {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestMoveGenerator_withEdge(test *testing.T) {
+	storage := makeStorage(test, "Ra1")
+
+	var generator models.MoveGenerator
+	moves, err := generator.MovesForPosition(storage, common.Position{
+		File: 0,
+		Rank: 5,
+	})
+
+	// 5 moves along the file a, 5 along the rank 1 and 10 along the diagonal
+	// from a1 to l1
+	if len(moves) != 20 || err != nil {
+		test.Fail()
+	}
+}
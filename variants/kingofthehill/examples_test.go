@@ -0,0 +1,42 @@
+package kingofthehill_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/kingofthehill"
+)
+
+func ExampleRules() {
+	storage, _ := uci.DecodePieceStorage(
+		"k7/8/8/8/8/4K3/8/8",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+
+	generator := models.MoveGenerator{Rules: kingofthehill.Rules{}}
+	move, _ := uci.DecodeMove("e3e4")
+	storage = generator.ApplyMove(storage, move)
+
+	result, _ := generator.Result(storage, common.Black)
+	fmt.Println(result == models.WhiteWin)
+
+	// Output: true
+}
+
+func ExampleCenter() {
+	center := kingofthehill.Center(common.Size{Width: 8, Height: 8})
+	for _, position := range center {
+		fmt.Println(uci.EncodePosition(position))
+	}
+
+	// Output:
+	// d4
+	// e4
+	// d5
+	// e5
+}
@@ -0,0 +1,72 @@
+// Package kingofthehill implements the rules of King of the Hill.
+//
+// A player whose king reaches a central position wins. Otherwise, the game
+// follows the orthodox rules including their special moves (see the classic
+// package).
+package kingofthehill
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+// Rules ...
+//
+// It implements the models.Rules and models.MoveExpander interfaces.
+// Checkmate and stalemate are resolved as in orthodox chess. The special moves
+// are enabled by the embedded classic.Rules.
+type Rules struct {
+	classic.Rules
+}
+
+// Termination ...
+//
+// It returns a win of a color whose king is on a central position.
+//
+// The game goes on if the color attacks the enemy king, because the last move
+// was illegal then and the move generator should report a king capture.
+func (rules Rules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) models.Result {
+	if common.IsCheck(storage, color) {
+		return models.Unfinished
+	}
+
+	for _, position := range Center(storage.Size()) {
+		piece, ok := storage.Piece(position)
+		if ok && piece.Kind() == common.King {
+			return models.Win(piece.Color())
+		}
+	}
+
+	return models.Unfinished
+}
+
+// Center ...
+//
+// It returns central positions of the size. There are one or two central
+// files (depending on an oddness of a width) and the same for ranks,
+// e.g. d4, e4, d5 and e5 for a board 8x8.
+func Center(size common.Size) []common.Position {
+	var positions []common.Position
+	for _, rank := range centralIndices(size.Height) {
+		for _, file := range centralIndices(size.Width) {
+			positions = append(positions, common.Position{File: file, Rank: rank})
+		}
+	}
+
+	return positions
+}
+
+func centralIndices(length int) []int {
+	if length <= 0 {
+		return nil
+	}
+	if length%2 != 0 {
+		return []int{length / 2}
+	}
+
+	return []int{length/2 - 1, length / 2}
+}
@@ -0,0 +1,187 @@
+package kingofthehill
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestRulesTermination(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args args
+		want models.Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/4K3",
+				color: common.Black,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/8/4K3/8/8/8",
+				color: common.Black,
+			},
+			want: models.WhiteWin,
+		},
+		{
+			args: args{
+				fen:   "8/8/8/3k4/8/8/8/4K3",
+				color: common.White,
+			},
+			want: models.BlackWin,
+		},
+		{
+			// the king has reached the center by an illegal move
+			args: args{
+				fen:   "4r3/8/8/8/4K3/8/8/4k3",
+				color: common.Black,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "k4/5/2K2/5/5",
+				color: common.Black,
+			},
+			want: models.WhiteWin,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var rules Rules
+		got := rules.Termination(storage, data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withMoveGenerator(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args       args
+		wantMoves  []string
+		wantResult models.Result
+	}
+
+	for _, data := range []data{
+		{
+			// the king can't reach the center by moving into a check
+			args: args{
+				fen:   "k7/8/8/5r2/8/4K3/8/8",
+				color: common.White,
+			},
+			wantMoves: []string{
+				"e3d2",
+				"e3d3",
+				"e3d4",
+				"e3e2",
+				"e3e4",
+			},
+			wantResult: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "k7/8/8/8/4K3/8/8/8",
+				color: common.Black,
+			},
+			wantMoves:  nil,
+			wantResult: models.WhiteWin,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		moves, err := generator.LegalMovesForColor(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var gotMoves []string
+		for _, move := range moves {
+			gotMoves = append(gotMoves, uci.EncodeMove(move))
+		}
+		sort.Strings(gotMoves)
+
+		gotResult, err := generator.Result(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotResult != data.wantResult {
+			test.Fail()
+		}
+	}
+}
+
+func TestCenter(test *testing.T) {
+	type args struct {
+		size common.Size
+	}
+	type data struct {
+		args args
+		want []common.Position
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.Size{Width: 8, Height: 8}},
+			want: []common.Position{
+				{File: 3, Rank: 3},
+				{File: 4, Rank: 3},
+				{File: 3, Rank: 4},
+				{File: 4, Rank: 4},
+			},
+		},
+		{
+			args: args{common.Size{Width: 5, Height: 5}},
+			want: []common.Position{{File: 2, Rank: 2}},
+		},
+		{
+			args: args{common.Size{Width: 5, Height: 6}},
+			want: []common.Position{
+				{File: 2, Rank: 2},
+				{File: 2, Rank: 3},
+			},
+		},
+		{
+			args: args{common.Size{Width: 0, Height: 0}},
+			want: nil,
+		},
+	} {
+		got := Center(data.args.size)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package makruk
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// BoardCountingLimit ...
+//
+// It's a limit of the board's honour counting.
+const BoardCountingLimit = 64
+
+// Counting ...
+//
+// It's a state of the counting rules. Moves of the weaker color are counted
+// when no pawns are left (the board's honour counting) or when the weaker
+// color has only a king (the pieces' honour counting). The game is drawn
+// if the count reaches the limit and the weaker color isn't checkmated.
+//
+// The zero value means that the counting isn't started.
+type Counting struct {
+	Color    common.Color // the weaker color, its moves are counted
+	Count    int
+	Limit    int  // zero means that the counting isn't started
+	IsPieces bool // the pieces' honour counting, otherwise the board's one
+}
+
+// IsStarted ...
+func (counting Counting) IsStarted() bool {
+	return counting.Limit != 0
+}
+
+// IsExhausted ...
+//
+// It checks that the count has reached the limit.
+func (counting Counting) IsExhausted() bool {
+	return counting.IsStarted() && counting.Count >= counting.Limit
+}
+
+// PiecesCountingLimit ...
+//
+// It returns a limit of the pieces' honour counting by pieces
+// of the stronger color: 8 for two rooks, 16 for one, 22 for two khons,
+// 32 for two knights, 44 for one khon and 64 otherwise.
+func PiecesCountingLimit(storage common.PieceStorage, color common.Color) int {
+	var rooks, khons, knights int
+	for _, piece := range storage.Pieces() {
+		if piece.Color() != color {
+			continue
+		}
+
+		switch piece.Kind() {
+		case common.Rook:
+			rooks++
+		case KhonKind:
+			khons++
+		case common.Knight:
+			knights++
+		}
+	}
+
+	switch {
+	case rooks >= 2:
+		return 8
+	case rooks == 1:
+		return 16
+	case khons >= 2:
+		return 22
+	case knights >= 2:
+		return 32
+	case khons == 1:
+		return 44
+	}
+
+	return BoardCountingLimit
+}
+
+// it returns a state of the counting after a move of the mover,
+// the storage should already contain the move
+func (counting Counting) next(
+	storage common.PieceStorage,
+	mover common.Color,
+) Counting {
+	var materials [common.ColorCount]int
+	var pieceCounts [common.ColorCount]int
+	for _, piece := range storage.Pieces() {
+		if piece.Kind() == common.Pawn {
+			return Counting{}
+		}
+
+		descriptor, _ := Kinds.LookupKind(piece.Kind())
+		materials[piece.Color()] += descriptor.Value
+		pieceCounts[piece.Color()]++
+	}
+
+	weakerColor := common.Black
+	switch {
+	case materials[common.Black] < materials[common.White]:
+	case materials[common.White] < materials[common.Black]:
+		weakerColor = common.White
+	default:
+		return Counting{}
+	}
+
+	// only a king is left
+	isPieces := pieceCounts[weakerColor] == 1
+	if counting.IsStarted() &&
+		counting.Color == weakerColor &&
+		counting.IsPieces == isPieces {
+		if mover == weakerColor {
+			counting.Count++
+		}
+
+		return counting
+	}
+
+	if !isPieces {
+		return Counting{Color: weakerColor, Limit: BoardCountingLimit}
+	}
+
+	return Counting{
+		Color:    weakerColor,
+		Count:    len(storage.Pieces()),
+		Limit:    PiecesCountingLimit(storage, weakerColor.Negative()),
+		IsPieces: true,
+	}
+}
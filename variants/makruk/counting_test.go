@@ -0,0 +1,164 @@
+package makruk
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func decodeTestPieceStorage(test *testing.T, fen string) common.PieceStorage {
+	storage, err := DecodePieceStorage(fen, NewPiece, boards.NewMapBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return storage
+}
+
+func TestCountingIsExhausted(test *testing.T) {
+	type data struct {
+		counting Counting
+		want     bool
+	}
+
+	for _, data := range []data{
+		{
+			counting: Counting{},
+			want:     false,
+		},
+		{
+			counting: Counting{Color: common.Black, Count: 15, Limit: 16},
+			want:     false,
+		},
+		{
+			counting: Counting{Color: common.Black, Count: 16, Limit: 16},
+			want:     true,
+		},
+	} {
+		got := data.counting.IsExhausted()
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPiecesCountingLimit(test *testing.T) {
+	type data struct {
+		fen  string
+		want int
+	}
+
+	for _, data := range []data{
+		{"4k3/8/8/8/8/8/8/R2K3R", 8},
+		{"4k3/8/8/8/8/8/8/R2KSS2", 16},
+		{"4k3/8/8/8/8/8/8/2NKSSN1", 22},
+		{"4k3/8/8/8/8/8/8/2NKMSN1", 32},
+		{"4k3/8/8/8/8/8/8/3KMS2", 44},
+		{"4k3/8/8/8/8/8/8/2NKM3", 64},
+	} {
+		storage := decodeTestPieceStorage(test, data.fen)
+		got := PiecesCountingLimit(storage, common.White)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestCountingNext(test *testing.T) {
+	type args struct {
+		counting Counting
+		fen      string
+		mover    common.Color
+	}
+	type data struct {
+		args args
+		want Counting
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				counting: Counting{},
+				fen:      "4k3/4p3/8/8/8/8/8/3KR3",
+				mover:    common.White,
+			},
+			want: Counting{},
+		},
+		{
+			// equal material
+			args: args{
+				counting: Counting{},
+				fen:      "4k2r/8/8/8/8/8/8/3KR3",
+				mover:    common.White,
+			},
+			want: Counting{},
+		},
+		{
+			// the board's honour counting is started
+			args: args{
+				counting: Counting{},
+				fen:      "4k1n1/8/8/8/8/8/8/3KR3",
+				mover:    common.White,
+			},
+			want: Counting{Color: common.Black, Limit: BoardCountingLimit},
+		},
+		{
+			args: args{
+				counting: Counting{Color: common.Black, Count: 5, Limit: 64},
+				fen:      "4k1n1/8/8/8/8/8/8/3KR3",
+				mover:    common.Black,
+			},
+			want: Counting{Color: common.Black, Count: 6, Limit: 64},
+		},
+		{
+			args: args{
+				counting: Counting{Color: common.Black, Count: 5, Limit: 64},
+				fen:      "4k1n1/8/8/8/8/8/8/3KR3",
+				mover:    common.White,
+			},
+			want: Counting{Color: common.Black, Count: 5, Limit: 64},
+		},
+		{
+			// the pieces' honour counting replaces the board's one
+			args: args{
+				counting: Counting{Color: common.Black, Count: 5, Limit: 64},
+				fen:      "4k3/8/8/8/8/8/8/3KR3",
+				mover:    common.White,
+			},
+			want: Counting{
+				Color:    common.Black,
+				Count:    3,
+				Limit:    16,
+				IsPieces: true,
+			},
+		},
+		{
+			args: args{
+				counting: Counting{
+					Color:    common.Black,
+					Count:    3,
+					Limit:    16,
+					IsPieces: true,
+				},
+				fen:   "4k3/8/8/8/8/8/8/3KR3",
+				mover: common.Black,
+			},
+			want: Counting{
+				Color:    common.Black,
+				Count:    4,
+				Limit:    16,
+				IsPieces: true,
+			},
+		},
+	} {
+		storage := decodeTestPieceStorage(test, data.args.fen)
+		got := data.args.counting.next(storage, data.args.mover)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
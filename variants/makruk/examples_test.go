@@ -0,0 +1,38 @@
+package makruk_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/makruk"
+)
+
+func ExampleRules() {
+	board, _ := makruk.DecodePieceStorage(
+		"4k3/8/8/3P4/8/8/8/R2K4",
+		makruk.NewPiece,
+		boards.NewMapBoard,
+	)
+	storage := makruk.NewPieceStorage(board, makruk.NewPiece, makruk.Counting{})
+
+	generator := models.MoveGenerator{Rules: makruk.Rules{}}
+	for _, text := range []string{"d5d6m", "e8d8", "a1a8", "d8e7"} {
+		move, _ := uci.Notation{Kinds: makruk.Kinds}.DecodeMove(text)
+		storage = generator.ApplyMove(storage, move).(makruk.PieceStorage)
+
+		counting := storage.Counting()
+		fmt.Println(
+			makruk.EncodePieceStorage(storage),
+			counting.Count,
+			counting.Limit,
+		)
+	}
+
+	// Output:
+	// 4k3/8/3M4/8/8/8/8/R2K4 4 16
+	// 3k4/8/3M4/8/8/8/8/R2K4 5 16
+	// R2k4/8/3M4/8/8/8/8/3K4 5 16
+	// R7/4k3/3M4/8/8/8/8/3K4 6 16
+}
@@ -0,0 +1,68 @@
+package makruk
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// InitialFEN ...
+//
+// It's the initial position of Makruk in the Makruk FEN.
+const InitialFEN = "rnsmksnr/8/pppppppp/8/8/PPPPPPPP/8/RNSKMSNR"
+
+const khonSymbol = 's'
+
+// DecodePieceStorage ...
+//
+// It decodes a piece storage from the Makruk FEN. It's the generic FEN,
+// where the s symbol is a khon.
+func DecodePieceStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	descriptor := Kinds[KhonKind]
+
+	var genericFEN strings.Builder
+	for _, symbol := range fen {
+		switch {
+		case symbol == khonSymbol:
+			genericFEN.WriteRune(descriptor.Symbol)
+		case symbol == unicode.ToUpper(khonSymbol):
+			genericFEN.WriteRune(unicode.ToUpper(descriptor.Symbol))
+		case unicode.ToLower(symbol) == descriptor.Symbol:
+			return nil, fmt.Errorf("unknown kind %q", symbol)
+		default:
+			genericFEN.WriteRune(symbol)
+		}
+	}
+
+	return uci.Notation{Kinds: Kinds}.DecodePieceStorage(
+		genericFEN.String(),
+		pieceFactory,
+		pieceStorageFactory,
+	)
+}
+
+// EncodePieceStorage ...
+//
+// It converts the piece storage to the Makruk FEN (see DecodePieceStorage()).
+func EncodePieceStorage(storage common.PieceStorage) string {
+	descriptor := Kinds[KhonKind]
+
+	genericFEN := uci.Notation{Kinds: Kinds}.EncodePieceStorage(storage)
+	return strings.Map(func(symbol rune) rune {
+		switch symbol {
+		case descriptor.Symbol:
+			return khonSymbol
+		case unicode.ToUpper(descriptor.Symbol):
+			return unicode.ToUpper(khonSymbol)
+		}
+
+		return symbol
+	}, genericFEN)
+}
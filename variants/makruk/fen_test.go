@@ -0,0 +1,92 @@
+package makruk
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		fen string
+	}
+	type data struct {
+		args           args
+		wantGenericFEN string
+		wantErr        bool
+	}
+
+	for _, data := range []data{
+		{
+			args:           args{InitialFEN},
+			wantGenericFEN: "rnxmkxnr/8/pppppppp/8/8/PPPPPPPP/8/RNXKMXNR",
+			wantErr:        false,
+		},
+		{
+			args:           args{"4k3/8/8/8/8/8/8/2SKM3"},
+			wantGenericFEN: "4k3/8/8/8/8/8/8/2XKM3",
+			wantErr:        false,
+		},
+		{
+			args:           args{"4k3/8/8/8/8/8/8/2XK4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+		{
+			args:           args{"4k3/8/8/8/8/8/8/2YK4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(data.args.fen, NewPiece, boards.NewMapBoard)
+
+		var gotGenericFEN string
+		if storage != nil {
+			gotGenericFEN = testNotation.EncodePieceStorage(storage)
+		}
+		if gotGenericFEN != data.wantGenericFEN {
+			test.Fail()
+		}
+		if hasErr := err != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePieceStorage(test *testing.T) {
+	type args struct {
+		storage common.PieceStorage
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	decode := func(fen string) common.PieceStorage {
+		storage, err := testNotation.DecodePieceStorage(fen, NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		return storage
+	}
+
+	for _, data := range []data{
+		{
+			args: args{decode("rnxmkxnr/8/pppppppp/8/8/PPPPPPPP/8/RNXKMXNR")},
+			want: InitialFEN,
+		},
+		{
+			args: args{decode("4k3/8/8/8/8/8/8/2XKM3")},
+			want: "4k3/8/8/8/8/8/8/2SKM3",
+		},
+	} {
+		got := EncodePieceStorage(data.args.storage)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package makruk
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/pieces/betza"
+)
+
+// ...
+//
+// The king, the rook (rua), the knight (ma) and the pawn (bia) use
+// the built-in kinds, the met and the khon are described by the Kinds table.
+const (
+	MetKind common.Kind = common.KindCount + iota
+	KhonKind
+)
+
+// Kinds ...
+//
+// It describes the met and the khon. A symbol of the khon is used only
+// by the generic FEN coding with the table (see uci.Notation),
+// see DecodePieceStorage() for the Makruk one.
+var Kinds = common.KindTable{
+	MetKind:  {Name: "met", Symbol: 'm', Value: 200},
+	KhonKind: {Name: "khon", Symbol: 'x', Value: 250},
+}
+
+var (
+	metMovement  = betza.MustParse("F")
+	khonMovement = betza.MustParse("FfW")
+)
+
+// NewPiece ...
+//
+// It makes a met or a khon and uses the pieces.NewPiece() function
+// for other kinds. The built-in pieces already move as in Makruk
+// (e.g. a pawn has no double move).
+func NewPiece(
+	kind common.Kind,
+	color common.Color,
+	position common.Position,
+) common.Piece {
+	return NewPieceFactory(pieces.NewPiece)(kind, color, position)
+}
+
+// NewPieceFactory ...
+//
+// It returns a factory, which makes a met or a khon and uses the fallback
+// factory for other kinds. The fallback can be nil.
+func NewPieceFactory(fallback common.PieceFactory) common.PieceFactory {
+	return func(
+		kind common.Kind,
+		color common.Color,
+		position common.Position,
+	) common.Piece {
+		switch kind {
+		case MetKind:
+			return betza.NewPiece(kind, color, position, metMovement)
+		case KhonKind:
+			return betza.NewPiece(kind, color, position, khonMovement)
+		}
+
+		if fallback == nil {
+			return nil
+		}
+
+		return fallback(kind, color, position)
+	}
+}
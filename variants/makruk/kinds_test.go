@@ -0,0 +1,74 @@
+package makruk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/pieces/betza"
+)
+
+func TestNewPiece(test *testing.T) {
+	type args struct {
+		kind common.Kind
+	}
+	type data struct {
+		args args
+		want common.Piece
+	}
+
+	position := common.Position{File: 2, Rank: 3}
+	for _, data := range []data{
+		{
+			args: args{MetKind},
+			want: betza.NewPiece(
+				MetKind,
+				common.White,
+				position,
+				betza.MustParse("F"),
+			),
+		},
+		{
+			args: args{KhonKind},
+			want: betza.NewPiece(
+				KhonKind,
+				common.White,
+				position,
+				betza.MustParse("FfW"),
+			),
+		},
+		{
+			args: args{common.Pawn},
+			want: pieces.NewPawn(common.White, position),
+		},
+	} {
+		got := NewPiece(data.args.kind, common.White, position)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestKinds(test *testing.T) {
+	position := common.Position{File: 2, Rank: 3}
+	for kind, descriptor := range Kinds {
+		if kind < common.KindCount {
+			test.Fail()
+		}
+		if NewPiece(kind, common.Black, position) == nil {
+			test.Fail()
+		}
+
+		gotKind, ok := Kinds.LookupKindBySymbol(descriptor.Symbol)
+		if !ok || gotKind != kind {
+			test.Fail()
+		}
+
+		// kinds of the table aren't registered
+		if _, ok := common.LookupKindBySymbol(descriptor.Symbol); ok {
+			test.Fail()
+		}
+	}
+}
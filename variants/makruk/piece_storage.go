@@ -0,0 +1,76 @@
+package makruk
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// PieceStorage ...
+//
+// It wraps a piece storage and keeps a state of the counting rules.
+type PieceStorage struct {
+	common.PieceStorage
+
+	pieceFactory common.PieceFactory
+	counting     Counting
+}
+
+// NewPieceStorage ...
+func NewPieceStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	counting Counting,
+) PieceStorage {
+	return PieceStorage{
+		PieceStorage: storage,
+
+		pieceFactory: pieceFactory,
+		counting:     counting,
+	}
+}
+
+// Counting ...
+func (storage PieceStorage) Counting() Counting {
+	return storage.counting
+}
+
+// ApplyMove ...
+//
+// A pawn is replaced with a promoted piece. The counting is started, continued
+// or stopped according to pieces left after the move.
+func (storage PieceStorage) ApplyMove(move common.Move) common.PieceStorage {
+	piece, _ := storage.Piece(move.Start)
+
+	nextStorage := storage.update(storage.PieceStorage.ApplyMove(move))
+	if move.IsPromotion {
+		promotedPiece :=
+			storage.pieceFactory(move.Promotion, piece.Color(), move.Finish)
+		nextStorage.PieceStorage = nextStorage.PieceStorage.SetPiece(promotedPiece)
+	}
+
+	nextStorage.counting =
+		storage.counting.next(nextStorage.PieceStorage, piece.Color())
+	return nextStorage
+}
+
+// SetPiece ...
+//
+// It doesn't change the counting.
+func (storage PieceStorage) SetPiece(piece common.Piece) common.PieceStorage {
+	return storage.update(storage.PieceStorage.SetPiece(piece))
+}
+
+// RemovePiece ...
+//
+// It doesn't change the counting.
+func (storage PieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return storage.update(storage.PieceStorage.RemovePiece(position))
+}
+
+func (storage PieceStorage) update(
+	baseStorage common.PieceStorage,
+) PieceStorage {
+	storage.PieceStorage = baseStorage
+	return storage
+}
@@ -0,0 +1,135 @@
+package makruk
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+var testNotation = uci.Notation{Kinds: Kinds}
+
+func decodeTestMove(test *testing.T, text string) common.Move {
+	move, err := testNotation.DecodeMove(text)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return move
+}
+
+func TestPieceStorageApplyMove(test *testing.T) {
+	type args struct {
+		fen      string
+		counting Counting
+		moves    []string
+	}
+	type data struct {
+		args         args
+		wantFEN      string
+		wantCounting Counting
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "4k3/4p3/8/8/8/P7/8/3KR3",
+				moves: []string{"e1e7"},
+			},
+			wantFEN:      "4k3/4R3/8/8/8/P7/8/3K4",
+			wantCounting: Counting{},
+		},
+		{
+			args: args{
+				fen:   "4k3/4p3/8/8/8/8/8/3KR3",
+				moves: []string{"e1e7"},
+			},
+			wantFEN: "4k3/4R3/8/8/8/8/8/3K4",
+			wantCounting: Counting{
+				Color:    common.Black,
+				Count:    3,
+				Limit:    16,
+				IsPieces: true,
+			},
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/4P3/8/8/8/3K4",
+				moves: []string{"e5e6m"},
+			},
+			wantFEN: "4k3/8/4M3/8/8/8/8/3K4",
+			wantCounting: Counting{
+				Color:    common.Black,
+				Count:    3,
+				Limit:    BoardCountingLimit,
+				IsPieces: true,
+			},
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/3KR3",
+				moves: []string{"e1e2", "e8f8", "e2e3", "f8g8"},
+			},
+			wantFEN: "6k1/8/8/8/8/4R3/8/3K4",
+			wantCounting: Counting{
+				Color:    common.Black,
+				Count:    5,
+				Limit:    16,
+				IsPieces: true,
+			},
+		},
+	} {
+		var storage common.PieceStorage = NewPieceStorage(
+			decodeTestPieceStorage(test, data.args.fen),
+			NewPiece,
+			data.args.counting,
+		)
+		for _, move := range data.args.moves {
+			storage = storage.ApplyMove(decodeTestMove(test, move))
+		}
+
+		if EncodePieceStorage(storage) != data.wantFEN {
+			test.Fail()
+		}
+		if storage.(PieceStorage).Counting() != data.wantCounting {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageSetPiece(test *testing.T) {
+	counting := Counting{Color: common.Black, Count: 3, Limit: 64}
+	storage := NewPieceStorage(
+		decodeTestPieceStorage(test, "4k3/8/8/8/8/8/8/3KR3"),
+		NewPiece,
+		counting,
+	)
+
+	position := common.Position{File: 0, Rank: 0}
+	nextStorage := storage.SetPiece(NewPiece(MetKind, common.White, position))
+
+	if EncodePieceStorage(nextStorage) != "4k3/8/8/8/8/8/8/M2KR3" {
+		test.Fail()
+	}
+	if nextStorage.(PieceStorage).Counting() != counting {
+		test.Fail()
+	}
+}
+
+func TestPieceStorageRemovePiece(test *testing.T) {
+	counting := Counting{Color: common.Black, Count: 3, Limit: 64}
+	storage := NewPieceStorage(
+		decodeTestPieceStorage(test, "4k3/8/8/8/8/8/8/3KR3"),
+		NewPiece,
+		counting,
+	)
+
+	nextStorage := storage.RemovePiece(common.Position{File: 4, Rank: 0})
+
+	if EncodePieceStorage(nextStorage) != "4k3/8/8/8/8/8/8/3K4" {
+		test.Fail()
+	}
+	if nextStorage.(PieceStorage).Counting() != counting {
+		test.Fail()
+	}
+}
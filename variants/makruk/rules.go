@@ -0,0 +1,120 @@
+// Package makruk implements Makruk (Thai chess).
+//
+// It provides its pieces (the met and the khon), promotions of pawns
+// to mets on the sixth rank, the counting rules and the Makruk FEN.
+// A board has a size 8x8, pawns start on the third rank and there are
+// no pawn double moves, en passant captures and castlings.
+package makruk
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// PromotionRankShift ...
+//
+// It's a count of ranks between the promotion rank and the last one.
+const PromotionRankShift = 2
+
+// Rules ...
+//
+// It implements the models.Rules and models.MoveExpander interfaces.
+// Checkmate and stalemate are resolved as in orthodox chess.
+//
+// A piece storage should be created by NewPieceStorage(),
+// otherwise promotions and the counting aren't supported.
+type Rules struct {
+	models.OrthodoxRules
+}
+
+// IsPromotionRank ...
+//
+// It checks that the position is on the sixth rank relative to the color
+// (see PromotionRankShift).
+func IsPromotionRank(
+	size common.Size,
+	color common.Color,
+	position common.Position,
+) bool {
+	if color == common.White {
+		return position.Rank == size.Height-1-PromotionRankShift
+	}
+
+	return position.Rank == PromotionRankShift
+}
+
+// CheckMove ...
+//
+// A pawn move to the promotion rank should be a promotion to a met.
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	if err := storage.CheckMove(move); err != nil {
+		return err
+	}
+
+	if move.IsPromotion != isPawnPromotion(storage, move) {
+		return common.ErrIllegalMove
+	}
+	if move.IsPromotion && move.Promotion != MetKind {
+		return common.ErrIllegalMove
+	}
+
+	return nil
+}
+
+// ExpandMove ...
+//
+// It replaces a pawn move to the promotion rank with a promotion to a met.
+func (rules Rules) ExpandMove(
+	storage common.PieceStorage,
+	move common.Move,
+) []common.Move {
+	if move.IsPromotion || !isPawnPromotion(storage, move) {
+		return nil
+	}
+
+	move.Promotion, move.IsPromotion = MetKind, true
+	return []common.Move{move}
+}
+
+// Termination ...
+//
+// It returns a draw if the counting is exhausted before a move
+// of the weaker color.
+//
+// The game goes on if any king is attacked, because either the last move
+// was illegal and the move generator should report a king capture, or the
+// weaker color can be checkmated, which takes precedence.
+func (rules Rules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) models.Result {
+	makrukStorage, ok := storage.(PieceStorage)
+	if !ok {
+		return models.Unfinished
+	}
+
+	counting := makrukStorage.Counting()
+	if !counting.IsExhausted() || counting.Color != color ||
+		common.IsCheck(storage, color) ||
+		common.IsCheck(storage, color.Negative()) {
+		return models.Unfinished
+	}
+
+	return models.Draw
+}
+
+func isPawnPromotion(storage common.PieceStorage, move common.Move) bool {
+	if move.IsDrop {
+		return false
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok || piece.Kind() != common.Pawn {
+		return false
+	}
+
+	return IsPromotionRank(storage.Size(), piece.Color(), move.Finish)
+}
@@ -0,0 +1,47 @@
+//go:build long
+// +build long
+
+package makruk
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestPerft_long(test *testing.T) {
+	type args struct {
+		deep int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{3},
+			want: 12012,
+		},
+		{
+			args: args{4},
+			want: 273026,
+		},
+	} {
+		board, err :=
+			DecodePieceStorage(InitialFEN, NewPiece, boards.NewSliceBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		storage := NewPieceStorage(board, NewPiece, Counting{})
+		generator := models.MoveGenerator{Rules: Rules{}}
+		got := models.Perft(generator, storage, common.White, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
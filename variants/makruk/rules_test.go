@@ -0,0 +1,267 @@
+package makruk
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestIsPromotionRank(test *testing.T) {
+	type args struct {
+		color    common.Color
+		position common.Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	size := common.Size{Width: 8, Height: 8}
+	for _, data := range []data{
+		{
+			args: args{common.White, common.Position{File: 4, Rank: 5}},
+			want: true,
+		},
+		{
+			args: args{common.White, common.Position{File: 4, Rank: 7}},
+			want: false,
+		},
+		{
+			args: args{common.Black, common.Position{File: 4, Rank: 2}},
+			want: true,
+		},
+		{
+			args: args{common.Black, common.Position{File: 4, Rank: 5}},
+			want: false,
+		},
+	} {
+		got := IsPromotionRank(size, data.args.color, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesCheckMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"4k3/8/8/4P3/8/8/8/3K4", "e5e6m"},
+			want: nil,
+		},
+		{
+			args: args{"4k3/8/8/4P3/8/8/8/3K4", "e5e6"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{"4k3/8/8/4P3/8/8/8/3K4", "e5e6q"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{"4k3/8/8/8/4P3/8/8/3K4", "e4e5m"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{"4k3/8/8/8/4P3/8/8/3K4", "e4e5"},
+			want: nil,
+		},
+		{
+			args: args{"4k3/8/8/8/8/4p3/8/3K4", "e3e2"},
+			want: nil,
+		},
+		{
+			args: args{"4k3/8/8/8/4p3/8/8/3K4", "e4e3m"},
+			want: nil,
+		},
+		{
+			args: args{"4k3/8/8/8/8/8/8/3KS3", "e1e2"},
+			want: nil,
+		},
+		{
+			args: args{"4k3/8/8/8/8/8/8/3KS3", "e1e0"},
+			want: common.ErrOutOfSize,
+		},
+		{
+			args: args{"4k3/8/8/8/8/8/8/3KS3", "e1d2"},
+			want: nil,
+		},
+		{
+			args: args{"4k3/8/8/8/8/8/8/3KS3", "e1f1"},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{"4k3/3P4/8/8/8/8/8/3K4", "d7e8"},
+			want: common.ErrKingCapture,
+		},
+	} {
+		storage := decodeTestPieceStorage(test, data.args.fen)
+
+		var rules Rules
+		got := rules.CheckMove(storage, decodeTestMove(test, data.args.move))
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesExpandMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want []string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"4k3/8/8/4P3/8/8/8/3K4", "e5e6"},
+			want: []string{"e5e6m"},
+		},
+		{
+			args: args{"4k3/8/8/4P3/8/8/8/3K4", "e5e6m"},
+			want: nil,
+		},
+		{
+			args: args{"4k3/8/8/8/4P3/8/8/3K4", "e4e5"},
+			want: nil,
+		},
+		{
+			args: args{"4k3/8/8/4R3/8/8/8/3K4", "e5e6"},
+			want: nil,
+		},
+	} {
+		storage := decodeTestPieceStorage(test, data.args.fen)
+
+		var rules Rules
+		moves := rules.ExpandMove(storage, decodeTestMove(test, data.args.move))
+
+		var got []string
+		for _, move := range moves {
+			got = append(got, testNotation.EncodeMove(move))
+		}
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesTermination(test *testing.T) {
+	type args struct {
+		storage common.PieceStorage
+		color   common.Color
+	}
+	type data struct {
+		args args
+		want models.Result
+	}
+
+	exhausted := Counting{
+		Color:    common.Black,
+		Count:    16,
+		Limit:    16,
+		IsPieces: true,
+	}
+	newStorage := func(fen string, counting Counting) common.PieceStorage {
+		storage := decodeTestPieceStorage(test, fen)
+		return NewPieceStorage(storage, NewPiece, counting)
+	}
+	for _, data := range []data{
+		{
+			args: args{
+				storage: newStorage("4k3/8/8/8/8/8/8/R2K4", exhausted),
+				color:   common.Black,
+			},
+			want: models.Draw,
+		},
+		{
+			args: args{
+				storage: newStorage("4k3/8/8/8/8/8/8/R2K4", exhausted),
+				color:   common.White,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				storage: newStorage(
+					"4k3/8/8/8/8/8/8/R2K4",
+					Counting{Color: common.Black, Count: 15, Limit: 16},
+				),
+				color: common.Black,
+			},
+			want: models.Unfinished,
+		},
+		{
+			// the weaker color can be checkmated
+			args: args{
+				storage: newStorage("R3k3/8/8/8/8/8/8/3K4", exhausted),
+				color:   common.Black,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				storage: decodeTestPieceStorage(test, "4k3/8/8/8/8/8/8/R2K4"),
+				color:   common.Black,
+			},
+			want: models.Unfinished,
+		},
+	} {
+		var rules Rules
+		got := rules.Termination(data.args.storage, data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPerft(test *testing.T) {
+	type args struct {
+		deep int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{1},
+			want: 23,
+		},
+		{
+			args: args{2},
+			want: 529,
+		},
+	} {
+		board, err :=
+			DecodePieceStorage(InitialFEN, NewPiece, boards.NewSliceBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		storage := NewPieceStorage(board, NewPiece, Counting{})
+		generator := models.MoveGenerator{Rules: Rules{}}
+		got := models.Perft(generator, storage, common.White, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package racingkings_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/racingkings"
+)
+
+func ExampleRules() {
+	storage, _ := uci.DecodePieceStorage(
+		racingkings.InitialFEN,
+		pieces.NewPiece,
+		boards.NewSliceBoard,
+	)
+
+	generator := models.MoveGenerator{Rules: racingkings.Rules{}}
+	moveCount := models.Perft(generator, storage, common.White, 2, nil)
+	fmt.Println(moveCount)
+
+	// Output: 421
+}
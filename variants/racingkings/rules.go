@@ -0,0 +1,121 @@
+// Package racingkings implements the rules of Racing Kings.
+//
+// Nobody may give a check. A player whose king reaches the last rank wins.
+// If the white king reaches it first, black has one move to do the same,
+// which makes a draw.
+package racingkings
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// InitialFEN ...
+//
+// It's the initial position of Racing Kings; both kings start
+// on the first rank.
+const InitialFEN = "8/8/8/8/8/8/krbnNBRK/qrbnNBRQ"
+
+// Rules ...
+//
+// It implements the models.Rules interface. Stalemate is resolved
+// as in orthodox chess; checkmate is impossible.
+type Rules struct {
+	models.OrthodoxRules
+}
+
+// CheckMove ...
+//
+// It returns common.ErrIllegalMove if the move gives a check.
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	if err := storage.CheckMove(move); err != nil {
+		return err
+	}
+
+	piece, _ := storage.Piece(move.Start)
+	if common.IsCheck(storage.ApplyMove(move), piece.Color()) {
+		return common.ErrIllegalMove
+	}
+
+	return nil
+}
+
+// Termination ...
+//
+// It returns a win of a color whose king is on the last rank and a draw
+// if both kings are there.
+//
+// If only the white king is on the last rank before a move of black,
+// the game goes on while the black king can reach the last rank by a legal
+// move.
+func (rules Rules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) models.Result {
+	// the last move was illegal, so the move generator
+	// should report a king capture
+	if common.IsCheck(storage, color) {
+		return models.Unfinished
+	}
+
+	isWhiteFinished := isKingFinished(storage, common.White)
+	isBlackFinished := isKingFinished(storage, common.Black)
+	switch {
+	case isWhiteFinished && isBlackFinished:
+		return models.Draw
+	case isBlackFinished:
+		return models.BlackWin
+	case !isWhiteFinished:
+		return models.Unfinished
+	case color == common.Black && rules.canBlackFinish(storage):
+		return models.Unfinished
+	}
+
+	return models.WhiteWin
+}
+
+func (rules Rules) canBlackFinish(storage common.PieceStorage) bool {
+	var blackKing common.Piece
+	for _, piece := range storage.Pieces() {
+		if piece.Kind() == common.King && piece.Color() == common.Black {
+			blackKing = piece
+			break
+		}
+	}
+	if blackKing == nil {
+		return false
+	}
+
+	lastRank := storage.Size().Height - 1
+	for file := 0; file < storage.Size().Width; file++ {
+		move := common.Move{
+			Start:  blackKing.Position(),
+			Finish: common.Position{File: file, Rank: lastRank},
+		}
+		if err := rules.CheckMove(storage, move); err != nil {
+			continue
+		}
+
+		nextStorage := storage.ApplyMove(move)
+		if !common.IsCheck(nextStorage, common.White) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isKingFinished(storage common.PieceStorage, color common.Color) bool {
+	lastRank := storage.Size().Height - 1
+	for _, piece := range storage.Pieces() {
+		if piece.Kind() == common.King && piece.Color() == color &&
+			piece.Position().Rank == lastRank {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+//go:build long
+// +build long
+
+package racingkings
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestPerft_long(test *testing.T) {
+	type args struct {
+		deep int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{3},
+			want: 11264,
+		},
+		{
+			args: args{4},
+			want: 296242,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(InitialFEN, pieces.NewPiece, boards.NewSliceBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		got := models.Perft(generator, storage, common.White, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
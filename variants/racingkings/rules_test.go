@@ -0,0 +1,225 @@
+package racingkings
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestRulesCheckMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/k7/6RK",
+				move: "g1g3",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/k7/6RK",
+				move: "g1a1",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/k7/6RK",
+				move: "g1h2",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:  "8/8/8/8/8/8/k7/r6K",
+				move: "a1h1",
+			},
+			want: common.ErrKingCapture,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		move, err := uci.DecodeMove(data.args.move)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var rules Rules
+		got := rules.CheckMove(storage, move)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesTermination(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args args
+		want models.Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   InitialFEN,
+				color: common.White,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "1k6/8/8/8/8/8/8/7K",
+				color: common.White,
+			},
+			want: models.BlackWin,
+		},
+		{
+			args: args{
+				fen:   "k6K/8/8/8/8/8/8/8",
+				color: common.White,
+			},
+			want: models.Draw,
+		},
+		{
+			// black can still reach the last rank
+			args: args{
+				fen:   "7K/k7/8/8/8/8/8/8",
+				color: common.Black,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "7K/8/k7/8/8/8/8/8",
+				color: common.Black,
+			},
+			want: models.WhiteWin,
+		},
+		{
+			// the black king would go to an attacked position
+			args: args{
+				fen:   "2R4K/k7/8/8/8/8/8/8",
+				color: common.Black,
+			},
+			want: models.WhiteWin,
+		},
+		{
+			args: args{
+				fen:   "7K/5k2/8/1B6/8/B7/8/8",
+				color: common.Black,
+			},
+			want: models.WhiteWin,
+		},
+		{
+			// black hasn't reached the last rank by the equalizing move
+			args: args{
+				fen:   "7K/8/k7/8/8/8/8/8",
+				color: common.White,
+			},
+			want: models.WhiteWin,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var rules Rules
+		got := rules.Termination(storage, data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withMoveGenerator(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"8/8/8/8/8/8/k7/6RK",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	generator := models.MoveGenerator{Rules: Rules{}}
+	moves, err := generator.LegalMovesForColor(storage, common.White)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var got []string
+	for _, move := range moves {
+		got = append(got, uci.EncodeMove(move))
+	}
+	sort.Strings(got)
+
+	want := []string{
+		"g1b1", "g1c1", "g1d1", "g1e1", "g1f1",
+		"g1g3", "g1g4", "g1g5", "g1g6", "g1g7", "g1g8",
+		"h1g2", "h1h2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestPerft(test *testing.T) {
+	type args struct {
+		deep int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{1},
+			want: 21,
+		},
+		{
+			args: args{2},
+			want: 421,
+		},
+	} {
+		storage, err :=
+			uci.DecodePieceStorage(InitialFEN, pieces.NewPiece, boards.NewSliceBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		got := models.Perft(generator, storage, common.White, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
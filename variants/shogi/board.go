@@ -0,0 +1,63 @@
+package shogi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// InitialFEN ...
+//
+// It's the initial position of Shogi. Its symbols are the same
+// in the generic FEN and in the board field of SFEN.
+const InitialFEN = "lnsgkgsnl/1r5b1/ppppppppp/9/9/9/PPPPPPPPP/1B5R1/LNSGKGSNL"
+
+// PromotionZoneDepth ...
+//
+// It's a count of the farthest ranks, which form a promotion zone.
+const PromotionZoneDepth = 3
+
+// IsInPromotionZone ...
+//
+// It checks that the position is on one of the farthest ranks
+// from the color (see PromotionZoneDepth).
+func IsInPromotionZone(
+	size common.Size,
+	color common.Color,
+	position common.Position,
+) bool {
+	return ranksAhead(size, color, position) < PromotionZoneDepth
+}
+
+// CanMoveFurther ...
+//
+// It checks that an unpromoted piece of the kind has any moves
+// from the position, i.e. a pawn or a lance isn't on the last rank
+// and a knight isn't on one of two last ranks.
+func CanMoveFurther(
+	size common.Size,
+	kind common.Kind,
+	color common.Color,
+	position common.Position,
+) bool {
+	var minRanksAhead int
+	switch kind {
+	case common.Pawn, LanceKind:
+		minRanksAhead = 1
+	case common.Knight:
+		minRanksAhead = 2
+	}
+
+	return ranksAhead(size, color, position) >= minRanksAhead
+}
+
+// it returns a count of ranks between the position and the farthest one
+func ranksAhead(
+	size common.Size,
+	color common.Color,
+	position common.Position,
+) int {
+	if color == common.White {
+		return size.Height - 1 - position.Rank
+	}
+
+	return position.Rank
+}
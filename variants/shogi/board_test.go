@@ -0,0 +1,115 @@
+package shogi
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestIsInPromotionZone(test *testing.T) {
+	type args struct {
+		color    common.Color
+		position common.Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	size := common.Size{Width: 9, Height: 9}
+	for _, data := range []data{
+		{
+			args: args{common.White, common.Position{File: 4, Rank: 8}},
+			want: true,
+		},
+		{
+			args: args{common.White, common.Position{File: 0, Rank: 6}},
+			want: true,
+		},
+		{
+			args: args{common.White, common.Position{File: 0, Rank: 5}},
+			want: false,
+		},
+		{
+			args: args{common.White, common.Position{File: 4, Rank: 0}},
+			want: false,
+		},
+		{
+			args: args{common.Black, common.Position{File: 4, Rank: 0}},
+			want: true,
+		},
+		{
+			args: args{common.Black, common.Position{File: 8, Rank: 2}},
+			want: true,
+		},
+		{
+			args: args{common.Black, common.Position{File: 8, Rank: 3}},
+			want: false,
+		},
+	} {
+		got := IsInPromotionZone(size, data.args.color, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestCanMoveFurther(test *testing.T) {
+	type args struct {
+		kind     common.Kind
+		color    common.Color
+		position common.Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	size := common.Size{Width: 9, Height: 9}
+	for _, data := range []data{
+		{
+			args: args{common.Pawn, common.White, common.Position{File: 4, Rank: 7}},
+			want: true,
+		},
+		{
+			args: args{common.Pawn, common.White, common.Position{File: 4, Rank: 8}},
+			want: false,
+		},
+		{
+			args: args{LanceKind, common.Black, common.Position{File: 0, Rank: 0}},
+			want: false,
+		},
+		{
+			args: args{common.Knight, common.White, common.Position{File: 1, Rank: 6}},
+			want: true,
+		},
+		{
+			args: args{common.Knight, common.White, common.Position{File: 1, Rank: 7}},
+			want: false,
+		},
+		{
+			args: args{common.Knight, common.Black, common.Position{File: 1, Rank: 1}},
+			want: false,
+		},
+		{
+			args: args{SilverKind, common.White, common.Position{File: 4, Rank: 8}},
+			want: true,
+		},
+		{
+			args: args{TokinKind, common.Black, common.Position{File: 4, Rank: 0}},
+			want: true,
+		},
+	} {
+		got := CanMoveFurther(
+			size,
+			data.args.kind,
+			data.args.color,
+			data.args.position,
+		)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package shogi_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+)
+
+func ExampleRules() {
+	notation := uci.Notation{Kinds: shogi.Kinds}
+	board, _ := notation.DecodePieceStorage(
+		"4k4/9/9/9/4s4/9/9/4R4/4K4",
+		shogi.NewPiece,
+		boards.NewMapBoard,
+	)
+	storage := shogi.NewPieceStorage(
+		board,
+		shogi.NewPiece,
+		common.White,
+		crazyhouse.Reserves{},
+	)
+
+	generator := models.MoveGenerator{Rules: shogi.Rules{}}
+	for _, text := range []string{"e2e5", "e9d9", "S@c7", "d9e9", "e5e7u"} {
+		move, _ := notation.DecodeMove(text)
+		storage = generator.ApplyMove(storage, move).(shogi.PieceStorage)
+		fmt.Println(
+			notation.EncodePieceStorage(storage),
+			storage.Hands().Count(common.White, shogi.SilverKind),
+		)
+	}
+
+	// Output:
+	// 4k4/9/9/9/4R4/9/9/9/4K4 1
+	// 3k5/9/9/9/4R4/9/9/9/4K4 1
+	// 3k5/9/2S6/9/4R4/9/9/9/4K4 0
+	// 4k4/9/2S6/9/4R4/9/9/9/4K4 0
+	// 4k4/9/2S1U4/9/9/9/9/9/4K4 0
+}
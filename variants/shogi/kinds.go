@@ -0,0 +1,139 @@
+package shogi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces/betza"
+)
+
+// ...
+//
+// The king, the rook, the bishop, the knight and the pawn use the built-in
+// kinds, other kinds are described by the Kinds table.
+const (
+	GoldKind common.Kind = common.KindCount + iota
+	SilverKind
+	LanceKind
+	TokinKind
+	PromotedSilverKind
+	PromotedKnightKind
+	PromotedLanceKind
+	DragonKind
+	DragonHorseKind
+)
+
+// Kinds ...
+//
+// It describes Shogi kinds, which aren't built-in ones. Symbols
+// of unpromoted kinds match the SFEN ones, symbols of promoted kinds are used
+// only by the generic FEN coding with the table (see uci.Notation).
+var Kinds = common.KindTable{
+	GoldKind:           {Name: "gold general", Symbol: 'g', Value: 690},
+	SilverKind:         {Name: "silver general", Symbol: 's', Value: 640},
+	LanceKind:          {Name: "lance", Symbol: 'l', Value: 430},
+	TokinKind:          {Name: "tokin", Symbol: 't', Value: 420},
+	PromotedSilverKind: {Name: "promoted silver", Symbol: 'v', Value: 670},
+	PromotedKnightKind: {Name: "promoted knight", Symbol: 'j', Value: 640},
+	PromotedLanceKind:  {Name: "promoted lance", Symbol: 'y', Value: 630},
+	DragonKind:         {Name: "dragon king", Symbol: 'u', Value: 1300},
+	DragonHorseKind:    {Name: "dragon horse", Symbol: 'w', Value: 1150},
+}
+
+var goldMovement = betza.MustParse("WfF")
+
+var movements = map[common.Kind]betza.Movement{
+	common.King:        betza.MustParse("K"),
+	common.Rook:        betza.MustParse("R"),
+	common.Bishop:      betza.MustParse("B"),
+	GoldKind:           goldMovement,
+	SilverKind:         betza.MustParse("FfW"),
+	common.Knight:      betza.MustParse("ffN"),
+	LanceKind:          betza.MustParse("fR"),
+	common.Pawn:        betza.MustParse("fW"),
+	TokinKind:          goldMovement,
+	PromotedSilverKind: goldMovement,
+	PromotedKnightKind: goldMovement,
+	PromotedLanceKind:  goldMovement,
+	DragonKind:         betza.MustParse("RF"),
+	DragonHorseKind:    betza.MustParse("BW"),
+}
+
+var promotions = map[common.Kind]common.Kind{
+	common.Rook:   DragonKind,
+	common.Bishop: DragonHorseKind,
+	SilverKind:    PromotedSilverKind,
+	common.Knight: PromotedKnightKind,
+	LanceKind:     PromotedLanceKind,
+	common.Pawn:   TokinKind,
+}
+
+var demotions = map[common.Kind]common.Kind{
+	DragonKind:         common.Rook,
+	DragonHorseKind:    common.Bishop,
+	PromotedSilverKind: SilverKind,
+	PromotedKnightKind: common.Knight,
+	PromotedLanceKind:  LanceKind,
+	TokinKind:          common.Pawn,
+}
+
+// NewPiece ...
+//
+// It makes Shogi pieces, including ones of the built-in kinds with Shogi
+// moves (e.g. a knight jumps only forward). It returns nil for other kinds.
+func NewPiece(
+	kind common.Kind,
+	color common.Color,
+	position common.Position,
+) common.Piece {
+	return NewPieceFactory(nil)(kind, color, position)
+}
+
+// NewPieceFactory ...
+//
+// It returns a factory, which makes Shogi pieces and uses the fallback
+// factory for other kinds. The fallback can be nil.
+func NewPieceFactory(fallback common.PieceFactory) common.PieceFactory {
+	return func(
+		kind common.Kind,
+		color common.Color,
+		position common.Position,
+	) common.Piece {
+		if movement, ok := movements[kind]; ok {
+			return betza.NewPiece(kind, color, position, movement)
+		}
+
+		if fallback == nil {
+			return nil
+		}
+
+		return fallback(kind, color, position)
+	}
+}
+
+// IsKind ...
+//
+// It checks that the kind is a Shogi one.
+func IsKind(kind common.Kind) bool {
+	_, ok := movements[kind]
+	return ok
+}
+
+// PromotedKind ...
+//
+// It returns a promoted form of the kind. The flag is false if the kind
+// can't be promoted.
+func PromotedKind(kind common.Kind) (promotion common.Kind, ok bool) {
+	promotion, ok = promotions[kind]
+	return promotion, ok
+}
+
+// DemotedKind ...
+//
+// It returns an unpromoted form of the kind, i.e. a kind of a piece that goes
+// to a hand on a capture. It returns the kind itself if it isn't promoted.
+func DemotedKind(kind common.Kind) common.Kind {
+	if demotion, ok := demotions[kind]; ok {
+		return demotion
+	}
+
+	return kind
+}
@@ -0,0 +1,165 @@
+package shogi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/pieces/betza"
+)
+
+func TestNewPieceFactory(test *testing.T) {
+	type args struct {
+		fallback common.PieceFactory
+		kind     common.Kind
+	}
+	type data struct {
+		args args
+		want common.Piece
+	}
+
+	position := common.Position{File: 2, Rank: 3}
+	newBetzaPiece := func(kind common.Kind, notation string) common.Piece {
+		movement := betza.MustParse(notation)
+		return betza.NewPiece(kind, common.White, position, movement)
+	}
+	for _, data := range []data{
+		{
+			args: args{nil, common.King},
+			want: newBetzaPiece(common.King, "K"),
+		},
+		{
+			args: args{nil, common.Knight},
+			want: newBetzaPiece(common.Knight, "ffN"),
+		},
+		{
+			args: args{nil, common.Pawn},
+			want: newBetzaPiece(common.Pawn, "fW"),
+		},
+		{
+			args: args{nil, GoldKind},
+			want: newBetzaPiece(GoldKind, "WfF"),
+		},
+		{
+			args: args{nil, TokinKind},
+			want: newBetzaPiece(TokinKind, "WfF"),
+		},
+		{
+			args: args{nil, DragonKind},
+			want: newBetzaPiece(DragonKind, "RF"),
+		},
+		{
+			args: args{nil, common.Queen},
+			want: nil,
+		},
+		{
+			args: args{pieces.NewPiece, common.Queen},
+			want: pieces.NewQueen(common.White, position),
+		},
+	} {
+		factory := NewPieceFactory(data.args.fallback)
+		got := factory(data.args.kind, common.White, position)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestKinds(test *testing.T) {
+	position := common.Position{File: 2, Rank: 3}
+	for kind, descriptor := range Kinds {
+		if kind < common.KindCount {
+			test.Fail()
+		}
+		if NewPiece(kind, common.Black, position) == nil {
+			test.Fail()
+		}
+
+		gotKind, ok := Kinds.LookupKindBySymbol(descriptor.Symbol)
+		if !ok || gotKind != kind {
+			test.Fail()
+		}
+
+		// kinds of the table aren't registered
+		if _, ok := common.LookupKindBySymbol(descriptor.Symbol); ok {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsKind(test *testing.T) {
+	type data struct {
+		kind common.Kind
+		want bool
+	}
+
+	for _, data := range []data{
+		{common.King, true},
+		{common.Pawn, true},
+		{LanceKind, true},
+		{DragonHorseKind, true},
+		{common.Queen, false},
+		{common.Archbishop, false},
+	} {
+		got := IsKind(data.kind)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPromotedKind(test *testing.T) {
+	type data struct {
+		kind          common.Kind
+		wantPromotion common.Kind
+		wantOk        bool
+	}
+
+	for _, data := range []data{
+		{common.Rook, DragonKind, true},
+		{common.Bishop, DragonHorseKind, true},
+		{SilverKind, PromotedSilverKind, true},
+		{common.Knight, PromotedKnightKind, true},
+		{LanceKind, PromotedLanceKind, true},
+		{common.Pawn, TokinKind, true},
+		{common.King, 0, false},
+		{GoldKind, 0, false},
+		{TokinKind, 0, false},
+	} {
+		gotPromotion, gotOk := PromotedKind(data.kind)
+
+		if gotPromotion != data.wantPromotion {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
+
+func TestDemotedKind(test *testing.T) {
+	type data struct {
+		kind common.Kind
+		want common.Kind
+	}
+
+	for _, data := range []data{
+		{DragonKind, common.Rook},
+		{DragonHorseKind, common.Bishop},
+		{PromotedSilverKind, SilverKind},
+		{PromotedKnightKind, common.Knight},
+		{PromotedLanceKind, LanceKind},
+		{TokinKind, common.Pawn},
+		{GoldKind, GoldKind},
+		{common.Pawn, common.Pawn},
+	} {
+		got := DemotedKind(data.kind)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package shogi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+)
+
+// PieceStorage ...
+//
+// It wraps a piece storage and keeps pieces in hands and a color to move.
+// The latter is necessary for drops, because they don't specify a color.
+type PieceStorage struct {
+	common.PieceStorage
+
+	pieceFactory common.PieceFactory
+	color        common.Color
+	hands        crazyhouse.Reserves
+}
+
+// NewPieceStorage ...
+//
+// The piece factory should make Shogi pieces, see NewPieceFactory().
+func NewPieceStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+	hands crazyhouse.Reserves,
+) PieceStorage {
+	return PieceStorage{
+		PieceStorage: storage,
+
+		pieceFactory: pieceFactory,
+		color:        color,
+		hands:        hands,
+	}
+}
+
+// Color ...
+//
+// It returns a color to move.
+func (storage PieceStorage) Color() common.Color {
+	return storage.color
+}
+
+// Hands ...
+func (storage PieceStorage) Hands() crazyhouse.Reserves {
+	return storage.hands
+}
+
+// CheckMove ...
+//
+// A drop is checked for the color to move. It should go to an empty position,
+// from which the dropped piece has moves (see CanMoveFurther()). A pawn
+// can't be dropped to a file with an unpromoted pawn of the same color
+// (nifu).
+//
+// It doesn't check for a check before or after the move.
+func (storage PieceStorage) CheckMove(move common.Move) error {
+	if !move.IsDrop {
+		return storage.PieceStorage.CheckMove(move)
+	}
+
+	if !storage.Size().HasPosition(move.Finish) {
+		return common.ErrOutOfSize
+	}
+
+	if storage.hands.Count(storage.color, move.Drop) == 0 {
+		return common.ErrNoPiece
+	}
+
+	if _, ok := storage.Piece(move.Finish); ok {
+		return common.ErrIllegalMove
+	}
+
+	if !CanMoveFurther(storage.Size(), move.Drop, storage.color, move.Finish) {
+		return common.ErrIllegalMove
+	}
+
+	if move.Drop == common.Pawn && storage.hasPawnOnFile(move.Finish.File) {
+		return common.ErrIllegalMove
+	}
+
+	return nil
+}
+
+// ApplyMove ...
+//
+// A captured piece goes to a hand of the capturer in its unpromoted form.
+// A dropped piece is taken from a hand of the color to move. A promoted piece
+// replaces a moved one.
+func (storage PieceStorage) ApplyMove(move common.Move) common.PieceStorage {
+	if move.IsDrop {
+		piece := storage.pieceFactory(move.Drop, storage.color, move.Finish)
+
+		nextStorage := storage.update(storage.PieceStorage.SetPiece(piece))
+		nextStorage.hands = storage.hands.Remove(storage.color, move.Drop)
+		nextStorage.color = storage.color.Negative()
+		return nextStorage
+	}
+
+	piece, _ := storage.Piece(move.Start)
+	nextStorage := storage.update(storage.PieceStorage.ApplyMove(move))
+	if target, ok := storage.Piece(move.Finish); ok {
+		nextStorage.hands =
+			storage.hands.Add(piece.Color(), DemotedKind(target.Kind()))
+	}
+
+	if move.IsPromotion {
+		promotedPiece :=
+			storage.pieceFactory(move.Promotion, piece.Color(), move.Finish)
+		nextStorage.PieceStorage = nextStorage.PieceStorage.SetPiece(promotedPiece)
+	}
+
+	nextStorage.color = piece.Color().Negative()
+	return nextStorage
+}
+
+// SetPiece ...
+//
+// It doesn't change pieces in hands.
+func (storage PieceStorage) SetPiece(piece common.Piece) common.PieceStorage {
+	return storage.update(storage.PieceStorage.SetPiece(piece))
+}
+
+// RemovePiece ...
+//
+// It doesn't change pieces in hands.
+func (storage PieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return storage.update(storage.PieceStorage.RemovePiece(position))
+}
+
+func (storage PieceStorage) update(
+	baseStorage common.PieceStorage,
+) PieceStorage {
+	storage.PieceStorage = baseStorage
+	return storage
+}
+
+func (storage PieceStorage) hasPawnOnFile(file int) bool {
+	for rank := 0; rank < storage.Size().Height; rank++ {
+		piece, ok := storage.Piece(common.Position{File: file, Rank: rank})
+		if ok && piece.Kind() == common.Pawn && piece.Color() == storage.color {
+			return true
+		}
+	}
+
+	return false
+}
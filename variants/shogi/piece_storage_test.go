@@ -0,0 +1,297 @@
+package shogi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+)
+
+var testNotation = uci.Notation{Kinds: Kinds}
+
+func newTestPieceStorage(
+	test *testing.T,
+	fen string,
+	color common.Color,
+	hands crazyhouse.Reserves,
+) PieceStorage {
+	storage, err := testNotation.DecodePieceStorage(fen, NewPiece, boards.NewMapBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return NewPieceStorage(storage, NewPiece, color, hands)
+}
+
+func decodeTestMove(test *testing.T, text string) common.Move {
+	move, err := testNotation.DecodeMove(text)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return move
+}
+
+func equalHands(a crazyhouse.Reserves, b crazyhouse.Reserves) bool {
+	for _, color := range []common.Color{common.Black, common.White} {
+		kinds := a.Kinds(color)
+		if !reflect.DeepEqual(kinds, b.Kinds(color)) {
+			return false
+		}
+
+		for _, kind := range kinds {
+			if a.Count(color, kind) != b.Count(color, kind) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func TestPieceStorageCheckMove(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		hands crazyhouse.Reserves
+		move  string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	whitePawn := crazyhouse.Reserves{}.Add(common.White, common.Pawn)
+	blackKnight := crazyhouse.Reserves{}.Add(common.Black, common.Knight)
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "P@e5",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K4",
+				color: common.Black,
+				hands: whitePawn,
+				move:  "P@e5",
+			},
+			want: common.ErrNoPiece,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/9/4p4/9/9/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "P@e5",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			// a pawn can't be dropped to the last rank
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "P@a9",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			// a knight can't be dropped to two last ranks
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K4",
+				color: common.Black,
+				hands: blackKnight,
+				move:  "N@a2",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K4",
+				color: common.Black,
+				hands: blackKnight,
+				move:  "N@a3",
+			},
+			want: nil,
+		},
+		{
+			// nifu
+			args: args{
+				fen:   "4k4/9/9/9/9/9/4P4/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "P@e5",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			// a tokin and an enemy pawn don't restrict a pawn drop
+			args: args{
+				fen:   "4k4/4p4/9/9/9/9/4T4/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "P@e5",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "P@j5",
+			},
+			want: common.ErrOutOfSize,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "e1e3",
+			},
+			want: common.ErrIllegalMove,
+		},
+	} {
+		storage := newTestPieceStorage(
+			test,
+			data.args.fen,
+			data.args.color,
+			data.args.hands,
+		)
+		got := storage.CheckMove(decodeTestMove(test, data.args.move))
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageApplyMove(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		hands crazyhouse.Reserves
+		moves []string
+	}
+	type data struct {
+		args      args
+		wantFEN   string
+		wantColor common.Color
+		wantHands crazyhouse.Reserves
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: crazyhouse.Reserves{}.Add(common.White, SilverKind),
+				moves: []string{"S@e5"},
+			},
+			wantFEN:   "4k4/9/9/9/4S4/9/9/9/4K4",
+			wantColor: common.Black,
+			wantHands: crazyhouse.Reserves{},
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/9/4u4/9/9/9/4KR3",
+				color: common.White,
+				hands: crazyhouse.Reserves{},
+				moves: []string{"f1f5", "e9d9", "f5e5"},
+			},
+			wantFEN:   "3k5/9/9/9/4R4/9/9/9/4K4",
+			wantColor: common.Black,
+			wantHands: crazyhouse.Reserves{}.Add(common.White, common.Rook),
+		},
+		{
+			args: args{
+				fen:   "4k4/9/2P6/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: crazyhouse.Reserves{},
+				moves: []string{"c7c8t", "e9d9", "c8d8"},
+			},
+			wantFEN:   "3k5/3T5/9/9/9/9/9/9/4K4",
+			wantColor: common.Black,
+			wantHands: crazyhouse.Reserves{},
+		},
+		{
+			args: args{
+				fen:   "4k4/9/2P6/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: crazyhouse.Reserves{},
+				moves: []string{"c7c8t", "e9d9", "c8d8", "d9d8"},
+			},
+			wantFEN:   "9/3k5/9/9/9/9/9/9/4K4",
+			wantColor: common.White,
+			wantHands: crazyhouse.Reserves{}.Add(common.Black, common.Pawn),
+		},
+	} {
+		var storage common.PieceStorage = newTestPieceStorage(
+			test,
+			data.args.fen,
+			data.args.color,
+			data.args.hands,
+		)
+		for _, move := range data.args.moves {
+			storage = storage.ApplyMove(decodeTestMove(test, move))
+		}
+
+		shogiStorage := storage.(PieceStorage)
+		if testNotation.EncodePieceStorage(storage) != data.wantFEN {
+			test.Fail()
+		}
+		if shogiStorage.Color() != data.wantColor {
+			test.Fail()
+		}
+		if !equalHands(shogiStorage.Hands(), data.wantHands) {
+			test.Fail()
+		}
+	}
+}
+
+func TestPieceStorageSetPiece(test *testing.T) {
+	storage := newTestPieceStorage(
+		test,
+		"4k4/9/9/9/9/9/9/9/4K4",
+		common.White,
+		crazyhouse.Reserves{}.Add(common.White, common.Pawn),
+	)
+
+	position := common.Position{File: 1, Rank: 7}
+	nextStorage := storage.SetPiece(NewPiece(GoldKind, common.White, position))
+
+	if testNotation.EncodePieceStorage(nextStorage) != "4k4/1G7/9/9/9/9/9/9/4K4" {
+		test.Fail()
+	}
+	if nextStorage.(PieceStorage).Hands().Count(common.White, common.Pawn) != 1 {
+		test.Fail()
+	}
+}
+
+func TestPieceStorageRemovePiece(test *testing.T) {
+	storage := newTestPieceStorage(
+		test,
+		"4k4/1G7/9/9/9/9/9/9/4K4",
+		common.White,
+		crazyhouse.Reserves{}.Add(common.White, common.Pawn),
+	)
+
+	nextStorage := storage.RemovePiece(common.Position{File: 1, Rank: 7})
+
+	if testNotation.EncodePieceStorage(nextStorage) != "4k4/9/9/9/9/9/9/9/4K4" {
+		test.Fail()
+	}
+	if nextStorage.(PieceStorage).Hands().Count(common.White, common.Pawn) != 1 {
+		test.Fail()
+	}
+}
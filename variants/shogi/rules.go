@@ -0,0 +1,170 @@
+// Package shogi implements Shogi (Japanese chess).
+//
+// It provides its pieces with their promoted forms, drops of captured pieces
+// from hands, the promotion zone with mandatory promotions and the nifu
+// and uchifuzume restrictions. A board has a size 9x9, white (sente)
+// moves first and starts on lower ranks.
+//
+// See the encoding/sfen package for SFEN coding.
+package shogi
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Rules ...
+//
+// It implements the models.Rules, models.MoveExpander
+// and models.DropGenerator interfaces.
+//
+// A piece storage should be created by NewPieceStorage() with Shogi pieces,
+// otherwise drops and promotions aren't supported.
+type Rules struct {
+	models.OrthodoxRules
+}
+
+// CheckMove ...
+//
+// A promotion is allowed only for a piece that starts or finishes its move
+// in the promotion zone (see IsInPromotionZone()); it's mandatory if the piece
+// would have no moves otherwise (see CanMoveFurther()). A pawn drop can't
+// give a checkmate (uchifuzume).
+func (rules Rules) CheckMove(
+	storage common.PieceStorage,
+	move common.Move,
+) error {
+	if err := storage.CheckMove(move); err != nil {
+		return err
+	}
+
+	if move.IsDrop {
+		if move.Drop == common.Pawn && rules.isDropMate(storage, move) {
+			return common.ErrIllegalMove
+		}
+
+		return nil
+	}
+
+	size := storage.Size()
+	piece, _ := storage.Piece(move.Start)
+	if !move.IsPromotion {
+		if !CanMoveFurther(size, piece.Kind(), piece.Color(), move.Finish) {
+			return common.ErrIllegalMove
+		}
+
+		return nil
+	}
+
+	promotion, ok := PromotedKind(piece.Kind())
+	if !ok || move.Promotion != promotion {
+		return common.ErrIllegalMove
+	}
+	if !IsInPromotionZone(size, piece.Color(), move.Start) &&
+		!IsInPromotionZone(size, piece.Color(), move.Finish) {
+		return common.ErrIllegalMove
+	}
+
+	return nil
+}
+
+// ExpandMove ...
+//
+// It expands a move of a promotable piece, which starts or finishes
+// in the promotion zone, to a promotion and the move itself.
+func (rules Rules) ExpandMove(
+	storage common.PieceStorage,
+	move common.Move,
+) []common.Move {
+	if move.IsDrop || move.IsPromotion {
+		return nil
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok {
+		return nil
+	}
+
+	promotion, ok := PromotedKind(piece.Kind())
+	if !ok {
+		return nil
+	}
+
+	size := storage.Size()
+	if !IsInPromotionZone(size, piece.Color(), move.Start) &&
+		!IsInPromotionZone(size, piece.Color(), move.Finish) {
+		return nil
+	}
+
+	promotionMove := move
+	promotionMove.Promotion, promotionMove.IsPromotion = promotion, true
+	return []common.Move{promotionMove, move}
+}
+
+// Drops ...
+//
+// It returns drops of all pieces in a hand of the color to all empty
+// positions, if the color is to move. They are restricted by CheckMove().
+func (rules Rules) Drops(
+	storage common.PieceStorage,
+	color common.Color,
+) []common.Move {
+	shogiStorage, ok := storage.(PieceStorage)
+	if !ok || shogiStorage.Color() != color {
+		return nil
+	}
+
+	kinds := shogiStorage.Hands().Kinds(color)
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	var moves []common.Move
+	for _, position := range storage.Size().Positions() {
+		if _, ok := storage.Piece(position); ok {
+			continue
+		}
+
+		for _, kind := range kinds {
+			moves = append(moves, common.Move{
+				Finish: position,
+				Drop:   kind,
+				IsDrop: true,
+			})
+		}
+	}
+
+	return moves
+}
+
+// NoMovesResult ...
+//
+// It returns a win of the opponent on both a checkmate and a stalemate.
+func (rules Rules) NoMovesResult(
+	color common.Color,
+	isCheck bool,
+) models.Result {
+	return models.Win(color.Negative())
+}
+
+// it checks that the drop gives a check, from which the opponent
+// has no legal moves
+func (rules Rules) isDropMate(
+	storage common.PieceStorage,
+	move common.Move,
+) bool {
+	shogiStorage, ok := storage.(PieceStorage)
+	if !ok {
+		return false
+	}
+
+	color := shogiStorage.Color()
+	nextStorage := rules.ApplyMove(storage, move)
+	if !common.IsCheck(nextStorage, color) {
+		return false
+	}
+
+	generator := models.MoveGenerator{Rules: rules}
+	moves, err := generator.LegalMovesForColor(nextStorage, color.Negative())
+	return err == nil && len(moves) == 0
+}
@@ -0,0 +1,47 @@
+//go:build long
+// +build long
+
+package shogi
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+)
+
+func TestPerft_long(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		hands crazyhouse.Reserves
+		deep  int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{InitialFEN, common.White, crazyhouse.Reserves{}, 3},
+			want: 25470,
+		},
+		{
+			args: args{middlegameFEN, common.Black, middlegameHands(), 2},
+			want: 28684,
+		},
+	} {
+		got := testPerft(
+			test,
+			data.args.fen,
+			data.args.color,
+			data.args.hands,
+			data.args.deep,
+		)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,454 @@
+package shogi
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+)
+
+func TestRulesCheckMove(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		hands crazyhouse.Reserves
+		move  string
+	}
+	type data struct {
+		args args
+		want error
+	}
+
+	whitePawn := crazyhouse.Reserves{}.Add(common.White, common.Pawn)
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "4k4/9/9/2P6/9/9/9/9/4K4",
+				color: common.White,
+				move:  "c6c7t",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/2P6/9/9/9/9/4K4",
+				color: common.White,
+				move:  "c6c7",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/9/2P6/9/9/9/4K4",
+				color: common.White,
+				move:  "c5c6t",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			// a promotion is mandatory for a pawn on the last rank
+			args: args{
+				fen:   "4k4/2P6/9/9/9/9/9/9/4K4",
+				color: common.White,
+				move:  "c8c9",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:   "4k4/2P6/9/9/9/9/9/9/4K4",
+				color: common.White,
+				move:  "c8c9t",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/9/9/9/9/9/9/4K1n2",
+				color: common.Black,
+				move:  "g1f3",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:   "4k4/2P6/9/9/9/9/9/9/4K4",
+				color: common.White,
+				move:  "c8c9u",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			args: args{
+				fen:   "4k4/9/2G6/9/9/9/9/9/4K4",
+				color: common.White,
+				move:  "c7c8t",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			// a silver leaving the promotion zone can be promoted
+			args: args{
+				fen:   "4k4/9/2S6/9/9/9/9/9/4K4",
+				color: common.White,
+				move:  "c7b6v",
+			},
+			want: nil,
+		},
+		{
+			args: args{
+				fen:   "4k4/4P4/9/9/9/9/9/9/4K4",
+				color: common.White,
+				move:  "e8e9t",
+			},
+			want: common.ErrKingCapture,
+		},
+		{
+			// uchifuzume
+			args: args{
+				fen:   "3lkl3/9/4G4/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "P@e8",
+			},
+			want: common.ErrIllegalMove,
+		},
+		{
+			// the king escapes to f9
+			args: args{
+				fen:   "3lk4/9/4G4/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: whitePawn,
+				move:  "P@e8",
+			},
+			want: nil,
+		},
+		{
+			// a gold drop can give a checkmate
+			args: args{
+				fen:   "3lkl3/9/4G4/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: crazyhouse.Reserves{}.Add(common.White, GoldKind),
+				move:  "G@e8",
+			},
+			want: nil,
+		},
+	} {
+		storage := newTestPieceStorage(
+			test,
+			data.args.fen,
+			data.args.color,
+			data.args.hands,
+		)
+
+		var rules Rules
+		got := rules.CheckMove(storage, decodeTestMove(test, data.args.move))
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesExpandMove(test *testing.T) {
+	type args struct {
+		fen  string
+		move string
+	}
+	type data struct {
+		args args
+		want []string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{"4k4/9/2P6/9/9/9/9/9/4K4", "c7c8"},
+			want: []string{"c7c8t", "c7c8"},
+		},
+		{
+			args: args{"4k4/9/9/9/9/9/9/1b7/4K4", "b2a1"},
+			want: []string{"b2a1w", "b2a1"},
+		},
+		{
+			args: args{"4k4/9/9/9/2P6/9/9/9/4K4", "c5c6"},
+			want: nil,
+		},
+		{
+			args: args{"4k4/9/2G6/9/9/9/9/9/4K4", "c7c8"},
+			want: nil,
+		},
+		{
+			args: args{"4k4/9/2P6/9/9/9/9/9/4K4", "c7c8t"},
+			want: nil,
+		},
+		{
+			args: args{"4k4/9/9/9/9/9/9/9/4K4", "P@c8"},
+			want: nil,
+		},
+		{
+			args: args{"4k4/9/9/9/9/9/9/9/4K4", "c7c8"},
+			want: nil,
+		},
+	} {
+		storage := newTestPieceStorage(
+			test,
+			data.args.fen,
+			common.White,
+			crazyhouse.Reserves{},
+		)
+
+		var rules Rules
+		moves := rules.ExpandMove(storage, decodeTestMove(test, data.args.move))
+
+		var got []string
+		for _, move := range moves {
+			got = append(got, testNotation.EncodeMove(move))
+		}
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesDrops(test *testing.T) {
+	type args struct {
+		storage common.PieceStorage
+		color   common.Color
+	}
+	type data struct {
+		args      args
+		wantCount int
+		wantFirst string
+	}
+
+	fen := "4k4/9/9/9/9/9/9/9/4K4"
+	hands := crazyhouse.Reserves{}.
+		Add(common.White, common.Pawn).
+		Add(common.White, GoldKind)
+	for _, data := range []data{
+		{
+			args: args{
+				storage: newTestPieceStorage(test, fen, common.White, hands),
+				color:   common.White,
+			},
+			wantCount: 158,
+			wantFirst: "P@a1",
+		},
+		{
+			args: args{
+				storage: newTestPieceStorage(test, fen, common.Black, hands),
+				color:   common.Black,
+			},
+			wantCount: 0,
+		},
+		{
+			args: args{
+				storage: newTestPieceStorage(test, fen, common.Black, hands),
+				color:   common.White,
+			},
+			wantCount: 0,
+		},
+		{
+			args: args{
+				storage: newTestPieceStorage(test, fen, common.White, hands).
+					PieceStorage,
+				color: common.White,
+			},
+			wantCount: 0,
+		},
+	} {
+		var rules Rules
+		got := rules.Drops(data.args.storage, data.args.color)
+
+		if len(got) != data.wantCount {
+			test.Fail()
+		}
+		if len(got) != 0 && testNotation.EncodeMove(got[0]) != data.wantFirst {
+			test.Fail()
+		}
+	}
+}
+
+func TestRulesNoMovesResult(test *testing.T) {
+	type args struct {
+		color   common.Color
+		isCheck bool
+	}
+	type data struct {
+		args args
+		want models.Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.White, true},
+			want: models.BlackWin,
+		},
+		{
+			args: args{common.White, false},
+			want: models.BlackWin,
+		},
+		{
+			args: args{common.Black, false},
+			want: models.WhiteWin,
+		},
+	} {
+		var rules Rules
+		got := rules.NoMovesResult(data.args.color, data.args.isCheck)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withMoveGenerator(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		hands crazyhouse.Reserves
+	}
+	type data struct {
+		args       args
+		wantCount  int
+		wantResult models.Result
+	}
+
+	for _, data := range []data{
+		{
+			// the king move (the lances attack the d and f files), the gold moves
+			// and the pawn drops except a drop to e8 (uchifuzume) and ones
+			// to the last rank
+			args: args{
+				fen:   "3lkl3/9/4G4/9/9/9/9/9/4K4",
+				color: common.White,
+				hands: crazyhouse.Reserves{}.Add(common.White, common.Pawn),
+			},
+			wantCount:  1 + 6 + 76 - 1 - 6,
+			wantResult: models.Unfinished,
+		},
+		{
+			// a checkmate by a gold drop
+			args: args{
+				fen:   "3lkl3/4G4/4G4/9/9/9/9/9/4K4",
+				color: common.Black,
+			},
+			wantCount:  0,
+			wantResult: models.WhiteWin,
+		},
+		{
+			// a stalemate is a loss
+			args: args{
+				fen:   "k8/2G6/1G7/9/9/9/9/9/4K4",
+				color: common.Black,
+			},
+			wantCount:  0,
+			wantResult: models.WhiteWin,
+		},
+	} {
+		storage := newTestPieceStorage(
+			test,
+			data.args.fen,
+			data.args.color,
+			data.args.hands,
+		)
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		moves, err := generator.LegalMovesForColor(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		gotResult, err := generator.Result(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		if len(moves) != data.wantCount {
+			test.Fail()
+		}
+		if gotResult != data.wantResult {
+			test.Fail()
+		}
+	}
+}
+
+// it's the SFEN "l6nl/5+P1gk/2np1S3/p1p4Pp/3P2Sp1/1PPb2P1P/P5GS1/R8/
+// LN4bKL w GR5pnsg 1"
+const middlegameFEN = "l6nl/5T1gk/2np1S3/p1p4Pp/3P2Sp1/1PPb2P1P/P5GS1/R8/" +
+	"LN4bKL"
+
+func middlegameHands() crazyhouse.Reserves {
+	hands := crazyhouse.Reserves{}.
+		Add(common.White, GoldKind).
+		Add(common.White, common.Rook).
+		Add(common.Black, common.Knight).
+		Add(common.Black, SilverKind).
+		Add(common.Black, GoldKind)
+	for i := 0; i < 5; i++ {
+		hands = hands.Add(common.Black, common.Pawn)
+	}
+
+	return hands
+}
+
+func testPerft(
+	test *testing.T,
+	fen string,
+	color common.Color,
+	hands crazyhouse.Reserves,
+	deep int,
+) int {
+	board, err := testNotation.DecodePieceStorage(fen, NewPiece, boards.NewSliceBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	storage := NewPieceStorage(board, NewPiece, color, hands)
+	generator := models.MoveGenerator{Rules: Rules{}}
+	return models.Perft(generator, storage, color, deep, nil)
+}
+
+func TestPerft(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+		hands crazyhouse.Reserves
+		deep  int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{InitialFEN, common.White, crazyhouse.Reserves{}, 1},
+			want: 30,
+		},
+		{
+			args: args{InitialFEN, common.White, crazyhouse.Reserves{}, 2},
+			want: 900,
+		},
+		{
+			args: args{middlegameFEN, common.Black, middlegameHands(), 1},
+			want: 207,
+		},
+	} {
+		got := testPerft(
+			test,
+			data.args.fen,
+			data.args.color,
+			data.args.hands,
+			data.args.deep,
+		)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package threecheck_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/threecheck"
+)
+
+func ExampleRules() {
+	storage, _ := threecheck.DecodePieceStorage(
+		"4k3/8/8/8/8/8/8/R3K3 +2+0",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+
+	generator := models.MoveGenerator{Rules: threecheck.Rules{}}
+	move, _ := uci.DecodeMove("a1a8")
+	nextStorage := generator.ApplyMove(storage, move).(threecheck.PieceStorage)
+	fmt.Println(threecheck.EncodePieceStorage(nextStorage))
+
+	result, _ := generator.Result(nextStorage, common.Black)
+	fmt.Println(result == models.WhiteWin)
+
+	// Output:
+	// R3k3/8/8/8/8/8/8/4K3 +3+0
+	// true
+}
@@ -0,0 +1,97 @@
+package threecheck
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+const checkSeparator = "+"
+
+// DecodeChecks ...
+//
+// It decodes counts of checks in the +N+M form, where N is a count of checks
+// given by white and M is the same for black.
+func DecodeChecks(text string) (Checks, error) {
+	parts := strings.Split(text, checkSeparator)
+	if len(parts) != 3 || parts[0] != "" {
+		return Checks{}, errors.New("incorrect check count format")
+	}
+
+	whiteChecks, err := decodeCheckCount(parts[1])
+	if err != nil {
+		return Checks{}, err
+	}
+
+	blackChecks, err := decodeCheckCount(parts[2])
+	if err != nil {
+		return Checks{}, err
+	}
+
+	return Checks{common.Black: blackChecks, common.White: whiteChecks}, nil
+}
+
+func decodeCheckCount(text string) (int, error) {
+	count, err := strconv.Atoi(text)
+	if err != nil || count < 0 || count > WinningCheckCount {
+		return 0, fmt.Errorf("incorrect check count %q", text)
+	}
+
+	return count, nil
+}
+
+// EncodeChecks ...
+//
+// It encodes counts of checks in the +N+M form, see DecodeChecks().
+func EncodeChecks(checks Checks) string {
+	return fmt.Sprintf(
+		"+%d+%d",
+		checks[common.White],
+		checks[common.Black],
+	)
+}
+
+// DecodePieceStorage ...
+//
+// It decodes a piece placement of FEN followed by counts of checks
+// separated by a space (e.g. "4k3/8/8/8/8/8/8/4K3 +2+0").
+func DecodePieceStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (PieceStorage, error) {
+	fields := strings.Fields(fen)
+	if len(fields) != 2 {
+		return PieceStorage{}, errors.New("incorrect field count")
+	}
+
+	storage, err :=
+		uci.DecodePieceStorage(fields[0], pieceFactory, pieceStorageFactory)
+	if err != nil {
+		return PieceStorage{}, fmt.Errorf("unable to decode the board: %s", err)
+	}
+
+	checks, err := DecodeChecks(fields[1])
+	if err != nil {
+		return PieceStorage{}, fmt.Errorf("unable to decode checks: %s", err)
+	}
+
+	return NewPieceStorage(storage, checks), nil
+}
+
+// EncodePieceStorage ...
+//
+// It encodes the piece storage in the form of DecodePieceStorage().
+// Counts of checks are zero if the piece storage isn't a PieceStorage.
+func EncodePieceStorage(storage common.PieceStorage) string {
+	var checks Checks
+	if threeCheckStorage, ok := storage.(PieceStorage); ok {
+		checks = threeCheckStorage.Checks()
+	}
+
+	return uci.EncodePieceStorage(storage) + " " + EncodeChecks(checks)
+}
@@ -0,0 +1,193 @@
+package threecheck
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestDecodeChecks(test *testing.T) {
+	type args struct {
+		text string
+	}
+	type data struct {
+		args       args
+		wantChecks Checks
+		wantErr    bool
+	}
+
+	for _, data := range []data{
+		{
+			args:       args{"+0+0"},
+			wantChecks: Checks{},
+			wantErr:    false,
+		},
+		{
+			args:       args{"+2+1"},
+			wantChecks: Checks{common.Black: 1, common.White: 2},
+			wantErr:    false,
+		},
+		{
+			args:       args{"2+1"},
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+		{
+			args:       args{"+2"},
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+		{
+			args:       args{"+x+1"},
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+		{
+			args:       args{"+1+4"},
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+		{
+			args:       args{"+1+-1"},
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+	} {
+		gotChecks, gotErr := DecodeChecks(data.args.text)
+
+		if gotChecks != data.wantChecks {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodeChecks(test *testing.T) {
+	type args struct {
+		checks Checks
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{Checks{}},
+			want: "+0+0",
+		},
+		{
+			args: args{Checks{common.Black: 1, common.White: 2}},
+			want: "+2+1",
+		},
+	} {
+		got := EncodeChecks(data.args.checks)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		fen string
+	}
+	type data struct {
+		args       args
+		wantBoard  string
+		wantChecks Checks
+		wantErr    bool
+	}
+
+	for _, data := range []data{
+		{
+			args:       args{"4k3/8/8/8/8/8/8/4K3 +2+0"},
+			wantBoard:  "4k3/8/8/8/8/8/8/4K3",
+			wantChecks: Checks{common.White: 2},
+			wantErr:    false,
+		},
+		{
+			args:       args{"4k3/8/8/8/8/8/8/4K3"},
+			wantBoard:  "",
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+		{
+			args:       args{"4k3/8/8/8/8/8/8/4K3 +2+0 +1+1"},
+			wantBoard:  "",
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+		{
+			args:       args{"4k3/8/8/8/8/8/8/4X3 +2+0"},
+			wantBoard:  "",
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+		{
+			args:       args{"4k3/8/8/8/8/8/8/4K3 2+0"},
+			wantBoard:  "",
+			wantChecks: Checks{},
+			wantErr:    true,
+		},
+	} {
+		got, gotErr :=
+			DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+
+		var gotBoard string
+		if got.PieceStorage != nil {
+			gotBoard = uci.EncodePieceStorage(got)
+		}
+		if gotBoard != data.wantBoard {
+			test.Fail()
+		}
+		if got.Checks() != data.wantChecks {
+			test.Fail()
+		}
+		if hasErr := gotErr != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePieceStorage(test *testing.T) {
+	storage, err := uci.DecodePieceStorage(
+		"4k3/8/8/8/8/8/8/4K3",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	type data struct {
+		storage common.PieceStorage
+		want    string
+	}
+
+	for _, data := range []data{
+		{
+			storage: NewPieceStorage(
+				storage,
+				Checks{common.Black: 1, common.White: 2},
+			),
+			want: "4k3/8/8/8/8/8/8/4K3 +2+1",
+		},
+		{
+			storage: storage,
+			want:    "4k3/8/8/8/8/8/8/4K3 +0+0",
+		},
+	} {
+		got := EncodePieceStorage(data.storage)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
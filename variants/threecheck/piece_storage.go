@@ -0,0 +1,76 @@
+package threecheck
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+// Checks ...
+//
+// It's counts of checks given by each color.
+type Checks [common.ColorCount]int
+
+// PieceStorage ...
+//
+// It wraps a piece storage and keeps counts of given checks.
+type PieceStorage struct {
+	common.PieceStorage
+
+	checks Checks
+}
+
+// NewPieceStorage ...
+func NewPieceStorage(storage common.PieceStorage, checks Checks) PieceStorage {
+	return PieceStorage{PieceStorage: storage, checks: checks}
+}
+
+// Checks ...
+func (storage PieceStorage) Checks() Checks {
+	return storage.checks
+}
+
+// ClassicStorage ...
+//
+// It returns the wrapped classic.PieceStorage, if any, so the wrapper doesn't
+// hide the special moves (see the classic.Wrapper interface).
+func (storage PieceStorage) ClassicStorage() (classic.PieceStorage, bool) {
+	return classic.Unwrap(storage.PieceStorage)
+}
+
+// ApplyMove ...
+//
+// It increases a count of checks of the moving color if the move checks
+// the enemy king.
+func (storage PieceStorage) ApplyMove(move common.Move) common.PieceStorage {
+	piece, ok := storage.Piece(move.Start)
+
+	nextStorage := storage.update(storage.PieceStorage.ApplyMove(move))
+	if ok && common.IsCheck(nextStorage.PieceStorage, piece.Color()) {
+		nextStorage.checks[piece.Color()]++
+	}
+
+	return nextStorage
+}
+
+// SetPiece ...
+//
+// It doesn't change counts of checks.
+func (storage PieceStorage) SetPiece(piece common.Piece) common.PieceStorage {
+	return storage.update(storage.PieceStorage.SetPiece(piece))
+}
+
+// RemovePiece ...
+//
+// It doesn't change counts of checks.
+func (storage PieceStorage) RemovePiece(
+	position common.Position,
+) common.PieceStorage {
+	return storage.update(storage.PieceStorage.RemovePiece(position))
+}
+
+func (storage PieceStorage) update(
+	baseStorage common.PieceStorage,
+) PieceStorage {
+	storage.PieceStorage = baseStorage
+	return storage
+}
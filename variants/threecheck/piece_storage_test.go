@@ -0,0 +1,115 @@
+package threecheck
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestPieceStorageApplyMove(test *testing.T) {
+	type args struct {
+		fen   string
+		moves []string
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/R3K3 +0+0",
+				moves: []string{"a1a7"},
+			},
+			want: "4k3/R7/8/8/8/8/8/4K3 +0+0",
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/R3K3 +1+0",
+				moves: []string{"a1a8"},
+			},
+			want: "R3k3/8/8/8/8/8/8/4K3 +2+0",
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/R3K2r +0+0",
+				moves: []string{"a1a8", "h1e1"},
+			},
+			want: "R3k3/8/8/8/8/8/8/4r3 +1+0",
+		},
+		{
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/R3K2r +0+2",
+				moves: []string{"a1a2", "h1h2", "a2e2", "h2e2"},
+			},
+			want: "4k3/8/8/8/8/8/4r3/4K3 +1+3",
+		},
+	} {
+		for _, factory := range []uci.PieceStorageFactory{
+			boards.NewMapBoard,
+			boards.NewSliceBoard,
+			func(size common.Size, pieceGroup []common.Piece) common.PieceStorage {
+				return boards.NewBitBoard(size, pieceGroup, pieces.NewPiece)
+			},
+		} {
+			storage, err :=
+				DecodePieceStorage(data.args.fen, pieces.NewPiece, factory)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			for _, text := range data.args.moves {
+				move, err := uci.DecodeMove(text)
+				if err != nil {
+					test.Fatal(err)
+				}
+
+				storage = storage.ApplyMove(move).(PieceStorage)
+			}
+
+			if EncodePieceStorage(storage) != data.want {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestPieceStorageSetPiece(test *testing.T) {
+	storage, err := DecodePieceStorage(
+		"4k3/8/8/8/8/8/8/4K3 +1+2",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	rook := pieces.NewRook(common.White, common.Position{File: 4, Rank: 6})
+	got := storage.SetPiece(rook).(PieceStorage)
+
+	if EncodePieceStorage(got) != "4k3/4R3/8/8/8/8/8/4K3 +1+2" {
+		test.Fail()
+	}
+}
+
+func TestPieceStorageRemovePiece(test *testing.T) {
+	storage, err := DecodePieceStorage(
+		"4k3/8/8/8/8/8/8/R3K3 +1+2",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	got :=
+		storage.RemovePiece(common.Position{File: 0, Rank: 0}).(PieceStorage)
+
+	if EncodePieceStorage(got) != "4k3/8/8/8/8/8/8/4K3 +1+2" {
+		test.Fail()
+	}
+}
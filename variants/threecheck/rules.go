@@ -0,0 +1,54 @@
+// Package threecheck implements the rules of Three-check chess.
+//
+// A player who checks the enemy king for the third time wins. Otherwise,
+// the game follows the orthodox rules including their special moves
+// (see the classic package).
+package threecheck
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+// WinningCheckCount ...
+//
+// It's a count of checks that wins the game.
+const WinningCheckCount = 3
+
+// Rules ...
+//
+// It implements the models.Rules and models.MoveExpander interfaces.
+// Checkmate and stalemate are resolved as in orthodox chess. The special moves
+// are enabled by the embedded classic.Rules.
+//
+// A piece storage should be created by NewPieceStorage() over
+// a classic.PieceStorage, otherwise checks aren't counted or the special moves
+// aren't supported.
+type Rules struct {
+	classic.Rules
+}
+
+// Termination ...
+//
+// It returns a win of the opponent if the latter has given enough checks.
+//
+// The game goes on if the color attacks the enemy king, because the last move
+// was illegal then and the move generator should report a king capture.
+func (rules Rules) Termination(
+	storage common.PieceStorage,
+	color common.Color,
+) models.Result {
+	threeCheckStorage, ok := storage.(PieceStorage)
+	if !ok {
+		return models.Unfinished
+	}
+
+	opponent := color.Negative()
+	if threeCheckStorage.Checks()[opponent] < WinningCheckCount ||
+		common.IsCheck(storage, color) {
+		return models.Unfinished
+	}
+
+	return models.Win(opponent)
+}
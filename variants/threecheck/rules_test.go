@@ -0,0 +1,178 @@
+package threecheck
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+func TestRulesTermination(test *testing.T) {
+	type args struct {
+		storage common.PieceStorage
+		color   common.Color
+	}
+	type data struct {
+		args args
+		want models.Result
+	}
+
+	decode := func(fen string) PieceStorage {
+		storage, err := DecodePieceStorage(fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		return storage
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				storage: decode("4k3/8/8/8/8/8/8/R3K3 +2+2"),
+				color:   common.Black,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				storage: decode("R3k3/8/8/8/8/8/8/4K3 +3+0"),
+				color:   common.Black,
+			},
+			want: models.WhiteWin,
+		},
+		{
+			args: args{
+				storage: decode("4k3/8/8/8/8/8/8/4K2r +0+3"),
+				color:   common.White,
+			},
+			want: models.BlackWin,
+		},
+		{
+			// the third check has been given by an illegal move
+			args: args{
+				storage: decode("R3k3/8/8/8/8/8/8/4K2r +3+0"),
+				color:   common.Black,
+			},
+			want: models.Unfinished,
+		},
+		{
+			args: args{
+				storage: boards.NewMapBoard(common.Size{Width: 8, Height: 8}, nil),
+				color:   common.Black,
+			},
+			want: models.Unfinished,
+		},
+	} {
+		var rules Rules
+		got := rules.Termination(data.args.storage, data.args.color)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withMoveGenerator(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args       args
+		wantMoves  []string
+		wantResult models.Result
+	}
+
+	for _, data := range []data{
+		{
+			// the third check can't be given by leaving the own king in a check
+			args: args{
+				fen:   "4k3/8/8/8/8/8/8/r2RK3 +2+0",
+				color: common.White,
+			},
+			wantMoves: []string{
+				"d1a1",
+				"d1b1",
+				"d1c1",
+				"e1d2",
+				"e1e2",
+				"e1f1",
+				"e1f2",
+			},
+			wantResult: models.Unfinished,
+		},
+		{
+			args: args{
+				fen:   "3Rk3/8/8/8/8/8/8/4K3 +3+0",
+				color: common.Black,
+			},
+			wantMoves:  nil,
+			wantResult: models.WhiteWin,
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(data.args.fen, pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		moves, err := generator.LegalMovesForColor(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var gotMoves []string
+		for _, move := range moves {
+			gotMoves = append(gotMoves, uci.EncodeMove(move))
+		}
+		sort.Strings(gotMoves)
+
+		gotResult, err := generator.Result(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotResult != data.wantResult {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withClassicStorage(test *testing.T) {
+	classicStorage, err := classic.DecodePieceStorage(
+		"5k2/8/8/8/8/8/8/4K2R K -",
+		pieces.NewPiece,
+		boards.NewMapBoard,
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	move, err := uci.DecodeMove("e1g1")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	rules := Rules{Rules: classic.Rules{Castling: true}}
+	storage := NewPieceStorage(classicStorage, Checks{})
+	if err := rules.CheckMove(storage, move); err != nil {
+		test.Fatal(err)
+	}
+
+	got := rules.ApplyMove(storage, move)
+
+	if EncodePieceStorage(got) != "5k2/8/8/8/8/8/8/5RK1 +1+0" {
+		test.Fail()
+	}
+}
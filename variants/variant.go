@@ -0,0 +1,200 @@
+// Package variants provides a catalog of chess variants and minichess
+// presets with their initial positions and rule differences.
+package variants
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+)
+
+// Features ...
+//
+// It describes special moves played in a variant.
+type Features struct {
+	PawnDoubleStep bool
+	EnPassant      bool
+	Castling       bool
+	Promotions     []common.Kind // kinds allowed for a promotion
+}
+
+// ClassicRules ...
+//
+// It returns the orthodox rules with the special moves of the features.
+func (features Features) ClassicRules() classic.Rules {
+	return classic.Rules{
+		PawnDoubleStep: features.PawnDoubleStep,
+		EnPassant:      features.EnPassant,
+		Castling:       features.Castling,
+		Promotions:     append([]common.Kind(nil), features.Promotions...),
+	}
+}
+
+// Variant ...
+type Variant struct {
+	Name        string
+	Description string
+	InitialFEN  string // only a piece placement
+	Features    Features
+
+	// it describes kinds of the variant, which aren't built-in ones
+	// (e.g. see xiangqi.Kinds); they're coded by Notation()
+	Kinds common.KindTable
+
+	// it describes colors of players in order of their turns
+	// (e.g. see fourplayer.Colors); nil means white and black
+	Colors []common.Color
+
+	// nil means the orthodox rules with the special moves of the features
+	// (see Features.ClassicRules()); otherwise, the rules should play
+	// the features themselves
+	Rules models.Rules
+
+	// it wraps a piece storage to keep a variant-specific state (e.g. pieces
+	// in hands); nil means wrapping by classic.NewPieceStorage() with initial
+	// castling rights if the Rules field is nil and using a piece storage as is
+	// otherwise
+	StorageWrapper func(
+		storage common.PieceStorage,
+		pieceFactory common.PieceFactory,
+		color common.Color,
+	) common.PieceStorage
+
+	// it wraps a piece factory to make variant-specific pieces
+	// (e.g. with other moves of built-in kinds); nil means that a piece factory
+	// is used as is
+	PieceFactoryWrapper func(
+		pieceFactory common.PieceFactory,
+	) common.PieceFactory
+
+	// they code a piece placement of the variant in its own notation
+	// (e.g. the Three-check FEN), which is also used by the InitialFEN field;
+	// nil means FEN of Notation()
+	PieceStorageDecoder func(
+		fen string,
+		pieceFactory common.PieceFactory,
+		pieceStorageFactory uci.PieceStorageFactory,
+	) (common.PieceStorage, error)
+	PieceStorageEncoder func(storage common.PieceStorage) string
+}
+
+// NewMoveGenerator ...
+//
+// It creates a move generator that follows the rules of the variant.
+func (variant Variant) NewMoveGenerator() models.MoveGenerator {
+	if variant.Rules == nil {
+		return models.MoveGenerator{Rules: variant.Features.ClassicRules()}
+	}
+
+	return models.MoveGenerator{Rules: variant.Rules}
+}
+
+// Notation ...
+//
+// It returns pure algebraic coordinate notation and FEN with kinds
+// of the variant and holes.
+func (variant Variant) Notation() uci.Notation {
+	return uci.Notation{
+		Kinds:           variant.Kinds,
+		GeometryWrapper: boards.NewGeometryBoard,
+	}
+}
+
+// FirstColor ...
+//
+// It returns a color that moves first in the variant.
+func (variant Variant) FirstColor() common.Color {
+	if len(variant.Colors) == 0 {
+		return common.White
+	}
+
+	return variant.Colors[0]
+}
+
+// DecodePieceStorage ...
+//
+// It decodes a piece storage from a piece placement of the variant
+// (see the PieceStorageDecoder field). The piece storage isn't wrapped
+// by WrapPieceStorage().
+func (variant Variant) DecodePieceStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	if variant.PieceStorageDecoder == nil {
+		return variant.Notation().
+			DecodePieceStorage(fen, pieceFactory, pieceStorageFactory)
+	}
+
+	return variant.PieceStorageDecoder(fen, pieceFactory, pieceStorageFactory)
+}
+
+// EncodePieceStorage ...
+//
+// It converts the piece storage to a piece placement of the variant
+// (see the PieceStorageEncoder field).
+func (variant Variant) EncodePieceStorage(storage common.PieceStorage) string {
+	if variant.PieceStorageEncoder == nil {
+		return variant.Notation().EncodePieceStorage(storage)
+	}
+
+	return variant.PieceStorageEncoder(storage)
+}
+
+// NewPieceStorage ...
+//
+// It creates the initial position of the variant with the first color
+// to move (see FirstColor()). The piece factory is wrapped
+// by WrapPieceFactory(); a piece storage factory that makes pieces itself
+// (e.g. boards.BitBoard) should use the wrapped one.
+func (variant Variant) NewPieceStorage(
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	pieceFactory = variant.WrapPieceFactory(pieceFactory)
+	storage, err := variant.DecodePieceStorage(
+		variant.InitialFEN,
+		pieceFactory,
+		pieceStorageFactory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	color := variant.FirstColor()
+	return variant.WrapPieceStorage(storage, pieceFactory, color), nil
+}
+
+// WrapPieceFactory ...
+//
+// It prepares the piece factory for the variant.
+func (variant Variant) WrapPieceFactory(
+	pieceFactory common.PieceFactory,
+) common.PieceFactory {
+	if variant.PieceFactoryWrapper == nil {
+		return pieceFactory
+	}
+
+	return variant.PieceFactoryWrapper(pieceFactory)
+}
+
+// WrapPieceStorage ...
+//
+// It prepares the piece storage with the color to move for the variant.
+func (variant Variant) WrapPieceStorage(
+	storage common.PieceStorage,
+	pieceFactory common.PieceFactory,
+	color common.Color,
+) common.PieceStorage {
+	if variant.StorageWrapper == nil {
+		if variant.Rules == nil {
+			return wrapClassicStorage(storage, pieceFactory, color)
+		}
+
+		return storage
+	}
+
+	return variant.StorageWrapper(storage, pieceFactory, color)
+}
@@ -0,0 +1,453 @@
+package variants
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/variants/classic"
+	"github.com/thewizardplusplus/go-chess-models/variants/crazyhouse"
+	"github.com/thewizardplusplus/go-chess-models/variants/fourplayer"
+	"github.com/thewizardplusplus/go-chess-models/variants/glinski"
+	"github.com/thewizardplusplus/go-chess-models/variants/makruk"
+	"github.com/thewizardplusplus/go-chess-models/variants/shogi"
+	"github.com/thewizardplusplus/go-chess-models/variants/threecheck"
+	"github.com/thewizardplusplus/go-chess-models/variants/xiangqi"
+)
+
+func TestVariantNewPieceStorage(test *testing.T) {
+	type data struct {
+		name     string
+		wantSize common.Size
+	}
+
+	for _, data := range []data{
+		{
+			name:     "standard",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "chess960",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "gardner",
+			wantSize: common.Size{Width: 5, Height: 5},
+		},
+		{
+			name:     "los-alamos",
+			wantSize: common.Size{Width: 6, Height: 6},
+		},
+		{
+			name:     "microchess",
+			wantSize: common.Size{Width: 4, Height: 5},
+		},
+		{
+			name:     "silverman",
+			wantSize: common.Size{Width: 4, Height: 5},
+		},
+		{
+			name:     "capablanca",
+			wantSize: common.Size{Width: 10, Height: 8},
+		},
+		{
+			name:     "gothic",
+			wantSize: common.Size{Width: 10, Height: 8},
+		},
+		{
+			name:     "atomic",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "antichess",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "crazyhouse",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "three-check",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "king-of-the-hill",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "racing-kings",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "xiangqi",
+			wantSize: common.Size{Width: 9, Height: 10},
+		},
+		{
+			name:     "shogi",
+			wantSize: common.Size{Width: 9, Height: 9},
+		},
+		{
+			name:     "makruk",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "glinski",
+			wantSize: common.Size{Width: 11, Height: 11},
+		},
+		{
+			name:     "cylinder",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "torus",
+			wantSize: common.Size{Width: 8, Height: 8},
+		},
+		{
+			name:     "four-player",
+			wantSize: common.Size{Width: 14, Height: 14},
+		},
+	} {
+		variant, ok := Lookup(data.name)
+		if !ok {
+			test.Fail()
+			continue
+		}
+
+		storage, err := variant.NewPieceStorage(pieces.NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fail()
+			continue
+		}
+
+		if storage.Size() != data.wantSize {
+			test.Fail()
+		}
+		if variant.EncodePieceStorage(storage) != variant.InitialFEN {
+			test.Fail()
+		}
+
+		colors := variant.Colors
+		if colors == nil {
+			colors = []common.Color{common.Black, common.White}
+		}
+
+		var kingCounts, wantKingCounts [common.AllColorCount]int
+		for _, piece := range storage.Pieces() {
+			if piece.Kind() == common.King {
+				kingCounts[piece.Color()]++
+			}
+		}
+		for _, color := range colors {
+			wantKingCounts[color] = 1
+		}
+		if kingCounts != wantKingCounts {
+			test.Fail()
+		}
+	}
+}
+
+func TestVariantFirstColor(test *testing.T) {
+	variant, _ := Lookup("standard")
+	if variant.FirstColor() != common.White {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("four-player")
+	if variant.FirstColor() != common.Red {
+		test.Fail()
+	}
+}
+
+func TestVariantNewMoveGenerator(test *testing.T) {
+	variant := Variant{Rules: models.OrthodoxRules{}}
+	got := variant.NewMoveGenerator()
+
+	want := models.MoveGenerator{Rules: models.OrthodoxRules{}}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+
+	variant = Variant{
+		Features: Features{
+			PawnDoubleStep: true,
+			Promotions:     []common.Kind{common.Queen},
+		},
+	}
+	got = variant.NewMoveGenerator()
+
+	want = models.MoveGenerator{
+		Rules: classic.Rules{
+			PawnDoubleStep: true,
+			Promotions:     []common.Kind{common.Queen},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestVariantNewPieceStorage_withFeatures(test *testing.T) {
+	type data struct {
+		name string
+		deep int
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			name: "standard",
+			deep: 2,
+			want: 400,
+		},
+		{
+			name: "chess960",
+			deep: 2,
+			want: 400,
+		},
+		{
+			name: "atomic",
+			deep: 2,
+			want: 400,
+		},
+		{
+			name: "antichess",
+			deep: 2,
+			want: 400,
+		},
+		{
+			name: "crazyhouse",
+			deep: 2,
+			want: 400,
+		},
+		{
+			name: "three-check",
+			deep: 2,
+			want: 400,
+		},
+		{
+			name: "king-of-the-hill",
+			deep: 2,
+			want: 400,
+		},
+		{
+			// without pawn double steps
+			name: "gardner",
+			deep: 2,
+			want: 53,
+		},
+	} {
+		variant, _ := Lookup(data.name)
+		storage, err :=
+			variant.NewPieceStorage(pieces.NewPiece, boards.NewSliceBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := variant.NewMoveGenerator()
+		got := models.Perft(generator, storage, common.White, data.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestVariantWrapPieceStorage(test *testing.T) {
+	storage := boards.NewMapBoard(common.Size{Width: 5, Height: 5}, nil)
+
+	variant, _ := Lookup("standard")
+	got := variant.WrapPieceStorage(storage, pieces.NewPiece, common.Black)
+	classicStorage, ok := got.(classic.PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+	if !reflect.DeepEqual(classicStorage.PieceStorage, storage) {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("racing-kings")
+	got = variant.WrapPieceStorage(storage, pieces.NewPiece, common.Black)
+	if !reflect.DeepEqual(got, storage) {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("crazyhouse")
+	got = variant.WrapPieceStorage(storage, pieces.NewPiece, common.Black)
+	crazyhouseStorage, ok := got.(crazyhouse.PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+	classicStorage, ok = crazyhouseStorage.ClassicStorage()
+	if !ok {
+		test.FailNow()
+	}
+	if !reflect.DeepEqual(classicStorage.PieceStorage, storage) {
+		test.Fail()
+	}
+	if crazyhouseStorage.Color() != common.Black {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("three-check")
+	checks := threecheck.Checks{common.White: 2}
+	got = variant.WrapPieceStorage(
+		threecheck.NewPieceStorage(storage, checks),
+		pieces.NewPiece,
+		common.Black,
+	)
+	threeCheckStorage, ok := got.(threecheck.PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+	classicStorage, ok = threeCheckStorage.ClassicStorage()
+	if !ok {
+		test.FailNow()
+	}
+	if !reflect.DeepEqual(classicStorage.PieceStorage, storage) {
+		test.Fail()
+	}
+	if threeCheckStorage.Checks() != checks {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("shogi")
+	got = variant.WrapPieceStorage(storage, pieces.NewPiece, common.Black)
+	shogiStorage, ok := got.(shogi.PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+	if !reflect.DeepEqual(shogiStorage.PieceStorage, storage) {
+		test.Fail()
+	}
+	if shogiStorage.Color() != common.Black {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("makruk")
+	got = variant.WrapPieceStorage(storage, pieces.NewPiece, common.Black)
+	makrukStorage, ok := got.(makruk.PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+	if !reflect.DeepEqual(makrukStorage.PieceStorage, storage) {
+		test.Fail()
+	}
+	if makrukStorage.Counting().IsStarted() {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("glinski")
+	got = variant.WrapPieceStorage(storage, pieces.NewPiece, common.Black)
+	if !reflect.DeepEqual(got, glinski.WrapPieceStorage(storage)) {
+		test.Fail()
+	}
+	if common.StorageGeometry(got) != (common.Hexagonal{}) {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("cylinder")
+	got = variant.WrapPieceStorage(storage, pieces.NewPiece, common.Black)
+	classicStorage, ok = got.(classic.PieceStorage)
+	if !ok {
+		test.FailNow()
+	}
+	wantStorage := boards.NewGeometryBoard(storage, common.Cylinder{})
+	if !reflect.DeepEqual(classicStorage.PieceStorage, wantStorage) {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("torus")
+	got = variant.WrapPieceStorage(storage, pieces.NewPiece, common.Black)
+	if common.StorageGeometry(got) != (common.Torus{}) {
+		test.Fail()
+	}
+}
+
+func TestVariantNewPieceStorage_withCylinder(test *testing.T) {
+	variant, _ := Lookup("cylinder")
+	storage, err := variant.NewPieceStorage(pieces.NewPiece, boards.NewSliceBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	// black replies by the d-pawn after g2g3 or g2g4 and by the f-pawn
+	// after c2c3 or c2c4 are illegal, because the white bishop or the queen
+	// checks across the edge
+	generator := variant.NewMoveGenerator()
+	got := models.Perft(generator, storage, common.White, 2, nil)
+
+	if got != 392 {
+		test.Fail()
+	}
+}
+
+func TestVariantNewPieceStorage_withTorus(test *testing.T) {
+	variant, _ := Lookup("torus")
+	storage, err := variant.NewPieceStorage(pieces.NewPiece, boards.NewSliceBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	// a king has no moves, because all positions next to it are attacked
+	// across the edge between the ranks 1 and 8
+	generator := variant.NewMoveGenerator()
+	got := models.Perft(generator, storage, common.White, 2, nil)
+
+	if got != 1769 {
+		test.Fail()
+	}
+}
+
+func TestVariantNewPieceStorage_withFourPlayers(test *testing.T) {
+	variant, _ := Lookup("four-player")
+	storage, err := variant.NewPieceStorage(pieces.NewPiece, boards.NewSliceBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if _, ok := storage.(fourplayer.PieceStorage); !ok {
+		test.Fail()
+	}
+
+	// each player has 8 pawn steps, 8 pawn double steps and 4 knight moves,
+	// except that the red double step d2d4 blocks the blue double step b4d4
+	generator := variant.NewMoveGenerator()
+	got := models.Perft(generator, storage, variant.FirstColor(), 2, nil)
+
+	if got != 20*20-1 {
+		test.Fail()
+	}
+}
+
+func TestVariantWrapPieceFactory(test *testing.T) {
+	position := common.Position{File: 4, Rank: 0}
+
+	variant, _ := Lookup("standard")
+	pieceFactory := variant.WrapPieceFactory(pieces.NewPiece)
+	got := pieceFactory(common.King, common.White, position)
+	if !reflect.DeepEqual(got, pieces.NewKing(common.White, position)) {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("xiangqi")
+	pieceFactory = variant.WrapPieceFactory(pieces.NewPiece)
+	got = pieceFactory(common.King, common.White, position)
+	if !reflect.DeepEqual(got, xiangqi.NewGeneral(common.White, position)) {
+		test.Fail()
+	}
+
+	got = pieceFactory(common.Queen, common.White, position)
+	if !reflect.DeepEqual(got, pieces.NewQueen(common.White, position)) {
+		test.Fail()
+	}
+
+	variant, _ = Lookup("glinski")
+	pieceFactory = variant.WrapPieceFactory(pieces.NewPiece)
+	got = pieceFactory(common.Rook, common.White, position)
+	if !reflect.DeepEqual(got, glinski.NewRook(common.White, position)) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,32 @@
+package xiangqi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Advisor ...
+//
+// It moves one position diagonally inside the palace.
+type Advisor struct{ pieces.Base }
+
+// NewAdvisor ...
+func NewAdvisor(color common.Color, position common.Position) Advisor {
+	base := pieces.NewBase(AdvisorKind, color, position)
+	return Advisor{base}
+}
+
+// ApplyPosition ...
+func (piece Advisor) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Advisor{base}
+}
+
+// CheckMove ...
+func (piece Advisor) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return advisorMovement.CheckMove(piece.Color(), move, storage) &&
+		IsInPalace(storage.Size(), piece.Color(), move.Finish)
+}
@@ -0,0 +1,59 @@
+package xiangqi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewAdvisor(test *testing.T) {
+	position := common.Position{File: 3, Rank: 0}
+	piece := NewAdvisor(common.White, position)
+
+	expectedPiece := Advisor{pieces.NewBase(AdvisorKind, common.White, position)}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+}
+
+func TestAdvisorCheckMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/3A5", "d1e2"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/3A5", "d1c2"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/3A5", "d1d2"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/4A4/9", "e2f3"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/3a5/9/9/9/9/9/9/9/9", "d9e8"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/3a5/9/9/9/9/9/9/9", "d8e7"},
+			want: false,
+		},
+	} {
+		got := checkPieceMove(test, data.args)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package xiangqi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// IsInPalace ...
+//
+// It checks that the position is inside the palace of the color,
+// i.e. on three central files and three ranks nearest to the color.
+func IsInPalace(
+	size common.Size,
+	color common.Color,
+	position common.Position,
+) bool {
+	fileShift := position.File - size.Width/2
+	if fileShift < -1 || fileShift > 1 {
+		return false
+	}
+
+	if color == common.White {
+		return position.Rank >= 0 && position.Rank <= 2
+	}
+
+	return position.Rank >= size.Height-3 && position.Rank < size.Height
+}
+
+// IsOnOwnSide ...
+//
+// It checks that the position is on the side of the river nearest
+// to the color.
+func IsOnOwnSide(
+	size common.Size,
+	color common.Color,
+	position common.Position,
+) bool {
+	if color == common.White {
+		return position.Rank < size.Height/2
+	}
+
+	return position.Rank >= size.Height-size.Height/2
+}
@@ -0,0 +1,119 @@
+package xiangqi
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+type checkMoveArgs struct {
+	fen  string
+	move string
+}
+
+func checkPieceMove(test *testing.T, args checkMoveArgs) bool {
+	storage, err := DecodePieceStorage(args.fen, NewPiece, boards.NewMapBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	move, err := uci.DecodeMove(args.move)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	piece, ok := storage.Piece(move.Start)
+	if !ok {
+		test.Fatal("no piece")
+	}
+
+	return piece.CheckMove(move, storage)
+}
+
+func TestIsInPalace(test *testing.T) {
+	type args struct {
+		color    common.Color
+		position common.Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	size := common.Size{Width: 9, Height: 10}
+	for _, data := range []data{
+		{
+			args: args{common.White, common.Position{File: 4, Rank: 0}},
+			want: true,
+		},
+		{
+			args: args{common.White, common.Position{File: 3, Rank: 2}},
+			want: true,
+		},
+		{
+			args: args{common.White, common.Position{File: 2, Rank: 1}},
+			want: false,
+		},
+		{
+			args: args{common.White, common.Position{File: 4, Rank: 3}},
+			want: false,
+		},
+		{
+			args: args{common.White, common.Position{File: 4, Rank: 9}},
+			want: false,
+		},
+		{
+			args: args{common.Black, common.Position{File: 5, Rank: 7}},
+			want: true,
+		},
+		{
+			args: args{common.Black, common.Position{File: 5, Rank: 6}},
+			want: false,
+		},
+	} {
+		got := IsInPalace(size, data.args.color, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestIsOnOwnSide(test *testing.T) {
+	type args struct {
+		color    common.Color
+		position common.Position
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	size := common.Size{Width: 9, Height: 10}
+	for _, data := range []data{
+		{
+			args: args{common.White, common.Position{File: 0, Rank: 4}},
+			want: true,
+		},
+		{
+			args: args{common.White, common.Position{File: 0, Rank: 5}},
+			want: false,
+		},
+		{
+			args: args{common.Black, common.Position{File: 0, Rank: 5}},
+			want: true,
+		},
+		{
+			args: args{common.Black, common.Position{File: 0, Rank: 4}},
+			want: false,
+		},
+	} {
+		got := IsOnOwnSide(size, data.args.color, data.args.position)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
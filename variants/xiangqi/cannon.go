@@ -0,0 +1,32 @@
+package xiangqi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Cannon ...
+//
+// It moves as a rook, but it captures by jumping exactly one piece
+// (a screen).
+type Cannon struct{ pieces.Base }
+
+// NewCannon ...
+func NewCannon(color common.Color, position common.Position) Cannon {
+	base := pieces.NewBase(CannonKind, color, position)
+	return Cannon{base}
+}
+
+// ApplyPosition ...
+func (piece Cannon) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Cannon{base}
+}
+
+// CheckMove ...
+func (piece Cannon) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return cannonMovement.CheckMove(piece.Color(), move, storage)
+}
@@ -0,0 +1,67 @@
+package xiangqi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewCannon(test *testing.T) {
+	position := common.Position{File: 1, Rank: 2}
+	piece := NewCannon(common.White, position)
+
+	expectedPiece := Cannon{pieces.NewBase(CannonKind, common.White, position)}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+}
+
+func TestCannonCheckMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/1C7/9/9", "b3b9"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/1C7/9/9", "b3c4"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/1r7/9/1p7/9/9/9/1C7/9/9", "b3b9"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/1r7/9/1p7/9/9/9/1C7/9/9", "b3b7"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/1r7/9/1p7/9/9/9/1C7/9/9", "b3b5"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/1r7/9/1p7/9/1p7/9/1C7/9/9", "b3b9"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/1CpPr4/9/9", "b3f3"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/1C1Pr4/9/9", "b3e3"},
+			want: true,
+		},
+	} {
+		got := checkPieceMove(test, data.args)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package xiangqi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Elephant ...
+//
+// It moves exactly two positions diagonally and can't cross the river.
+// It's blocked by a piece on the intermediate position.
+type Elephant struct{ pieces.Base }
+
+// NewElephant ...
+func NewElephant(color common.Color, position common.Position) Elephant {
+	base := pieces.NewBase(ElephantKind, color, position)
+	return Elephant{base}
+}
+
+// ApplyPosition ...
+func (piece Elephant) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Elephant{base}
+}
+
+// CheckMove ...
+func (piece Elephant) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return elephantMovement.CheckMove(piece.Color(), move, storage) &&
+		IsOnOwnSide(storage.Size(), piece.Color(), move.Finish)
+}
@@ -0,0 +1,60 @@
+package xiangqi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewElephant(test *testing.T) {
+	position := common.Position{File: 2, Rank: 0}
+	piece := NewElephant(common.White, position)
+
+	expectedPiece :=
+		Elephant{pieces.NewBase(ElephantKind, common.White, position)}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+}
+
+func TestElephantCheckMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/2B6", "c1e3"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/3P5/2B6", "c1e3"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/2B6", "c1d2"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/2B6/9/9/9/9", "c5e7"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/2B6/9/9/9/9", "c5a3"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/2b6/9/9/9/9/9", "c6e4"},
+			want: false,
+		},
+	} {
+		got := checkPieceMove(test, data.args)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
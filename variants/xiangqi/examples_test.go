@@ -0,0 +1,31 @@
+package xiangqi_test
+
+import (
+	"fmt"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/variants/xiangqi"
+)
+
+func ExampleRules() {
+	storage, _ := xiangqi.DecodePieceStorage(
+		xiangqi.InitialFEN,
+		xiangqi.NewPiece,
+		boards.NewMapBoard,
+	)
+
+	generator := models.MoveGenerator{Rules: xiangqi.Rules{}}
+	moves, _ := generator.LegalMovesForColor(storage, common.White)
+	fmt.Println(len(moves))
+
+	move, _ := uci.DecodeMove("h3e3")
+	storage = generator.ApplyMove(storage, move)
+	fmt.Println(xiangqi.EncodePieceStorage(storage))
+
+	// Output:
+	// 44
+	// rnbakabnr/9/1c5c1/p1p1p1p1p/9/9/P1P1P1P1P/1C2C4/9/RNBAKABNR
+}
@@ -0,0 +1,125 @@
+package xiangqi
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+// InitialFEN ...
+//
+// It's the initial position of Xiangqi in the Xiangqi FEN.
+const InitialFEN = "rnbakabnr/9/1c5c1/p1p1p1p1p/9/9/P1P1P1P1P/1C5C1/9/RNBAKABNR"
+
+// DecodePieceStorage ...
+//
+// It decodes a piece storage from the Xiangqi FEN. It uses the symbols
+// k (a general), a (an advisor), b or e (an elephant), n or h (a horse),
+// r (a chariot), c (a cannon) and p (a soldier).
+//
+// The piece factory should make Xiangqi pieces, see NewPieceFactory().
+func DecodePieceStorage(
+	fen string,
+	pieceFactory common.PieceFactory,
+	pieceStorageFactory uci.PieceStorageFactory,
+) (common.PieceStorage, error) {
+	var genericFEN strings.Builder
+	for _, symbol := range fen {
+		if !unicode.IsLetter(symbol) {
+			genericFEN.WriteRune(symbol)
+			continue
+		}
+
+		kind, ok := decodeKind(unicode.ToLower(symbol))
+		if !ok {
+			return nil, fmt.Errorf("unknown kind %q", symbol)
+		}
+
+		descriptor, _ := Kinds.LookupKind(kind)
+		genericSymbol := descriptor.Symbol
+		if unicode.IsUpper(symbol) {
+			genericSymbol = unicode.ToUpper(genericSymbol)
+		}
+
+		genericFEN.WriteRune(genericSymbol)
+	}
+
+	return uci.Notation{Kinds: Kinds}.DecodePieceStorage(
+		genericFEN.String(),
+		pieceFactory,
+		pieceStorageFactory,
+	)
+}
+
+// EncodePieceStorage ...
+//
+// It converts the piece storage to the Xiangqi FEN (see DecodePieceStorage()).
+// Non-Xiangqi pieces are encoded as in the generic FEN.
+func EncodePieceStorage(storage common.PieceStorage) string {
+	genericFEN := uci.Notation{Kinds: Kinds}.EncodePieceStorage(storage)
+	return strings.Map(func(symbol rune) rune {
+		if !unicode.IsLetter(symbol) {
+			return symbol
+		}
+
+		kind, ok := Kinds.LookupKindBySymbol(symbol)
+		if !ok {
+			return symbol
+		}
+
+		xiangqiSymbol, ok := encodeKind(kind)
+		if !ok {
+			return symbol
+		}
+		if unicode.IsUpper(symbol) {
+			xiangqiSymbol = unicode.ToUpper(xiangqiSymbol)
+		}
+
+		return xiangqiSymbol
+	}, genericFEN)
+}
+
+func decodeKind(symbol rune) (common.Kind, bool) {
+	switch symbol {
+	case 'k':
+		return common.King, true
+	case 'a':
+		return AdvisorKind, true
+	case 'b', 'e':
+		return ElephantKind, true
+	case 'n', 'h':
+		return HorseKind, true
+	case 'r':
+		return common.Rook, true
+	case 'c':
+		return CannonKind, true
+	case 'p':
+		return SoldierKind, true
+	}
+
+	return 0, false
+}
+
+func encodeKind(kind common.Kind) (rune, bool) {
+	switch kind {
+	case common.King:
+		return 'k', true
+	case AdvisorKind:
+		return 'a', true
+	case ElephantKind:
+		return 'b', true
+	case HorseKind:
+		return 'n', true
+	case common.Rook:
+		return 'r', true
+	case CannonKind:
+		return 'c', true
+	case SoldierKind:
+		return 'p', true
+	}
+
+	return 0, false
+}
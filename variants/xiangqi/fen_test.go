@@ -0,0 +1,98 @@
+package xiangqi
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestDecodePieceStorage(test *testing.T) {
+	type args struct {
+		fen string
+	}
+	type data struct {
+		args           args
+		wantGenericFEN string
+		wantErr        bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{InitialFEN},
+			wantGenericFEN: "rhedkdehr/9/1o5o1/i1i1i1i1i/9/" +
+				"9/I1I1I1I1I/1O5O1/9/RHEDKDEHR",
+			wantErr: false,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/9/9/2E1K1H2"},
+			wantGenericFEN: "4k4/9/9/9/9/9/9/9/9/2E1K1H2",
+			wantErr:        false,
+		},
+		{
+			args:           args{"4k4/9/9/9/9/9/9/9/9/3QK4"},
+			wantGenericFEN: "",
+			wantErr:        true,
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(data.args.fen, NewPiece, boards.NewMapBoard)
+
+		var gotGenericFEN string
+		if storage != nil {
+			gotGenericFEN = uci.Notation{Kinds: Kinds}.EncodePieceStorage(storage)
+		}
+		if gotGenericFEN != data.wantGenericFEN {
+			test.Fail()
+		}
+		if hasErr := err != nil; hasErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestEncodePieceStorage(test *testing.T) {
+	type args struct {
+		storage common.PieceStorage
+	}
+	type data struct {
+		args args
+		want string
+	}
+
+	decode := func(fen string) common.PieceStorage {
+		storage, err := DecodePieceStorage(fen, NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		return storage
+	}
+
+	for _, data := range []data{
+		{
+			args: args{decode(InitialFEN)},
+			want: InitialFEN,
+		},
+		{
+			args: args{decode("4k4/9/9/9/9/9/9/9/9/2E1K1H2")},
+			want: "4k4/9/9/9/9/9/9/9/9/2B1K1N2",
+		},
+		{
+			args: args{
+				boards.NewMapBoard(common.Size{Width: 3, Height: 1}, []common.Piece{
+					pieces.NewQueen(common.White, common.Position{File: 1, Rank: 0}),
+				}),
+			},
+			want: "1Q1",
+		},
+	} {
+		got := EncodePieceStorage(data.args.storage)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
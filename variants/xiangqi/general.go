@@ -0,0 +1,40 @@
+package xiangqi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// General ...
+//
+// It moves one position orthogonally inside the palace. It can also capture
+// the enemy general on the same file without pieces between them, which
+// implements the flying general rule.
+type General struct{ pieces.Base }
+
+// NewGeneral ...
+func NewGeneral(color common.Color, position common.Position) General {
+	base := pieces.NewBase(common.King, color, position)
+	return General{base}
+}
+
+// ApplyPosition ...
+func (piece General) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return General{base}
+}
+
+// CheckMove ...
+func (piece General) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	if target, ok := storage.Piece(move.Finish); ok &&
+		target.Kind() == common.King &&
+		flyingGeneralMovement.CheckMove(piece.Color(), move, storage) {
+		return true
+	}
+
+	return generalMovement.CheckMove(piece.Color(), move, storage) &&
+		IsInPalace(storage.Size(), piece.Color(), move.Finish)
+}
@@ -0,0 +1,78 @@
+package xiangqi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewGeneral(test *testing.T) {
+	position := common.Position{File: 4, Rank: 0}
+	piece := NewGeneral(common.White, position)
+
+	expectedPiece := General{pieces.NewBase(common.King, common.White, position)}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+}
+
+func TestGeneralApplyPosition(test *testing.T) {
+	piece := NewGeneral(common.White, common.Position{File: 4, Rank: 0})
+	nextPiece := piece.ApplyPosition(common.Position{File: 4, Rank: 1})
+
+	expectedNextPiece :=
+		NewGeneral(common.White, common.Position{File: 4, Rank: 1})
+	if !reflect.DeepEqual(nextPiece, expectedNextPiece) {
+		test.Fail()
+	}
+}
+
+func TestGeneralCheckMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/4K4", "e1e2"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/4K4", "e1d1"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/4K4", "e1d2"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/9/9/3K5", "d1c1"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/9/4K4/9/9", "e3e4"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/4k4/9/9/9/9/9/9/4K4", "e1e8"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/4k4/9/9/4P4/9/9/9/4K4", "e1e8"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/3k5/9/9/9/9/9/9/4K4", "e1d8"},
+			want: false,
+		},
+	} {
+		got := checkPieceMove(test, data.args)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package xiangqi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Horse ...
+//
+// It moves as a knight, but it's blocked by a piece on the position
+// orthogonally adjacent to it in the direction of the move (a leg).
+type Horse struct{ pieces.Base }
+
+// NewHorse ...
+func NewHorse(color common.Color, position common.Position) Horse {
+	base := pieces.NewBase(HorseKind, color, position)
+	return Horse{base}
+}
+
+// ApplyPosition ...
+func (piece Horse) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Horse{base}
+}
+
+// CheckMove ...
+func (piece Horse) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	return horseMovement.CheckMove(piece.Color(), move, storage)
+}
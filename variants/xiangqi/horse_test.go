@@ -0,0 +1,59 @@
+package xiangqi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewHorse(test *testing.T) {
+	position := common.Position{File: 1, Rank: 0}
+	piece := NewHorse(common.White, position)
+
+	expectedPiece := Horse{pieces.NewBase(HorseKind, common.White, position)}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+}
+
+func TestHorseCheckMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: checkMoveArgs{"9/9/9/9/9/4N4/9/9/9/9", "e5f7"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/4P4/4N4/9/9/9/9", "e5f7"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/4P4/4N4/9/9/9/9", "e5g6"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/4NP3/9/9/9/9", "e5g6"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/4NP3/9/9/9/9", "e5g4"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/4N4/9/9/9/9", "e5f6"},
+			want: false,
+		},
+	} {
+		got := checkPieceMove(test, data.args)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package xiangqi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+	"github.com/thewizardplusplus/go-chess-models/pieces/betza"
+)
+
+// ...
+//
+// The general and the chariot use the built-in kinds common.King
+// and common.Rook, other kinds are described by the Kinds table.
+const (
+	AdvisorKind common.Kind = common.KindCount + iota
+	ElephantKind
+	HorseKind
+	CannonKind
+	SoldierKind
+)
+
+// Kinds ...
+//
+// It describes Xiangqi kinds, which aren't built-in ones. Their symbols
+// are used only by the generic FEN coding with the table
+// (see uci.Notation), see DecodePieceStorage() for the Xiangqi one.
+var Kinds = common.KindTable{
+	AdvisorKind:  {Name: "advisor", Symbol: 'd', Value: 200},
+	ElephantKind: {Name: "elephant", Symbol: 'e', Value: 200},
+	HorseKind:    {Name: "horse", Symbol: 'h', Value: 400},
+	CannonKind:   {Name: "cannon", Symbol: 'o', Value: 450},
+	SoldierKind:  {Name: "soldier", Symbol: 'i', Value: 100},
+}
+
+// movements of pieces without palace and river constraints
+var (
+	generalMovement        = betza.MustParse("W")
+	flyingGeneralMovement  = betza.MustParse("cvR")
+	advisorMovement        = betza.MustParse("F")
+	elephantMovement       = betza.MustParse("nA")
+	horseMovement          = betza.MustParse("nN")
+	cannonMovement         = betza.MustParse("mRcpR")
+	soldierMovement        = betza.MustParse("fW")
+	crossedSoldierMovement = betza.MustParse("fsW")
+)
+
+// NewPiece ...
+//
+// It makes Xiangqi pieces, including a general for common.King.
+// It returns nil for other kinds.
+func NewPiece(
+	kind common.Kind,
+	color common.Color,
+	position common.Position,
+) common.Piece {
+	return NewPieceFactory(nil)(kind, color, position)
+}
+
+// NewPieceFactory ...
+//
+// It returns a factory, which makes Xiangqi pieces and uses the fallback
+// factory for other kinds. The fallback can be nil.
+func NewPieceFactory(fallback common.PieceFactory) common.PieceFactory {
+	return func(
+		kind common.Kind,
+		color common.Color,
+		position common.Position,
+	) common.Piece {
+		switch kind {
+		case common.King:
+			return NewGeneral(color, position)
+		case common.Rook:
+			return pieces.NewRook(color, position)
+		case AdvisorKind:
+			return NewAdvisor(color, position)
+		case ElephantKind:
+			return NewElephant(color, position)
+		case HorseKind:
+			return NewHorse(color, position)
+		case CannonKind:
+			return NewCannon(color, position)
+		case SoldierKind:
+			return NewSoldier(color, position)
+		}
+
+		if fallback == nil {
+			return nil
+		}
+
+		return fallback(kind, color, position)
+	}
+}
@@ -0,0 +1,89 @@
+package xiangqi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewPieceFactory(test *testing.T) {
+	type args struct {
+		fallback common.PieceFactory
+		kind     common.Kind
+	}
+	type data struct {
+		args args
+		want common.Piece
+	}
+
+	position := common.Position{File: 2, Rank: 3}
+	for _, data := range []data{
+		{
+			args: args{nil, common.King},
+			want: NewGeneral(common.White, position),
+		},
+		{
+			args: args{nil, common.Rook},
+			want: pieces.NewRook(common.White, position),
+		},
+		{
+			args: args{nil, AdvisorKind},
+			want: NewAdvisor(common.White, position),
+		},
+		{
+			args: args{nil, ElephantKind},
+			want: NewElephant(common.White, position),
+		},
+		{
+			args: args{nil, HorseKind},
+			want: NewHorse(common.White, position),
+		},
+		{
+			args: args{nil, CannonKind},
+			want: NewCannon(common.White, position),
+		},
+		{
+			args: args{nil, SoldierKind},
+			want: NewSoldier(common.White, position),
+		},
+		{
+			args: args{nil, common.Queen},
+			want: nil,
+		},
+		{
+			args: args{pieces.NewPiece, common.Queen},
+			want: pieces.NewQueen(common.White, position),
+		},
+	} {
+		factory := NewPieceFactory(data.args.fallback)
+		got := factory(data.args.kind, common.White, position)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestKinds(test *testing.T) {
+	position := common.Position{File: 2, Rank: 3}
+	for kind, descriptor := range Kinds {
+		if kind < common.KindCount {
+			test.Fail()
+		}
+		if NewPiece(kind, common.Black, position) == nil {
+			test.Fail()
+		}
+
+		gotKind, ok := Kinds.LookupKindBySymbol(descriptor.Symbol)
+		if !ok || gotKind != kind {
+			test.Fail()
+		}
+
+		// kinds of the table aren't registered
+		if _, ok := common.LookupKindBySymbol(descriptor.Symbol); ok {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Package xiangqi implements Xiangqi (Chinese chess).
+//
+// It provides its pieces with palace and river constraints, the flying
+// general rule and the Xiangqi FEN. A board has a size 9x10, white (red)
+// starts on lower ranks.
+package xiangqi
+
+import (
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+// Rules ...
+//
+// It implements the models.Rules interface. All moves are checked
+// by pieces, so a piece storage should be created with Xiangqi pieces
+// (see NewPieceFactory()).
+type Rules struct {
+	models.OrthodoxRules
+}
+
+// NoMovesResult ...
+//
+// It returns a win of the opponent on both a checkmate and a stalemate.
+func (rules Rules) NoMovesResult(
+	color common.Color,
+	isCheck bool,
+) models.Result {
+	return models.Win(color.Negative())
+}
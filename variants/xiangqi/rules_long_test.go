@@ -0,0 +1,27 @@
+//go:build long
+// +build long
+
+package xiangqi
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+)
+
+func TestPerft_long(test *testing.T) {
+	storage, err :=
+		DecodePieceStorage(InitialFEN, NewPiece, boards.NewSliceBoard)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	generator := models.MoveGenerator{Rules: Rules{}}
+	got := models.Perft(generator, storage, common.White, 3, nil)
+
+	if got != 79666 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,151 @@
+package xiangqi
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-chess-models"
+	"github.com/thewizardplusplus/go-chess-models/boards"
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/encoding/uci"
+)
+
+func TestRulesNoMovesResult(test *testing.T) {
+	type args struct {
+		color   common.Color
+		isCheck bool
+	}
+	type data struct {
+		args args
+		want models.Result
+	}
+
+	for _, data := range []data{
+		{
+			args: args{common.White, true},
+			want: models.BlackWin,
+		},
+		{
+			args: args{common.White, false},
+			want: models.BlackWin,
+		},
+		{
+			args: args{common.Black, false},
+			want: models.WhiteWin,
+		},
+	} {
+		var rules Rules
+		got := rules.NoMovesResult(data.args.color, data.args.isCheck)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRules_withMoveGenerator(test *testing.T) {
+	type args struct {
+		fen   string
+		color common.Color
+	}
+	type data struct {
+		args       args
+		wantMoves  []string
+		wantResult models.Result
+	}
+
+	for _, data := range []data{
+		{
+			// the generals can't face each other
+			args: args{
+				fen:   "3k5/9/9/9/9/9/9/9/9/4K4",
+				color: common.White,
+			},
+			wantMoves:  []string{"e1e2", "e1f1"},
+			wantResult: models.Unfinished,
+		},
+		{
+			// the soldier is pinned by the flying general rule
+			args: args{
+				fen:   "4k4/9/9/9/4P4/9/9/9/9/4K4",
+				color: common.White,
+			},
+			wantMoves:  []string{"e1d1", "e1e2", "e1f1", "e6e7"},
+			wantResult: models.Unfinished,
+		},
+		{
+			// a stalemate is a loss
+			args: args{
+				fen:   "5k3/9/9/9/9/9/9/9/4r4/3K5",
+				color: common.White,
+			},
+			wantMoves:  nil,
+			wantResult: models.BlackWin,
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(data.args.fen, NewPiece, boards.NewMapBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		moves, err := generator.LegalMovesForColor(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		var gotMoves []string
+		for _, move := range moves {
+			gotMoves = append(gotMoves, uci.EncodeMove(move))
+		}
+		sort.Strings(gotMoves)
+
+		gotResult, err := generator.Result(storage, data.args.color)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotResult != data.wantResult {
+			test.Fail()
+		}
+	}
+}
+
+func TestPerft(test *testing.T) {
+	type args struct {
+		deep int
+	}
+	type data struct {
+		args args
+		want int
+	}
+
+	for _, data := range []data{
+		{
+			args: args{1},
+			want: 44,
+		},
+		{
+			args: args{2},
+			want: 1920,
+		},
+	} {
+		storage, err :=
+			DecodePieceStorage(InitialFEN, NewPiece, boards.NewSliceBoard)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		generator := models.MoveGenerator{Rules: Rules{}}
+		got := models.Perft(generator, storage, common.White, data.args.deep, nil)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
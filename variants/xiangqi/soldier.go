@@ -0,0 +1,37 @@
+package xiangqi
+
+import (
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+// Soldier ...
+//
+// It moves and captures one position forward. After crossing the river,
+// it can also move one position sideways. It's never promoted.
+type Soldier struct{ pieces.Base }
+
+// NewSoldier ...
+func NewSoldier(color common.Color, position common.Position) Soldier {
+	base := pieces.NewBase(SoldierKind, color, position)
+	return Soldier{base}
+}
+
+// ApplyPosition ...
+func (piece Soldier) ApplyPosition(position common.Position) common.Piece {
+	base := piece.Base.ApplyPosition(position)
+	return Soldier{base}
+}
+
+// CheckMove ...
+func (piece Soldier) CheckMove(
+	move common.Move,
+	storage common.PieceStorage,
+) bool {
+	movement := soldierMovement
+	if !IsOnOwnSide(storage.Size(), piece.Color(), move.Start) {
+		movement = crossedSoldierMovement
+	}
+
+	return movement.CheckMove(piece.Color(), move, storage)
+}
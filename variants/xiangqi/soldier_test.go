@@ -0,0 +1,71 @@
+package xiangqi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-chess-models/common"
+	"github.com/thewizardplusplus/go-chess-models/pieces"
+)
+
+func TestNewSoldier(test *testing.T) {
+	position := common.Position{File: 0, Rank: 3}
+	piece := NewSoldier(common.White, position)
+
+	expectedPiece := Soldier{pieces.NewBase(SoldierKind, common.White, position)}
+	if !reflect.DeepEqual(piece, expectedPiece) {
+		test.Fail()
+	}
+}
+
+func TestSoldierCheckMove(test *testing.T) {
+	type data struct {
+		args checkMoveArgs
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/4P4/9/9/9", "e4e5"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/4P4/9/9/9", "e4d4"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/9/4P4/9/9/9", "e4e3"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/4P4/9/9/9/9/9", "e6d6"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/4P4/9/9/9/9/9", "e6e5"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/4P4/9/9/9/9/9", "e6f7"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/4p4/9/9/9/9/9/9", "e7e6"},
+			want: true,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/4p4/9/9/9/9/9/9", "e7d7"},
+			want: false,
+		},
+		{
+			args: checkMoveArgs{"9/9/9/9/9/4p4/9/9/9/9", "e5d5"},
+			want: true,
+		},
+	} {
+		got := checkPieceMove(test, data.args)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}